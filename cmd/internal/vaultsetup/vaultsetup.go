@@ -0,0 +1,71 @@
+// Package vaultsetup builds the vault shared by the server and the worker from the environment.
+package vaultsetup
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/rbroggi/faceittha/internal/actors/postgres"
+	"github.com/rbroggi/faceittha/internal/actors/vault"
+	log "github.com/sirupsen/logrus"
+)
+
+// developmentMasterKey is the master key used in development mode when VAULT_MASTER_KEY is not set. It is public, it
+// must never be used in production.
+const developmentMasterKey = "faceittha-development-master-key"
+
+// NewVault builds the vault protecting the personal data of the users. The data keys are wrapped by the base64 encoded
+// VAULT_MASTER_KEY and stored in the database pointed by DATA_KEYS_POSTGRESQL_URL, which defaults to the users database.
+// It returns an error if VAULT_MASTER_KEY is not set, unless VAULT_DEVELOPMENT_MODE is true, in which case the
+// development master key is used.
+func NewVault(ctx context.Context, usersDB *pg.DB) (*vault.Vault, error) {
+	masterKey, err := masterKey()
+	if err != nil {
+		return nil, err
+	}
+
+	keysDB := usersDB
+	if url := os.Getenv("DATA_KEYS_POSTGRESQL_URL"); url != "" {
+		opts, err := pg.ParseURL(url)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing data keys Postgres URL: %w", err)
+		}
+		keysDB = pg.Connect(opts)
+		if err := keysDB.Ping(ctx); err != nil {
+			return nil, fmt.Errorf("data keys db does not appear to be reachable: %w", err)
+		}
+	}
+	keysRepository, err := postgres.NewPostgresDB(postgres.PostgresDBArgs{DB: keysDB})
+	if err != nil {
+		return nil, err
+	}
+	return vault.NewVault(vault.VaultArgs{Repository: keysRepository, MasterKey: masterKey})
+}
+
+func masterKey() ([]byte, error) {
+	if encoded := os.Getenv("VAULT_MASTER_KEY"); encoded != "" {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding VAULT_MASTER_KEY: %w", err)
+		}
+		return key, nil
+	}
+
+	developmentMode := false
+	if value := os.Getenv("VAULT_DEVELOPMENT_MODE"); value != "" {
+		var err error
+		developmentMode, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing VAULT_DEVELOPMENT_MODE: %w", err)
+		}
+	}
+	if !developmentMode {
+		return nil, fmt.Errorf("VAULT_MASTER_KEY must be set unless VAULT_DEVELOPMENT_MODE is true")
+	}
+	log.Warn("VAULT_MASTER_KEY not set, using the development master key")
+	return []byte(developmentMasterKey), nil
+}
@@ -2,20 +2,38 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"regexp"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/go-pg/pg/v10"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/rbroggi/faceittha/cmd/internal/vaultsetup"
+	"github.com/rbroggi/faceittha/internal/actors/auth"
 	grpcactor "github.com/rbroggi/faceittha/internal/actors/grpc"
 	"github.com/rbroggi/faceittha/internal/actors/postgres"
+	"github.com/rbroggi/faceittha/internal/actors/ratelimit"
+	"github.com/rbroggi/faceittha/internal/actors/signer"
+	"github.com/rbroggi/faceittha/internal/actors/tlsconfig"
+	"github.com/rbroggi/faceittha/internal/core/authz"
+	"github.com/rbroggi/faceittha/internal/core/model"
 	"github.com/rbroggi/faceittha/internal/core/usecase"
 	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
 	log "github.com/sirupsen/logrus"
@@ -58,16 +76,166 @@ func run() error {
 		return err
 
 	}
-	pgDB, err := postgres.NewPostgresDB(postgres.PostgresDBArgs{DB: db})
+	userDataVault, err := vaultsetup.NewVault(ctx, db)
+	if err != nil {
+		log.WithError(err).Error("error instantiating vault")
+		return err
+	}
+	pgDB, err := postgres.NewPostgresDB(postgres.PostgresDBArgs{DB: db}, postgres.WithVault(userDataVault))
 	if err != nil {
 		log.WithError(err).Error("error instantiating PostgresDB")
 		return err
 	}
-	userSvcUsecase := usecase.NewUserService(usecase.UserServiceArgs{Repository: pgDB})
-	userServer := grpcactor.NewUserService(grpcactor.UserServiceArgs{Usecase: userSvcUsecase})
+	// the nickname keys cleared by a change of model.NicknameKey must be recomputed before the nicknames are checked
+	keyed, err := pgDB.BackfillNicknameKeys(ctx)
+	if err != nil {
+		log.WithError(err).Error("error backfilling the nickname keys")
+		return err
+	}
+	if keyed > 0 {
+		log.WithField("users", keyed).Info("backfilled the nickname keys")
+	}
+	exportSigner, err := newExportSigner()
+	if err != nil {
+		log.WithError(err).Error("error instantiating export signer")
+		return err
+	}
+	tokenIssuer, err := newTokenIssuer()
+	if err != nil {
+		log.WithError(err).Error("error instantiating token issuer")
+		return err
+	}
+	attributeSchemas, err := newAttributeSchemas()
+	if err != nil {
+		log.WithError(err).Error("error parsing USER_ATTRIBUTES")
+		return err
+	}
+	fieldPolicy, err := newFieldPolicy()
+	if err != nil {
+		log.WithError(err).Error("error parsing PII_FIELD_ROLES")
+		return err
+	}
+	var deletionGracePeriod, nicknameCooldown, nicknameReservation time.Duration
+	for name, duration := range map[string]*time.Duration{
+		"ACCOUNT_DELETION_GRACE_PERIOD": &deletionGracePeriod,
+		"NICKNAME_CHANGE_COOLDOWN":      &nicknameCooldown,
+		"NICKNAME_RESERVATION_PERIOD":   &nicknameReservation,
+	} {
+		if encoded := os.Getenv(name); encoded != "" {
+			if *duration, err = time.ParseDuration(encoded); err != nil {
+				log.WithError(err).Errorf("error parsing %s", name)
+				return err
+			}
+		}
+	}
+	var nicknameMinLength, nicknameMaxLength int
+	for name, length := range map[string]*int{
+		"NICKNAME_MIN_LENGTH": &nicknameMinLength,
+		"NICKNAME_MAX_LENGTH": &nicknameMaxLength,
+	} {
+		if encoded := os.Getenv(name); encoded != "" {
+			if *length, err = strconv.Atoi(encoded); err != nil {
+				log.WithError(err).Errorf("error parsing %s", name)
+				return err
+			}
+		}
+	}
+	var reservedNicknames, profaneWords, disposableEmailDomains []string
+	for name, words := range map[string]*[]string{
+		"NICKNAME_RESERVED_WORDS_FILE":  &reservedNicknames,
+		"NICKNAME_PROFANITY_FILE":       &profaneWords,
+		"DISPOSABLE_EMAIL_DOMAINS_FILE": &disposableEmailDomains,
+	} {
+		if file := os.Getenv(name); file != "" {
+			if *words, err = readWordList(file); err != nil {
+				log.WithError(err).Errorf("error reading %s", name)
+				return err
+			}
+		}
+	}
+	userSvcUsecase := usecase.NewUserService(usecase.UserServiceArgs{
+		Repository:           pgDB,
+		AuditRepository:      pgDB,
+		Signer:               exportSigner,
+		Vault:                userDataVault,
+		LockoutRepository:    pgDB,
+		SessionRepository:    pgDB,
+		TokenIssuer:          tokenIssuer,
+		TOTPRepository:       pgDB,
+		APIKeyRepository:     pgDB,
+		RoleRepository:       pgDB,
+		AttributeSchemas:     attributeSchemas,
+		FriendshipRepository: pgDB,
+		BlockRepository:      pgDB,
+		SuspensionRepository: pgDB,
+		DeletionRepository:   pgDB,
 
-	mux := runtime.NewServeMux()
+		AccountDeletionGracePeriod: deletionGracePeriod,
+		NicknameRepository:         pgDB,
+		NicknameChangeCooldown:     nicknameCooldown,
+		NicknameReservation:        nicknameReservation,
+		NicknameMinLength:          nicknameMinLength,
+		NicknameMaxLength:          nicknameMaxLength,
+		ReservedNicknames:          reservedNicknames,
+		ProfaneWords:               profaneWords,
+		DisposableEmailDomains:     disposableEmailDomains,
+	})
+	userServer := grpcactor.NewUserService(grpcactor.UserServiceArgs{
+		Usecase: authz.NewUserService(authz.UserServiceArgs{Usecase: userSvcUsecase, FieldPolicy: fieldPolicy}),
+	})
+	authenticator, err := newAuthenticator(ctx, tokenIssuer)
+	if err != nil {
+		log.WithError(err).Error("error instantiating authenticator")
+		return err
+	}
+	tlsConfigs, err := newTLSConfigs()
+	if err != nil {
+		log.WithError(err).Error("error loading TLS configuration")
+		return err
+	}
+	var gatewayIdentities []string
+	if tlsConfigs != nil {
+		gatewayIdentities = tlsConfigs.gatewayIdentities
+	}
+	peerIdentities, err := newPeerIdentities(gatewayIdentities)
+	if err != nil {
+		log.WithError(err).Error("error parsing MTLS_IDENTITIES")
+		return err
+	}
+	authInterceptor := grpcactor.NewAuthInterceptor(grpcactor.AuthInterceptorArgs{
+		Authenticator: authenticator,
+		PublicMethods: []string{
+			pb.HealthService_Healthz_FullMethodName,
+			pb.UserService_Login_FullMethodName,
+			pb.UserService_RefreshSession_FullMethodName,
+			pb.UserService_CompleteLogin_FullMethodName,
+		},
+		PeerIdentities:      peerIdentities,
+		GatewayIdentities:   gatewayIdentities,
+		APIKeyAuthenticator: userSvcUsecase,
+	})
+	rateLimitInterceptor, err := newRateLimitInterceptor(ctx, pgDB)
+	if err != nil {
+		log.WithError(err).Error("error instantiating rate limiter")
+		return err
+	}
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(grpcactor.RequestMetadataUnaryInterceptor, authInterceptor.Unary, rateLimitInterceptor.Unary),
+		grpc.ChainStreamInterceptor(authInterceptor.Stream, rateLimitInterceptor.Stream),
+	}
+	httpServer := &http.Server{Addr: *httpServerEndpoint}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		runtime.WithMetadata(grpcactor.GatewayClientIdentity),
+	)
 	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if tlsConfigs != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfigs.grpcServer)))
+		httpServer.TLSConfig = tlsConfigs.httpServer
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfigs.gateway))}
+	}
+	httpServer.Handler = mux
 
 	err = pb.RegisterUserServiceHandlerFromEndpoint(ctx, mux, *grpcServerEndpoint, opts)
 	if err != nil {
@@ -80,7 +248,7 @@ func run() error {
 	}
 
 	go func() {
-		if err := http.ListenAndServe(*httpServerEndpoint, mux); err != nil {
+		if err := listenAndServe(httpServer); err != nil {
 			panic(err)
 		}
 	}()
@@ -90,7 +258,7 @@ func run() error {
 		return err
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(serverOpts...)
 	pb.RegisterUserServiceServer(s, userServer)
 	pb.RegisterHealthServiceServer(s, &grpcactor.HealthService{})
 
@@ -120,6 +288,335 @@ func run() error {
 	return nil
 }
 
+// gatewayHeaderMatcher forwards the request id and the API key of the HTTP calls to the gRPC server, besides the
+// default headers. The Authorization header is always forwarded by the gateway as the authorization metadata. The
+// identity of the HTTP client is set by the gateway from its certificate only, it is never forwarded from the headers.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "X-Request-Id") {
+		return "x-request-id", true
+	}
+	if strings.EqualFold(key, "X-Api-Key") {
+		return "x-api-key", true
+	}
+	forwarded, ok := runtime.DefaultHeaderMatcher(key)
+	if strings.EqualFold(forwarded, grpcactor.ForwardedClientIdentityHeader) {
+		return "", false
+	}
+	return forwarded, ok
+}
+
+// newAuthenticator builds the verifier of the bearer tokens against the key of the token issuer of the service and,
+// if any, the JWKS in the JWT_JWKS_FILE file or served at JWT_JWKS_URL. The iss and aud claims are verified against
+// JWT_ISSUER and JWT_AUDIENCE when set.
+func newAuthenticator(ctx context.Context, tokenIssuer *auth.JWTIssuer) (*auth.JWTAuthenticator, error) {
+	var keySet *auth.JWKS
+	var err error
+	switch {
+	case os.Getenv("JWT_JWKS_FILE") != "":
+		keySet, err = auth.NewFileJWKS(os.Getenv("JWT_JWKS_FILE"))
+	case os.Getenv("JWT_JWKS_URL") != "":
+		keySet, err = auth.NewRemoteJWKS(ctx, os.Getenv("JWT_JWKS_URL"), nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return auth.NewJWTAuthenticator(auth.JWTAuthenticatorArgs{
+		KeySet:   keySet,
+		Keys:     tokenIssuer.Keys(),
+		Issuer:   os.Getenv("JWT_ISSUER"),
+		Audience: os.Getenv("JWT_AUDIENCE"),
+	})
+}
+
+// newTokenIssuer builds the issuer of the access tokens of the sessions, signed with the key in
+// SESSION_SIGNING_KEY_FILE, valid for ACCESS_TOKEN_TTL (15m by default) and carrying JWT_ISSUER and JWT_AUDIENCE.
+// If no key is configured an ephemeral one is generated, the access tokens are then only accepted by the replica that
+// issued them and until it restarts.
+func newTokenIssuer() (*auth.JWTIssuer, error) {
+	var privateKey ed25519.PrivateKey
+	if keyFile := os.Getenv("SESSION_SIGNING_KEY_FILE"); keyFile != "" {
+		key, err := signer.LoadEd25519PrivateKey(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	} else {
+		log.Warn("SESSION_SIGNING_KEY_FILE not set, access tokens will be signed with an ephemeral key")
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	}
+	var ttl time.Duration
+	if encoded := os.Getenv("ACCESS_TOKEN_TTL"); encoded != "" {
+		var err error
+		if ttl, err = time.ParseDuration(encoded); err != nil {
+			return nil, fmt.Errorf("error parsing ACCESS_TOKEN_TTL: %w", err)
+		}
+	}
+	return auth.NewJWTIssuer(auth.JWTIssuerArgs{
+		PrivateKey: privateKey,
+		Issuer:     os.Getenv("JWT_ISSUER"),
+		Audience:   os.Getenv("JWT_AUDIENCE"),
+		TTL:        ttl,
+	})
+}
+
+// defaultRateLimits are the limits of every client when RATE_LIMITS is not set.
+var defaultRateLimits = map[string]model.RateLimit{
+	grpcactor.DefaultMethodLimit:                {Rate: 20, Burst: 40},
+	pb.UserService_CreateUser_FullMethodName:    {Rate: 1, Burst: 5},
+	pb.UserService_ListUsers_FullMethodName:     {Rate: 5, Burst: 10},
+	pb.UserService_Login_FullMethodName:         {Rate: 1, Burst: 10},
+	pb.UserService_CompleteLogin_FullMethodName: {Rate: 1, Burst: 10},
+	// the nicknames are checked as the users type them, the limit stops the enumeration of the nicknames.
+	pb.UserService_CheckNicknameAvailability_FullMethodName: {Rate: 2, Burst: 10},
+}
+
+// rateLimitSweepInterval is how often the full token buckets are removed from Postgres.
+const rateLimitSweepInterval = 10 * time.Minute
+
+// newRateLimitInterceptor builds the rate limiter. RATE_LIMITS is a JSON object of the limits by full method name
+// (defaults to defaultRateLimits) and RATE_LIMIT_IDENTITIES one of the limits overridden by client key, e.g.
+// {"sub:spiffe://faceittha/backoffice": {"rate": 100, "burst": 200}}. The token buckets are kept in memory, per
+// replica, unless RATE_LIMIT_STORE is postgres.
+func newRateLimitInterceptor(ctx context.Context, pgDB *postgres.PostgresDB) (*grpcactor.RateLimitInterceptor, error) {
+	methodLimits := defaultRateLimits
+	if encoded := os.Getenv("RATE_LIMITS"); encoded != "" {
+		methodLimits = nil
+		if err := json.Unmarshal([]byte(encoded), &methodLimits); err != nil {
+			return nil, fmt.Errorf("error parsing RATE_LIMITS: %w", err)
+		}
+	}
+	var identityLimits map[string]model.RateLimit
+	if encoded := os.Getenv("RATE_LIMIT_IDENTITIES"); encoded != "" {
+		if err := json.Unmarshal([]byte(encoded), &identityLimits); err != nil {
+			return nil, fmt.Errorf("error parsing RATE_LIMIT_IDENTITIES: %w", err)
+		}
+	}
+
+	args := grpcactor.RateLimitInterceptorArgs{MethodLimits: methodLimits, IdentityLimits: identityLimits}
+	switch store := os.Getenv("RATE_LIMIT_STORE"); store {
+	case "", "memory":
+		args.Store = ratelimit.NewMemoryStore()
+	case "postgres":
+		args.Store = pgDB
+		go func() {
+			ticker := time.NewTicker(rateLimitSweepInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if _, err := pgDB.DeleteFullRateLimitBuckets(ctx); err != nil {
+						log.WithError(err).Error("error removing full rate limit buckets")
+					}
+				}
+			}
+		}()
+	default:
+		return nil, fmt.Errorf("unknown RATE_LIMIT_STORE %q", store)
+	}
+	return grpcactor.NewRateLimitInterceptor(args), nil
+}
+
+// tlsConfigs are the TLS configurations of the listeners and of the connection of the gateway to the gRPC server.
+type tlsConfigs struct {
+	grpcServer *tls.Config
+	httpServer *tls.Config
+	gateway    *tls.Config
+
+	// gatewayIdentities are the URI and DNS SANs of the client certificate of the gateway.
+	gatewayIdentities []string
+}
+
+// newTLSConfigs builds the TLS configurations from the environment, nil is returned when TLS_CERT_FILE is not set and
+// the servers must be served in plaintext.
+// Both listeners serve the TLS_CERT_FILE and TLS_KEY_FILE key pair, and verify the client certificates against
+// TLS_CLIENT_CA_FILE when set. Client certificates are only mandatory if GRPC_TLS_REQUIRE_CLIENT_CERT, respectively
+// HTTP_TLS_REQUIRE_CLIENT_CERT, is true. The gateway verifies the gRPC server against TLS_CA_FILE, or the system roots,
+// and presents TLS_GATEWAY_CERT_FILE and TLS_GATEWAY_KEY_FILE, which default to the server key pair.
+func newTLSConfigs() (*tlsConfigs, error) {
+	certFile, keyFile := os.Getenv("TLS_CERT_FILE"), os.Getenv("TLS_KEY_FILE")
+	if certFile == "" {
+		log.Warn("TLS_CERT_FILE not set, serving in plaintext")
+		return nil, nil
+	}
+	grpcServer, err := tlsconfig.NewServerConfig(tlsconfig.ServerConfigArgs{
+		CertFile:          certFile,
+		KeyFile:           keyFile,
+		ClientCAFile:      os.Getenv("TLS_CLIENT_CA_FILE"),
+		RequireClientCert: os.Getenv("GRPC_TLS_REQUIRE_CLIENT_CERT") == "true",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading gRPC server TLS configuration: %w", err)
+	}
+	httpServer, err := tlsconfig.NewServerConfig(tlsconfig.ServerConfigArgs{
+		CertFile:          certFile,
+		KeyFile:           keyFile,
+		ClientCAFile:      os.Getenv("TLS_CLIENT_CA_FILE"),
+		RequireClientCert: os.Getenv("HTTP_TLS_REQUIRE_CLIENT_CERT") == "true",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading HTTP server TLS configuration: %w", err)
+	}
+	gatewayCertFile, gatewayKeyFile := os.Getenv("TLS_GATEWAY_CERT_FILE"), os.Getenv("TLS_GATEWAY_KEY_FILE")
+	if gatewayCertFile == "" {
+		gatewayCertFile, gatewayKeyFile = certFile, keyFile
+	}
+	gateway, err := tlsconfig.NewClientConfig(tlsconfig.ClientConfigArgs{
+		CertFile:   gatewayCertFile,
+		KeyFile:    gatewayKeyFile,
+		RootCAFile: os.Getenv("TLS_CA_FILE"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading gateway TLS configuration: %w", err)
+	}
+	gatewayIdentities, err := certificateSANs(gatewayCertFile, gatewayKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading gateway certificate: %w", err)
+	}
+	return &tlsConfigs{grpcServer: grpcServer, httpServer: httpServer, gateway: gateway, gatewayIdentities: gatewayIdentities}, nil
+}
+
+// certificateSANs returns the URI and DNS SANs of the certificate of the key pair.
+func certificateSANs(certFile, keyFile string) ([]string, error) {
+	keyPair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	sans := make([]string, 0, len(cert.URIs)+len(cert.DNSNames))
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	return append(sans, cert.DNSNames...), nil
+}
+
+// listenAndServe serves HTTPS if the server has a TLS configuration, HTTP otherwise.
+func listenAndServe(server *http.Server) error {
+	if server.TLSConfig != nil {
+		return server.ListenAndServeTLS("", "")
+	}
+	return server.ListenAndServe()
+}
+
+// newPeerIdentities parses MTLS_IDENTITIES, a JSON object mapping the SANs of the client certificates of the internal
+// services to their roles, e.g. {"spiffe://faceittha/backoffice": ["support"]}. The SANs of the gateway certificate are
+// rejected, the anonymous HTTP calls would otherwise be granted their roles.
+func newPeerIdentities(gatewayIdentities []string) (map[string][]model.Role, error) {
+	encoded := os.Getenv("MTLS_IDENTITIES")
+	if encoded == "" {
+		return nil, nil
+	}
+	var identities map[string][]model.Role
+	if err := json.Unmarshal([]byte(encoded), &identities); err != nil {
+		return nil, err
+	}
+	for _, san := range gatewayIdentities {
+		if _, ok := identities[san]; ok {
+			return nil, fmt.Errorf("%q is a SAN of the gateway certificate and cannot be mapped to roles", san)
+		}
+	}
+	return identities, nil
+}
+
+// newAttributeSchemas parses USER_ATTRIBUTES, a JSON object mapping the keys of the custom profile attributes to the
+// JSON schema of their values, e.g. {"team": {"type": "string", "maxLength": 32}}.
+func newAttributeSchemas() (map[string]model.AttributeSchema, error) {
+	encoded := os.Getenv("USER_ATTRIBUTES")
+	if encoded == "" {
+		return nil, nil
+	}
+	var schemas map[string]model.AttributeSchema
+	if err := json.Unmarshal([]byte(encoded), &schemas); err != nil {
+		return nil, err
+	}
+	for key, schema := range schemas {
+		if err := checkAttributeSchema(schema); err != nil {
+			return nil, fmt.Errorf("invalid schema of attribute %q: %w", key, err)
+		}
+	}
+	return schemas, nil
+}
+
+// newFieldPolicy parses PII_FIELD_ROLES, a JSON object mapping the personal fields of the users to the roles granted
+// them, e.g. {"email": ["admin"], "country": ["support", "admin"]}. The fields not listed are never masked.
+func newFieldPolicy() (model.FieldPolicy, error) {
+	encoded := os.Getenv("PII_FIELD_ROLES")
+	if encoded == "" {
+		return nil, nil
+	}
+	var policy model.FieldPolicy
+	if err := json.Unmarshal([]byte(encoded), &policy); err != nil {
+		return nil, err
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// readWordList reads a list of words, one per line. Blank lines and lines starting with # are skipped.
+func readWordList(file string) ([]string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	words := []string{}
+	for _, line := range strings.Split(string(content), "\n") {
+		if word := strings.TrimSpace(line); word != "" && !strings.HasPrefix(word, "#") {
+			words = append(words, word)
+		}
+	}
+	return words, nil
+}
+
+// checkAttributeSchema rejects the schemas using an unsupported type or an invalid pattern.
+func checkAttributeSchema(schema model.AttributeSchema) error {
+	switch schema.Type {
+	case "string", "number", "integer", "boolean":
+	case "array":
+		if schema.Items != nil {
+			return checkAttributeSchema(*schema.Items)
+		}
+	default:
+		return fmt.Errorf("unsupported type %q", schema.Type)
+	}
+	if schema.Pattern != "" {
+		if _, err := regexp.Compile(schema.Pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newExportSigner builds the signer of the data exports from the key in EXPORT_SIGNING_KEY_FILE.
+// If no key is configured an ephemeral one is generated, signatures will then not be verifiable across restarts.
+func newExportSigner() (*signer.Ed25519Signer, error) {
+	var privateKey ed25519.PrivateKey
+	if keyFile := os.Getenv("EXPORT_SIGNING_KEY_FILE"); keyFile != "" {
+		key, err := signer.LoadEd25519PrivateKey(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	} else {
+		log.Warn("EXPORT_SIGNING_KEY_FILE not set, data exports will be signed with an ephemeral key")
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	}
+	return signer.NewEd25519Signer(signer.Ed25519SignerArgs{PrivateKey: privateKey})
+}
+
 func main() {
 	flag.Parse()
 
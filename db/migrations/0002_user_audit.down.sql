@@ -0,0 +1 @@
+DROP TABLE faceittha.user_audit;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- audit trail of the operations performed on users. user_id is deliberately not a foreign key
+-- as the trail must outlive hard-deleted users.
+CREATE TABLE IF NOT EXISTS faceittha.user_audit (
+    id UUID DEFAULT uuid_generate_v4() NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    actor_id TEXT NOT NULL,
+    action TEXT NOT NULL,
+    occurred_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- index used for efficient querying of the trail of a given user
+CREATE INDEX IF NOT EXISTS idx_user_audit_user_id_occurred_at ON faceittha.user_audit (user_id, occurred_at);
+
+COMMIT;
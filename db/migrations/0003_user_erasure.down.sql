@@ -0,0 +1,2 @@
+DROP TABLE faceittha.user_data_keys;
+ALTER TABLE faceittha.users DROP COLUMN erased_at;
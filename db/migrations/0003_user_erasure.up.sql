@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS erased_at TIMESTAMP;
+
+-- per-user data keys wrapped by the master key. Destroying a key (crypto-shredding) makes every encrypted copy of
+-- the user personal data unreadable, including the ones in CDC payloads and database backups. This table should live
+-- in a database with a short backup retention (see DATA_KEYS_POSTGRESQL_URL), otherwise the keys outlive the erasure in the backups.
+CREATE TABLE IF NOT EXISTS faceittha.user_data_keys (
+    user_id UUID NOT NULL PRIMARY KEY,
+    wrapped_key BYTEA,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    destroyed_at TIMESTAMP
+);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS user_audit_append_only ON faceittha.user_audit;
+DROP FUNCTION IF EXISTS faceittha.reject_user_audit_change();
+
+ALTER TABLE faceittha.user_audit
+    DROP COLUMN IF EXISTS changed_fields,
+    DROP COLUMN IF EXISTS source_ip,
+    DROP COLUMN IF EXISTS request_id;
+
+COMMIT;
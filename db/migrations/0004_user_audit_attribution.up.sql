@@ -0,0 +1,22 @@
+BEGIN;
+
+-- attribution of the audited operations to the request that performed them.
+-- changed_fields holds the names of the modified fields only, values are never recorded.
+ALTER TABLE faceittha.user_audit
+    ADD COLUMN IF NOT EXISTS request_id TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS source_ip TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS changed_fields TEXT[];
+
+-- the audit trail is append-only: entries can neither be modified nor removed.
+CREATE OR REPLACE FUNCTION faceittha.reject_user_audit_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'faceittha.user_audit is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS user_audit_append_only ON faceittha.user_audit;
+CREATE TRIGGER user_audit_append_only
+    BEFORE UPDATE OR DELETE ON faceittha.user_audit
+    FOR EACH ROW EXECUTE FUNCTION faceittha.reject_user_audit_change();
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS users_history ON faceittha.users;
+DROP FUNCTION IF EXISTS faceittha.record_user_history();
+DROP TABLE IF EXISTS faceittha.users_history;
+
+COMMIT;
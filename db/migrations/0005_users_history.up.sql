@@ -0,0 +1,71 @@
+BEGIN;
+
+-- temporal table holding every version of the users. A version is valid in [valid_from, valid_to), the current
+-- version has a NULL valid_to. Password hashes are deliberately not versioned.
+CREATE TABLE IF NOT EXISTS faceittha.users_history (
+    version_id UUID DEFAULT uuid_generate_v4() NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    first_name TEXT NOT NULL,
+    last_name TEXT NOT NULL,
+    nickname TEXT NOT NULL,
+    email TEXT NOT NULL,
+    country TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP,
+    erased_at TIMESTAMP,
+    valid_from TIMESTAMP NOT NULL,
+    valid_to TIMESTAMP
+);
+
+-- index used for point-in-time reads and for listing the versions of a given user
+CREATE INDEX IF NOT EXISTS idx_users_history_user_id_valid_from ON faceittha.users_history (user_id, valid_from);
+
+-- the history is maintained on every change of faceittha.users, whatever the writer.
+CREATE OR REPLACE FUNCTION faceittha.record_user_history() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        UPDATE faceittha.users_history SET valid_to = NOW() AT TIME ZONE 'UTC'
+            WHERE user_id = OLD.id AND valid_to IS NULL;
+        RETURN OLD;
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        -- changes of the password only do not make a new version
+        IF (OLD.first_name, OLD.last_name, OLD.nickname, OLD.email, OLD.country, OLD.deleted_at, OLD.erased_at)
+            IS NOT DISTINCT FROM
+           (NEW.first_name, NEW.last_name, NEW.nickname, NEW.email, NEW.country, NEW.deleted_at, NEW.erased_at) THEN
+            RETURN NEW;
+        END IF;
+
+        IF NEW.erased_at IS NOT NULL AND OLD.erased_at IS NULL THEN
+            -- the versions preceding an erasure hold personal data and are purged with it
+            DELETE FROM faceittha.users_history WHERE user_id = NEW.id;
+        ELSE
+            UPDATE faceittha.users_history SET valid_to = NEW.updated_at
+                WHERE user_id = NEW.id AND valid_to IS NULL;
+        END IF;
+    END IF;
+
+    INSERT INTO faceittha.users_history
+        (user_id, first_name, last_name, nickname, email, country, created_at, updated_at, deleted_at, erased_at, valid_from)
+    VALUES
+        (NEW.id, NEW.first_name, NEW.last_name, NEW.nickname, NEW.email, NEW.country, NEW.created_at, NEW.updated_at,
+         NEW.deleted_at, NEW.erased_at, NEW.updated_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS users_history ON faceittha.users;
+CREATE TRIGGER users_history
+    AFTER INSERT OR UPDATE OR DELETE ON faceittha.users
+    FOR EACH ROW EXECUTE FUNCTION faceittha.record_user_history();
+
+-- users existing before the introduction of the history start with their current state.
+INSERT INTO faceittha.users_history
+    (user_id, first_name, last_name, nickname, email, country, created_at, updated_at, deleted_at, erased_at, valid_from)
+SELECT id, first_name, last_name, nickname, email, country, created_at, updated_at, deleted_at, erased_at, updated_at
+FROM faceittha.users
+WHERE NOT EXISTS (SELECT 1 FROM faceittha.users_history h WHERE h.user_id = users.id);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- token buckets of the rate limiter shared by the server replicas. A bucket is stored as its theoretical arrival time
+-- (tat, see GCRA): the time at which it is full again. Buckets whose tat is in the past are full and can be removed.
+-- the state is disposable, the table is therefore not WAL-logged.
+CREATE UNLOGGED TABLE IF NOT EXISTS faceittha.rate_limits (
+    key TEXT PRIMARY KEY,
+    tat TIMESTAMPTZ NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS locked_until;
+DROP TABLE IF EXISTS faceittha.credential_failures;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+-- counters of the consecutive failed credential checks, per account (scope 'user', key is the user id) and per source
+-- IP (scope 'ip'). Counters whose last failure is old enough are restarted.
+CREATE TABLE IF NOT EXISTS faceittha.credential_failures (
+    scope TEXT NOT NULL,
+    key TEXT NOT NULL,
+    failures INTEGER NOT NULL,
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP,
+    PRIMARY KEY (scope, key)
+);
+
+-- the lock of an account is mirrored in the users table so that it is captured by CDC and announced with a
+-- security event. Locks do not change the user versions.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP;
+
+COMMIT;
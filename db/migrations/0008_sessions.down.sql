@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS faceittha.refresh_tokens;
+DROP TABLE IF EXISTS faceittha.sessions;
+DROP INDEX IF EXISTS faceittha.idx_users_email_index;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS email_index;
+
+COMMIT;
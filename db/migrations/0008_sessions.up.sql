@@ -0,0 +1,32 @@
+BEGIN;
+
+-- blind index of the normalized email, allowing the users to log in by email while the email itself is sealed. The index
+-- of the users created before its introduction is computed by the cmd/reindexemails job, see ReindexEmails.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS email_index TEXT;
+CREATE INDEX IF NOT EXISTS idx_users_email_index ON faceittha.users (email_index);
+
+-- logins of the users, one per device. A session stays alive as long as its refresh tokens are used before expires_at.
+CREATE TABLE IF NOT EXISTS faceittha.sessions (
+    id UUID DEFAULT uuid_generate_v4() NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    user_agent TEXT NOT NULL,
+    source_ip TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    refreshed_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP
+);
+
+-- index used for listing the sessions of a given user
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON faceittha.sessions (user_id);
+
+-- the refresh tokens of the sessions, by SHA-256 digest. Every token can be used once, the tokens used already are
+-- kept to detect their reuse.
+CREATE TABLE IF NOT EXISTS faceittha.refresh_tokens (
+    token_hash TEXT NOT NULL PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES faceittha.sessions (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP
+);
+
+COMMIT;
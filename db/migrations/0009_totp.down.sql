@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS faceittha.login_challenges;
+DROP TABLE IF EXISTS faceittha.recovery_codes;
+DROP TABLE IF EXISTS faceittha.totp_credentials;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS totp_enabled_at;
+
+COMMIT;
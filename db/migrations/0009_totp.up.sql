@@ -0,0 +1,34 @@
+BEGIN;
+
+-- time at which the user enabled the TOTP second factor, captured by CDC so that consumers learn about it.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS totp_enabled_at TIMESTAMP;
+
+-- the TOTP secrets of the users, sealed with their data key. A credential is pending until confirmed_at is set.
+CREATE TABLE IF NOT EXISTS faceittha.totp_credentials (
+    user_id UUID NOT NULL PRIMARY KEY REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    confirmed_at TIMESTAMP,
+    last_used_step BIGINT NOT NULL DEFAULT 0
+);
+
+-- the one-time recovery codes of the users, by SHA-256 digest.
+CREATE TABLE IF NOT EXISTS faceittha.recovery_codes (
+    user_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMP,
+    PRIMARY KEY (user_id, code_hash)
+);
+
+-- the pending second steps of the logins, by SHA-256 digest of the challenge.
+CREATE TABLE IF NOT EXISTS faceittha.login_challenges (
+    challenge_hash TEXT NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+-- index used for purging the expired challenges of a given user
+CREATE INDEX IF NOT EXISTS idx_login_challenges_user_id ON faceittha.login_challenges (user_id);
+
+COMMIT;
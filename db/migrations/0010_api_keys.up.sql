@@ -0,0 +1,16 @@
+BEGIN;
+
+-- the API keys of the services calling the API on their own behalf, by SHA-256 digest. Scopes are the roles granted to
+-- their holders.
+CREATE TABLE IF NOT EXISTS faceittha.api_keys (
+    id UUID DEFAULT uuid_generate_v4() NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS faceittha.user_roles;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS roles;
+
+COMMIT;
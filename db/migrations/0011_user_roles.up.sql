@@ -0,0 +1,16 @@
+BEGIN;
+
+-- the roles granted to the users.
+CREATE TABLE IF NOT EXISTS faceittha.user_roles (
+    user_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    granted_by TEXT NOT NULL,
+    granted_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, role)
+);
+
+-- the roles of a user are mirrored in the users table so that their changes are captured by CDC. Role changes do not
+-- change the user versions.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS roles TEXT[] NOT NULL DEFAULT '{}';
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS attributes;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- the custom profile attributes of the users, by key. The keys and the values are validated by the service against
+-- its attribute registry. Attributes are captured by CDC but do not change the user versions.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+
+COMMIT;
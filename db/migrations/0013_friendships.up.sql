@@ -0,0 +1,23 @@
+BEGIN;
+
+-- the friendships between users, pending until the addressee accepts the friend request of the requester. Declined
+-- requests and removed friendships are deleted.
+CREATE TABLE IF NOT EXISTS faceittha.friendships (
+    requester_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    addressee_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP,
+    PRIMARY KEY (requester_id, addressee_id),
+    CHECK (requester_id <> addressee_id)
+);
+
+-- two users have a single friendship, whatever its direction
+CREATE UNIQUE INDEX IF NOT EXISTS friendships_pair_idx ON faceittha.friendships
+    (LEAST(requester_id, addressee_id), GREATEST(requester_id, addressee_id));
+CREATE INDEX IF NOT EXISTS friendships_addressee_id_idx ON faceittha.friendships (addressee_id);
+
+-- needed for proper replication in CDC, the deletions carry the friendship
+ALTER TABLE faceittha.friendships REPLICA IDENTITY FULL;
+
+COMMIT;
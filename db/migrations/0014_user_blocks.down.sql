@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS faceittha.user_blocks;
+
+COMMIT;
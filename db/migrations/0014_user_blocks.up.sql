@@ -0,0 +1,14 @@
+BEGIN;
+
+-- the users blocked by other users. Blocked users cannot send friend requests to the users blocking them.
+CREATE TABLE IF NOT EXISTS faceittha.user_blocks (
+    blocker_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_blocks_blocked_id_idx ON faceittha.user_blocks (blocked_id);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS faceittha.user_suspensions;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS suspension_reason;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS suspended_at;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS suspended_until;
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN;
+
+-- the history of the suspensions of the users. A suspension without expiry is a ban. Suspensions end when they are
+-- lifted, lifted_by is then set, or when the worker processes their expiry.
+CREATE TABLE IF NOT EXISTS faceittha.user_suspensions (
+    id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    note TEXT NOT NULL,
+    issued_by TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP,
+    ended_at TIMESTAMP,
+    lifted_by TEXT
+);
+
+CREATE INDEX IF NOT EXISTS user_suspensions_user_id_created_at_idx ON faceittha.user_suspensions (user_id, created_at);
+
+-- a user has at most one suspension in force.
+CREATE UNIQUE INDEX IF NOT EXISTS user_suspensions_in_force_idx ON faceittha.user_suspensions (user_id)
+    WHERE ended_at IS NULL;
+
+-- index used by the worker to find the expired suspensions.
+CREATE INDEX IF NOT EXISTS user_suspensions_expires_at_idx ON faceittha.user_suspensions (expires_at)
+    WHERE ended_at IS NULL AND expires_at IS NOT NULL;
+
+-- the suspension in force is mirrored in the users table so that its changes are captured by CDC. Suspensions do not
+-- change the user versions.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS suspension_reason TEXT;
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS suspended_at TIMESTAMP;
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMP;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS faceittha.users_status_idx;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS status;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS verified_at;
+
+COMMIT;
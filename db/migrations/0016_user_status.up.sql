@@ -0,0 +1,15 @@
+BEGIN;
+
+-- the status of the account of the users. Its transitions are enforced by the service. Users existing before the
+-- introduction of the status are deemed verified.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS verified_at TIMESTAMP;
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'active'
+    CHECK (status IN ('pending_verification', 'active', 'suspended', 'scheduled_for_deletion', 'deleted'));
+UPDATE faceittha.users SET verified_at = created_at WHERE verified_at IS NULL;
+UPDATE faceittha.users SET status = 'suspended' WHERE suspended_at IS NOT NULL;
+UPDATE faceittha.users SET status = 'deleted' WHERE deleted_at IS NOT NULL;
+ALTER TABLE faceittha.users ALTER COLUMN status SET DEFAULT 'pending_verification';
+
+CREATE INDEX IF NOT EXISTS users_status_idx ON faceittha.users (status);
+
+COMMIT;
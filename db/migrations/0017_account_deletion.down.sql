@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS faceittha.users_deletion_scheduled_for_idx;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS deletion_scheduled_for;
+
+COMMIT;
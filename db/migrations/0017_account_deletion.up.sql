@@ -0,0 +1,10 @@
+BEGIN;
+
+-- the time at which the deletion requested by the user is carried out by the worker. Set while the user is scheduled
+-- for deletion only.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS deletion_scheduled_for TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS users_deletion_scheduled_for_idx ON faceittha.users (deletion_scheduled_for)
+    WHERE deletion_scheduled_for IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- the history of the nickname changes of the users. The nicknames released recently are reserved to their previous
+-- holder, the lookups are case-insensitive.
+CREATE TABLE IF NOT EXISTS faceittha.nickname_changes (
+    id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES faceittha.users (id) ON DELETE CASCADE,
+    old_nickname TEXT NOT NULL,
+    new_nickname TEXT NOT NULL,
+    changed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS nickname_changes_user_id_idx ON faceittha.nickname_changes (user_id, changed_at DESC);
+CREATE INDEX IF NOT EXISTS nickname_changes_old_nickname_idx ON faceittha.nickname_changes (lower(old_nickname), changed_at);
+
+COMMIT;
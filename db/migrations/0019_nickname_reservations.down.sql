@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP TABLE IF EXISTS faceittha.nickname_reservations;
+
+DROP INDEX IF EXISTS faceittha.nickname_changes_old_nickname_key_idx;
+ALTER TABLE faceittha.nickname_changes DROP COLUMN IF EXISTS old_nickname_key;
+CREATE INDEX IF NOT EXISTS nickname_changes_old_nickname_idx ON faceittha.nickname_changes (lower(old_nickname), changed_at);
+
+DROP INDEX IF EXISTS faceittha.users_nickname_key_idx;
+ALTER TABLE faceittha.users DROP COLUMN IF EXISTS nickname_key;
+
+COMMIT;
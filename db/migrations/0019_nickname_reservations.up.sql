@@ -0,0 +1,23 @@
+BEGIN;
+
+-- the nicknames are compared by a key folding the case and the confusable characters, computed by the service. The
+-- backfill mirrors model.NicknameKey.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS nickname_key TEXT NOT NULL DEFAULT '';
+UPDATE faceittha.users SET nickname_key = translate(lower(nickname), '01i|34@5$78-_. ', 'ollleaasstb');
+CREATE INDEX IF NOT EXISTS users_nickname_key_idx ON faceittha.users (nickname_key);
+
+ALTER TABLE faceittha.nickname_changes ADD COLUMN IF NOT EXISTS old_nickname_key TEXT NOT NULL DEFAULT '';
+UPDATE faceittha.nickname_changes SET old_nickname_key = translate(lower(old_nickname), '01i|34@5$78-_. ', 'ollleaasstb');
+DROP INDEX IF EXISTS faceittha.nickname_changes_old_nickname_idx;
+CREATE INDEX IF NOT EXISTS nickname_changes_old_nickname_key_idx ON faceittha.nickname_changes (old_nickname_key, changed_at);
+
+-- the short-lived holds on the nicknames, claimed by the creation of a user with the token. A nickname has at most one
+-- reservation, the expired ones are taken over.
+CREATE TABLE IF NOT EXISTS faceittha.nickname_reservations (
+    token_hash TEXT NOT NULL PRIMARY KEY,
+    nickname_key TEXT NOT NULL UNIQUE,
+    nickname TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+COMMIT;
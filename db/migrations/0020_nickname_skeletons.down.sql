@@ -0,0 +1,8 @@
+BEGIN;
+
+-- the keys are recomputed as in 0019_nickname_reservations.
+UPDATE faceittha.users SET nickname_key = translate(lower(nickname), '01i|34@5$78-_. ', 'ollleaasstb')
+    WHERE erased_at IS NULL;
+UPDATE faceittha.nickname_changes SET old_nickname_key = translate(lower(old_nickname), '01i|34@5$78-_. ', 'ollleaasstb');
+
+COMMIT;
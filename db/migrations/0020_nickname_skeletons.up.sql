@@ -0,0 +1,10 @@
+BEGIN;
+
+-- the nickname keys become skeletons folding the Cyrillic and Greek homoglyphs and ignoring the invisible characters on
+-- top of the case, the look-alike digits and symbols and the separators. The keys are cleared and recomputed with
+-- model.NicknameKey by the service on startup, see PostgresDB.BackfillNicknameKeys, so that the confusables are not
+-- mirrored here.
+UPDATE faceittha.users SET nickname_key = '' WHERE erased_at IS NULL;
+UPDATE faceittha.nickname_changes SET old_nickname_key = '';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- the privacy setting deciding who can see the public profile of the user: public, friends or private. Empty for the
+-- users who never chose one, whose profile is public.
+ALTER TABLE faceittha.users ADD COLUMN IF NOT EXISTS profile_visibility TEXT NOT NULL DEFAULT '';
+
+COMMIT;
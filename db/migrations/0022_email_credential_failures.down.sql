@@ -0,0 +1,6 @@
+BEGIN;
+
+DELETE FROM faceittha.credential_failures WHERE scope = 'email';
+UPDATE faceittha.users SET locked_until = NULL WHERE locked_until IS NOT NULL;
+
+COMMIT;
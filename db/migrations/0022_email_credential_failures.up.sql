@@ -0,0 +1,8 @@
+BEGIN;
+
+-- the failures of the accounts are counted per email index (scope 'email') instead of per user id (scope 'user'), so
+-- that the unknown emails are locked alike. The counters and the locks of the user ids are dropped.
+DELETE FROM faceittha.credential_failures WHERE scope = 'user';
+UPDATE faceittha.users SET locked_until = NULL WHERE locked_until IS NOT NULL;
+
+COMMIT;
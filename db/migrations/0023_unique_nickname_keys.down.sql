@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS faceittha.users_nickname_key_key;
+CREATE INDEX IF NOT EXISTS users_nickname_key_idx ON faceittha.users (nickname_key);
+
+COMMIT;
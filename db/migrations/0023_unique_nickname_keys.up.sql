@@ -0,0 +1,17 @@
+BEGIN;
+
+-- a nickname key is held by a single user that is not erased, so that two concurrent creations or renames cannot take
+-- look-alike nicknames; the keys cleared for the service to recompute them are left out. The users sharing a key with an
+-- older user, e.g. created before the keys folded the homoglyphs, keep their nickname but get a key suffixed by their id:
+-- the lookups by nickname find the oldest holder, as before, and the duplicates are resolved when they rename.
+UPDATE faceittha.users u SET nickname_key = u.nickname_key || '#' || u.id
+    WHERE u.erased_at IS NULL AND u.nickname_key <> '' AND EXISTS (
+        SELECT 1 FROM faceittha.users o
+            WHERE o.nickname_key = u.nickname_key AND o.erased_at IS NULL
+                AND (o.created_at, o.id) < (u.created_at, u.id)
+    );
+DROP INDEX IF EXISTS faceittha.users_nickname_key_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS users_nickname_key_key ON faceittha.users (nickname_key)
+    WHERE erased_at IS NULL AND nickname_key <> '';
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS faceittha.users_email_index_key;
+CREATE INDEX IF NOT EXISTS idx_users_email_index ON faceittha.users (email_index);
+
+COMMIT;
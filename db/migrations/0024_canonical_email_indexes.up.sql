@@ -0,0 +1,13 @@
+BEGIN;
+
+-- an email is held by a single user. The users sharing the same legacy index, i.e. the same lowercase email, could
+-- already only log in as the oldest of them: the index of the others is cleared so that the index can be unique, and
+-- they are reported as collisions to be resolved manually by the cmd/reindexemails job, which re-indexes the users to
+-- their canonical email after the migrations, since the indexes are blinded with the vault master key.
+UPDATE faceittha.users AS u SET email_index = NULL
+FROM faceittha.users AS o
+WHERE u.email_index = o.email_index AND (o.created_at, o.id) < (u.created_at, u.id);
+DROP INDEX IF EXISTS faceittha.idx_users_email_index;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_index_key ON faceittha.users (email_index);
+
+COMMIT;
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// defaultAccessTokenTTL is the lifetime of the access tokens when JWTIssuerArgs.TTL is not set.
+const defaultAccessTokenTTL = 15 * time.Minute
+
+// JWTIssuerArgs are the arguments for the creation of a JWTIssuer.
+type JWTIssuerArgs struct {
+	// PrivateKey is the key the tokens are signed with.
+	PrivateKey ed25519.PrivateKey
+
+	// Issuer is the iss claim of the tokens. Optional.
+	Issuer string
+
+	// Audience is the aud claim of the tokens. Optional.
+	Audience string
+
+	// TTL is the lifetime of the tokens. Defaults to 15 minutes.
+	TTL time.Duration
+}
+
+// NewJWTIssuer creates a new JWTIssuer. The key id is derived from the public key fingerprint.
+func NewJWTIssuer(args JWTIssuerArgs) (*JWTIssuer, error) {
+	if len(args.PrivateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid ed25519 private key")
+	}
+	ttl := args.TTL
+	if ttl <= 0 {
+		ttl = defaultAccessTokenTTL
+	}
+	fingerprint := sha256.Sum256(args.PrivateKey.Public().(ed25519.PublicKey))
+	return &JWTIssuer{
+		privateKey: args.PrivateKey,
+		keyID:      hex.EncodeToString(fingerprint[:8]),
+		issuer:     args.Issuer,
+		audience:   args.Audience,
+		ttl:        ttl,
+	}, nil
+}
+
+// JWTIssuer issues short-lived EdDSA signed access tokens, verifiable by a JWTAuthenticator trusting its Keys.
+type JWTIssuer struct {
+	privateKey ed25519.PrivateKey
+	keyID      string
+	issuer     string
+	audience   string
+	ttl        time.Duration
+}
+
+// Keys returns the public key of the issuer by key id.
+func (i *JWTIssuer) Keys() map[string]crypto.PublicKey {
+	return map[string]crypto.PublicKey{i.keyID: i.privateKey.Public()}
+}
+
+// IssueAccessToken issues a token whose subject is the actor, carrying its roles and the session id (sid claim).
+func (i *JWTIssuer) IssueAccessToken(_ context.Context, actor model.Actor, sessionID uuid.UUID) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(i.ttl)
+	c := sessionClaims{
+		claims: claims{RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   actor.ID,
+			Issuer:    i.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			ID:        uuid.NewString(),
+		}},
+		SessionID: sessionID.String(),
+	}
+	if i.audience != "" {
+		c.Audience = jwt.ClaimStrings{i.audience}
+	}
+	for _, role := range actor.Roles {
+		c.Roles = append(c.Roles, string(role))
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, c)
+	token.Header["kid"] = i.keyID
+	signed, err := token.SignedString(i.privateKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error signing access token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+// sessionClaims are the claims of the access tokens of the sessions.
+type sessionClaims struct {
+	claims
+	SessionID string `json:"sid"`
+}
@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTIssuer_IssueAccessToken(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	issuer, err := NewJWTIssuer(JWTIssuerArgs{PrivateKey: privateKey, Issuer: "issuer", Audience: "faceittha", TTL: time.Minute})
+	require.NoError(t, err)
+	authenticator, err := NewJWTAuthenticator(JWTAuthenticatorArgs{Keys: issuer.Keys(), Issuer: "issuer", Audience: "faceittha"})
+	require.NoError(t, err)
+
+	sessionID := uuid.New()
+	token, expiresAt, err := issuer.IssueAccessToken(context.Background(), model.Actor{ID: "user-1", Roles: []model.Role{model.RoleSupport}}, sessionID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), expiresAt, time.Second)
+
+	actor, err := authenticator.Authenticate(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, model.Actor{ID: "user-1", Roles: []model.Role{model.RoleSupport}}, actor)
+
+	var c jwt.MapClaims
+	_, _, err = jwt.NewParser().ParseUnverified(token, &c)
+	require.NoError(t, err)
+	assert.Equal(t, sessionID.String(), c["sid"])
+
+	// tokens of other issuers are rejected
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	other, err := NewJWTIssuer(JWTIssuerArgs{PrivateKey: otherKey, Issuer: "issuer", Audience: "faceittha"})
+	require.NoError(t, err)
+	token, _, err = other.IssueAccessToken(context.Background(), model.Actor{ID: "user-1"}, sessionID)
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate(context.Background(), token)
+	assert.ErrorIs(t, err, model.ErrUnauthenticated)
+}
+
+func TestNewJWTIssuer_InvalidKey(t *testing.T) {
+	_, err := NewJWTIssuer(JWTIssuerArgs{PrivateKey: ed25519.PrivateKey("short")})
+	assert.Error(t, err)
+}
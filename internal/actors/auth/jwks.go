@@ -0,0 +1,222 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrKeyNotFound is returned when the key set holds no key with the requested id.
+var ErrKeyNotFound = errors.New("key not found in key set")
+
+// minRefreshInterval bounds how often a remote key set is fetched when tokens reference unknown keys.
+const minRefreshInterval = time.Minute
+
+// JWKS is a JSON Web Key Set (RFC 7517) the token signatures are verified against.
+// Only signature keys of type RSA, EC (P-256, P-384, P-521) and OKP (Ed25519) are retained.
+type JWKS struct {
+	fetch func(ctx context.Context) ([]byte, error)
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	refreshedAt time.Time
+}
+
+// NewFileJWKS loads the key set from a local file. The file is read once.
+func NewFileJWKS(path string) (*JWKS, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading JWKS file: %w", err)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+	return &JWKS{keys: keys}, nil
+}
+
+// NewRemoteJWKS loads the key set from url. The key set is fetched again, at most once per minute, when a token
+// references a key it does not hold, which lets the issuer rotate its keys.
+func NewRemoteJWKS(ctx context.Context, url string, client *http.Client) (*JWKS, error) {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	jwks := &JWKS{fetch: func(ctx context.Context) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status fetching JWKS: %s", resp.Status)
+		}
+		return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	}}
+	if err := jwks.refresh(ctx); err != nil {
+		return nil, err
+	}
+	return jwks, nil
+}
+
+// Key returns the public key identified by kid.
+func (j *JWKS) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	j.mu.RLock()
+	key, ok := j.keys[kid]
+	stale := j.fetch != nil && time.Since(j.refreshedAt) > minRefreshInterval
+	j.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+	if !stale {
+		return nil, ErrKeyNotFound
+	}
+
+	if err := j.refresh(ctx); err != nil {
+		return nil, err
+	}
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	if key, ok := j.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, ErrKeyNotFound
+}
+
+func (j *JWKS) refresh(ctx context.Context) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	// another caller may have refreshed the set while waiting for the lock.
+	if time.Since(j.refreshedAt) <= minRefreshInterval {
+		return nil
+	}
+	data, err := j.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("error fetching JWKS: %w", err)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+	j.keys = keys
+	j.refreshedAt = time.Now()
+	return nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("error decoding JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("error decoding key %q: %w", jwk.Kid, err)
+		}
+		if key != nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS holds no signature key")
+	}
+	return keys, nil
+}
+
+// publicKey decodes the key. It returns a nil key for unsupported key types.
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
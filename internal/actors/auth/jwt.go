@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// signingMethods are the accepted token signature algorithms. Symmetric algorithms are deliberately excluded.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// JWTAuthenticatorArgs are the arguments for the creation of a JWTAuthenticator.
+type JWTAuthenticatorArgs struct {
+	// KeySet holds the keys the token signatures are verified against. Optional if Keys is set.
+	KeySet *JWKS
+
+	// Keys are trusted on top of the key set, by key id, e.g. the key of the JWTIssuer of the service itself.
+	Keys map[string]crypto.PublicKey
+
+	// Issuer is the expected iss claim. Empty if the issuer is not verified.
+	Issuer string
+
+	// Audience is the expected aud claim. Empty if the audience is not verified.
+	Audience string
+}
+
+// NewJWTAuthenticator creates a new JWTAuthenticator.
+func NewJWTAuthenticator(args JWTAuthenticatorArgs) (*JWTAuthenticator, error) {
+	if args.KeySet == nil && len(args.Keys) == 0 {
+		return nil, errors.New("no key passed to JWT authenticator")
+	}
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithExpirationRequired(),
+	}
+	if args.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(args.Issuer))
+	}
+	if args.Audience != "" {
+		opts = append(opts, jwt.WithAudience(args.Audience))
+	}
+	return &JWTAuthenticator{keySet: args.KeySet, keys: args.Keys, parser: jwt.NewParser(opts...)}, nil
+}
+
+// JWTAuthenticator authenticates actors by their bearer JSON Web Tokens.
+type JWTAuthenticator struct {
+	keySet *JWKS
+	keys   map[string]crypto.PublicKey
+	parser *jwt.Parser
+}
+
+// claims are the verified claims of a token. The subject identifies the actor and roles holds the roles granted to it.
+type claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+// Authenticate verifies the token and returns the actor it was issued to. It returns an error wrapping
+// model.ErrUnauthenticated if the token is not valid.
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (model.Actor, error) {
+	var c claims
+	_, err := a.parser.ParseWithClaims(token, &c, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		if key, ok := a.keys[kid]; ok {
+			return key, nil
+		}
+		if a.keySet == nil {
+			return nil, ErrKeyNotFound
+		}
+		return a.keySet.Key(ctx, kid)
+	})
+	if err != nil {
+		return model.Actor{}, fmt.Errorf("%w: %v", model.ErrUnauthenticated, err)
+	}
+	if c.Subject == "" {
+		return model.Actor{}, fmt.Errorf("%w: token has no subject", model.ErrUnauthenticated)
+	}
+
+	actor := model.Actor{ID: c.Subject}
+	for _, role := range c.Roles {
+		actor.Roles = append(actor.Roles, model.Role(role))
+	}
+	return actor, nil
+}
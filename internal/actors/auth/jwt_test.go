@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testKeys struct {
+	ed25519 ed25519.PrivateKey
+	rsa     *rsa.PrivateKey
+	ecdsa   *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return testKeys{ed25519: edKey, rsa: rsaKey, ecdsa: ecKey}
+}
+
+func (k testKeys) jwks(t *testing.T) []byte {
+	b64 := base64.RawURLEncoding.EncodeToString
+	data, err := json.Marshal(map[string]interface{}{"keys": []map[string]string{
+		{"kty": "OKP", "kid": "ed", "crv": "Ed25519", "x": b64(k.ed25519.Public().(ed25519.PublicKey))},
+		{"kty": "RSA", "kid": "rsa", "use": "sig", "n": b64(k.rsa.N.Bytes()), "e": b64(big.NewInt(int64(k.rsa.E)).Bytes())},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64(k.ecdsa.X.Bytes()), "y": b64(k.ecdsa.Y.Bytes())},
+		{"kty": "RSA", "kid": "enc", "use": "enc", "n": b64(k.rsa.N.Bytes()), "e": b64(big.NewInt(int64(k.rsa.E)).Bytes())},
+	}})
+	require.NoError(t, err)
+	return data
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestJWTAuthenticator_Authenticate(t *testing.T) {
+	keys := newTestKeys(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, keys.jwks(t), 0o600))
+	keySet, err := NewFileJWKS(path)
+	require.NoError(t, err)
+	authenticator, err := NewJWTAuthenticator(JWTAuthenticatorArgs{KeySet: keySet, Issuer: "issuer", Audience: "faceittha"})
+	require.NoError(t, err)
+
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"sub":   "user-1",
+			"iss":   "issuer",
+			"aud":   "faceittha",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"roles": []string{"admin"},
+		}
+	}
+	with := func(key string, value interface{}) jwt.MapClaims {
+		claims := valid()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name          string
+		token         string
+		expectedActor model.Actor
+		expectedErr   bool
+	}{
+		{
+			name:          "Ed25519 signed token",
+			token:         sign(t, jwt.SigningMethodEdDSA, "ed", keys.ed25519, valid()),
+			expectedActor: model.Actor{ID: "user-1", Roles: []model.Role{model.RoleAdmin}},
+		},
+		{
+			name:          "RSA signed token",
+			token:         sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, valid()),
+			expectedActor: model.Actor{ID: "user-1", Roles: []model.Role{model.RoleAdmin}},
+		},
+		{
+			name:          "ECDSA signed token without roles",
+			token:         sign(t, jwt.SigningMethodES256, "ec", keys.ecdsa, with("roles", nil)),
+			expectedActor: model.Actor{ID: "user-1"},
+		},
+		{
+			name:        "expired token",
+			token:       sign(t, jwt.SigningMethodEdDSA, "ed", keys.ed25519, with("exp", time.Now().Add(-time.Minute).Unix())),
+			expectedErr: true,
+		},
+		{
+			name:        "token without expiration",
+			token:       sign(t, jwt.SigningMethodEdDSA, "ed", keys.ed25519, with("exp", nil)),
+			expectedErr: true,
+		},
+		{
+			name:        "token without subject",
+			token:       sign(t, jwt.SigningMethodEdDSA, "ed", keys.ed25519, with("sub", nil)),
+			expectedErr: true,
+		},
+		{
+			name:        "wrong issuer",
+			token:       sign(t, jwt.SigningMethodEdDSA, "ed", keys.ed25519, with("iss", "someone-else")),
+			expectedErr: true,
+		},
+		{
+			name:        "wrong audience",
+			token:       sign(t, jwt.SigningMethodEdDSA, "ed", keys.ed25519, with("aud", "another-service")),
+			expectedErr: true,
+		},
+		{
+			name:        "unknown key",
+			token:       sign(t, jwt.SigningMethodEdDSA, "unknown", keys.ed25519, valid()),
+			expectedErr: true,
+		},
+		{
+			name:        "key not meant for signatures",
+			token:       sign(t, jwt.SigningMethodRS256, "enc", keys.rsa, valid()),
+			expectedErr: true,
+		},
+		{
+			name:        "signed with another key",
+			token:       sign(t, jwt.SigningMethodEdDSA, "ed", newTestKeys(t).ed25519, valid()),
+			expectedErr: true,
+		},
+		{
+			name:        "symmetric algorithms are rejected",
+			token:       sign(t, jwt.SigningMethodHS256, "rsa", []byte("secret"), valid()),
+			expectedErr: true,
+		},
+		{
+			name:        "malformed token",
+			token:       "not-a-token",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actor, err := authenticator.Authenticate(context.Background(), test.token)
+			if test.expectedErr {
+				require.ErrorIs(t, err, model.ErrUnauthenticated)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedActor, actor)
+		})
+	}
+}
+
+func TestRemoteJWKS_RefreshesOnUnknownKey(t *testing.T) {
+	keys := newTestKeys(t)
+	var served atomic.Value
+	served.Store([]byte(`{"keys":[{"kty":"OKP","kid":"old","crv":"Ed25519","x":"` +
+		base64.RawURLEncoding.EncodeToString(newTestKeys(t).ed25519.Public().(ed25519.PublicKey)) + `"}]}`))
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		_, _ = w.Write(served.Load().([]byte))
+	}))
+	defer server.Close()
+
+	keySet, err := NewRemoteJWKS(context.Background(), server.URL, server.Client())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, fetches.Load())
+
+	// the issuer rotates its keys
+	served.Store(keys.jwks(t))
+
+	// unknown keys do not trigger a fetch before the minimum refresh interval elapses
+	_, err = keySet.Key(context.Background(), "ed")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+	require.EqualValues(t, 1, fetches.Load())
+
+	keySet.refreshedAt = time.Now().Add(-2 * minRefreshInterval)
+	key, err := keySet.Key(context.Background(), "ed")
+	require.NoError(t, err)
+	assert.Equal(t, keys.ed25519.Public(), key)
+	require.EqualValues(t, 2, fetches.Load())
+}
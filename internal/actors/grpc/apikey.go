@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateAPIKey creates an API key.
+func (u *UserService) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	scopes := make([]model.Role, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		scopes[i] = model.Role(scope)
+	}
+	expiresAt := time.Time{}
+	if req.ExpiresAt.IsValid() {
+		expiresAt = req.ExpiresAt.AsTime()
+	}
+
+	resp, err := u.usecase.CreateAPIKey(ctx, model.CreateAPIKeyArgs{Name: req.Name, Scopes: scopes, ExpiresAt: expiresAt})
+	if err != nil {
+		return nil, usecaseError("CreateAPIKey", err)
+	}
+
+	return &pb.CreateAPIKeyResponse{ApiKey: apiKeyToProto(resp.APIKey), Key: resp.Key}, nil
+}
+
+// ListAPIKeys lists the API keys.
+func (u *UserService) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	resp, err := u.usecase.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, usecaseError("ListAPIKeys", err)
+	}
+
+	apiKeys := make([]*pb.ApiKey, len(resp.APIKeys))
+	for i, apiKey := range resp.APIKeys {
+		apiKeys[i] = apiKeyToProto(apiKey)
+	}
+	return &pb.ListAPIKeysResponse{ApiKeys: apiKeys}, nil
+}
+
+// RevokeAPIKey revokes an API key.
+func (u *UserService) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	err = u.usecase.RevokeAPIKey(ctx, model.RevokeAPIKeyArgs{ID: id})
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "api key not found")
+	}
+	if err != nil {
+		return nil, usecaseError("RevokeAPIKey", err)
+	}
+
+	return &pb.RevokeAPIKeyResponse{}, nil
+}
+
+func apiKeyToProto(apiKey model.APIKey) *pb.ApiKey {
+	ret := &pb.ApiKey{
+		Id:        apiKey.ID.String(),
+		Name:      apiKey.Name,
+		Scopes:    rolesToProto(apiKey.Scopes),
+		CreatedBy: apiKey.CreatedBy,
+		CreatedAt: timestamppb.New(apiKey.CreatedAt),
+	}
+	if !apiKey.ExpiresAt.IsZero() {
+		ret.ExpiresAt = timestamppb.New(apiKey.ExpiresAt)
+	}
+	if !apiKey.RevokedAt.IsZero() {
+		ret.RevokedAt = timestamppb.New(apiKey.RevokedAt)
+	}
+	return ret
+}
@@ -0,0 +1,185 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// authorizationHeader carries the bearer token. The HTTP gateway forwards the Authorization header under this key.
+const authorizationHeader = "authorization"
+
+// AuthInterceptorArgs are the mandatory args to instantiate the AuthInterceptor.
+type AuthInterceptorArgs struct {
+	// Authenticator verifies the bearer tokens.
+	Authenticator authenticator
+
+	// PublicMethods are the full names of the methods that can be invoked without a token (e.g. /HealthService/Healthz).
+	PublicMethods []string
+
+	// PeerIdentities maps the URI or DNS SANs of verified client certificates to the roles of the calling services.
+	// Calls of such peers without a bearer token are performed on behalf of an actor identified by the SAN. Optional.
+	PeerIdentities map[string][]model.Role
+
+	// GatewayIdentities are the URI or DNS SANs of the client certificate of the HTTP gateway. The calls of the gateway
+	// are authenticated by the identity of the HTTP client it forwards in the ForwardedClientIdentityHeader metadata,
+	// never by the certificate of the gateway itself. Optional.
+	GatewayIdentities []string
+
+	// APIKeyAuthenticator verifies the API keys of the calls without a bearer token. Optional, API keys are rejected
+	// if nil.
+	APIKeyAuthenticator apiKeyAuthenticator
+}
+
+// NewAuthInterceptor creates a new AuthInterceptor.
+func NewAuthInterceptor(args AuthInterceptorArgs) *AuthInterceptor {
+	publicMethods := make(map[string]bool, len(args.PublicMethods))
+	for _, method := range args.PublicMethods {
+		publicMethods[method] = true
+	}
+	gatewayIdentities := make(map[string]bool, len(args.GatewayIdentities))
+	for _, san := range args.GatewayIdentities {
+		gatewayIdentities[san] = true
+	}
+	return &AuthInterceptor{
+		authenticator:       args.Authenticator,
+		apiKeyAuthenticator: args.APIKeyAuthenticator,
+		publicMethods:       publicMethods,
+		peerIdentities:      args.PeerIdentities,
+		gatewayIdentities:   gatewayIdentities,
+	}
+}
+
+// AuthInterceptor authenticates the calls by their bearer token, or else by their API key, or else by the client
+// certificate of the peer, and attaches the model.Actor to the context of the handler. Calls with an invalid token or
+// API key, and calls to non-public methods without any credential, are rejected with codes.Unauthenticated.
+type AuthInterceptor struct {
+	authenticator       authenticator
+	apiKeyAuthenticator apiKeyAuthenticator
+	publicMethods       map[string]bool
+	peerIdentities      map[string][]model.Role
+	gatewayIdentities   map[string]bool
+}
+
+// Unary is the unary server interceptor.
+func (a *AuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream is the stream server interceptor.
+func (a *AuthInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+}
+
+func (a *AuthInterceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	scheme, token, _ := strings.Cut(firstValue(md, authorizationHeader), " ")
+	if !strings.EqualFold(scheme, "Bearer") || token == "" {
+		if apiKey := firstValue(md, apiKeyHeader); apiKey != "" {
+			return a.authenticateAPIKey(ctx, method, apiKey)
+		}
+		if actor, ok := a.peerActor(ctx, md); ok {
+			return model.ContextWithActor(ctx, actor), nil
+		}
+		if a.publicMethods[method] {
+			return ctx, nil
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "missing bearer token")
+	}
+
+	actor, err := a.authenticator.Authenticate(ctx, token)
+	if err != nil {
+		log.WithError(err).WithField("method", method).Info("rejected bearer token")
+		return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token")
+	}
+	return model.ContextWithActor(ctx, actor), nil
+}
+
+func (a *AuthInterceptor) authenticateAPIKey(ctx context.Context, method, apiKey string) (context.Context, error) {
+	if a.apiKeyAuthenticator == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "api keys not supported")
+	}
+	actor, err := a.apiKeyAuthenticator.AuthenticateAPIKey(ctx, apiKey)
+	if err != nil {
+		log.WithError(err).WithField("method", method).Info("rejected api key")
+		return nil, status.Errorf(codes.Unauthenticated, "invalid api key")
+	}
+	return model.ContextWithActor(ctx, actor), nil
+}
+
+// peerActor returns the actor of the peer if it presented a verified client certificate whose SAN is a known identity.
+// For the calls of the gateway, it is the actor of the HTTP client, identified by the SANs the gateway forwards.
+func (a *AuthInterceptor) peerActor(ctx context.Context, md metadata.MD) (model.Actor, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return model.Actor{}, false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return model.Actor{}, false
+	}
+	sans := certificateSANs(tlsInfo.State.VerifiedChains[0][0])
+	for _, san := range sans {
+		if a.gatewayIdentities[san] {
+			sans = md.Get(ForwardedClientIdentityHeader)
+			break
+		}
+	}
+	for _, san := range sans {
+		if a.gatewayIdentities[san] {
+			continue
+		}
+		if roles, ok := a.peerIdentities[san]; ok {
+			return model.Actor{ID: san, Roles: roles}, true
+		}
+	}
+	return model.Actor{}, false
+}
+
+// certificateSANs returns the URI and DNS SANs of the certificate.
+func certificateSANs(cert *x509.Certificate) []string {
+	sans := make([]string, 0, len(cert.URIs)+len(cert.DNSNames))
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	return append(sans, cert.DNSNames...)
+}
+
+// serverStream overrides the context of a grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticator verifies bearer tokens.
+type authenticator interface {
+	// Authenticate returns the actor the token was issued to.
+	Authenticate(ctx context.Context, token string) (model.Actor, error)
+}
+
+// apiKeyAuthenticator verifies API keys.
+type apiKeyAuthenticator interface {
+	// AuthenticateAPIKey returns the actor holding the key.
+	AuthenticateAPIKey(ctx context.Context, key string) (model.Actor, error)
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BlockUser blocks a user on behalf of another one.
+func (u *UserService) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, blockedID, err := parseFriendIDs(req.UserId, req.BlockedId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.usecase.BlockUser(ctx, model.BlockUserArgs{UserID: id, BlockedID: blockedID})
+	if err != nil {
+		return nil, usecaseError("BlockUser", err)
+	}
+
+	return &pb.BlockUserResponse{Block: blockToProto(resp.Block)}, nil
+}
+
+// UnblockUser lifts a block of a user.
+func (u *UserService) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, blockedID, err := parseFriendIDs(req.UserId, req.BlockedId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.usecase.UnblockUser(ctx, model.UnblockUserArgs{UserID: id, BlockedID: blockedID})
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "block not found")
+	}
+	if err != nil {
+		return nil, usecaseError("UnblockUser", err)
+	}
+
+	return &pb.UnblockUserResponse{}, nil
+}
+
+// ListBlockedUsers lists the users blocked by a user.
+func (u *UserService) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (*pb.ListBlockedUsersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.ListBlockedUsers(ctx, model.ListBlockedUsersArgs{
+		UserID: id,
+		Limit:  req.GetPageSize(),
+		Offset: req.GetOffset(),
+	})
+	if err != nil {
+		return nil, usecaseError("ListBlockedUsers", err)
+	}
+
+	blocks := make([]*pb.Block, len(resp.Blocks))
+	for i, block := range resp.Blocks {
+		blocks[i] = blockToProto(block)
+	}
+	return &pb.ListBlockedUsersResponse{Blocks: blocks}, nil
+}
+
+// IsBlocked reports whether either user blocked the other.
+func (u *UserService) IsBlocked(ctx context.Context, req *pb.IsBlockedRequest) (*pb.IsBlockedResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, otherID, err := parseFriendIDs(req.UserId, req.OtherUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := u.usecase.IsBlocked(ctx, model.IsBlockedArgs{UserID: id, OtherUserID: otherID})
+	if err != nil {
+		return nil, usecaseError("IsBlocked", err)
+	}
+
+	return &pb.IsBlockedResponse{Blocked: blocked}, nil
+}
+
+func blockToProto(block model.Block) *pb.Block {
+	return &pb.Block{
+		BlockerId: block.BlockerID.String(),
+		BlockedId: block.BlockedID.String(),
+		CreatedAt: timestamppb.New(block.CreatedAt),
+	}
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RequestAccountDeletion schedules the deletion of the account of a user.
+func (u *UserService) RequestAccountDeletion(ctx context.Context, req *pb.RequestAccountDeletionRequest) (*pb.RequestAccountDeletionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.RequestAccountDeletion(ctx, model.RequestAccountDeletionArgs{UserID: id})
+	if err != nil {
+		return nil, usecaseError("RequestAccountDeletion", err)
+	}
+
+	return &pb.RequestAccountDeletionResponse{ScheduledFor: timestamppb.New(resp.ScheduledFor)}, nil
+}
+
+// CancelAccountDeletion cancels the scheduled deletion of the account of a user.
+func (u *UserService) CancelAccountDeletion(ctx context.Context, req *pb.CancelAccountDeletionRequest) (*pb.CancelAccountDeletionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	if err := u.usecase.CancelAccountDeletion(ctx, model.CancelAccountDeletionArgs{UserID: id}); err != nil {
+		return nil, usecaseError("CancelAccountDeletion", err)
+	}
+
+	return &pb.CancelAccountDeletionResponse{}, nil
+}
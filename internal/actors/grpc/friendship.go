@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RequestFriendship sends a friend request on behalf of a user.
+func (u *UserService) RequestFriendship(ctx context.Context, req *pb.RequestFriendshipRequest) (*pb.RequestFriendshipResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, friendID, err := parseFriendIDs(req.UserId, req.FriendId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.usecase.RequestFriendship(ctx, model.RequestFriendshipArgs{UserID: id, FriendID: friendID})
+	if err != nil {
+		return nil, usecaseError("RequestFriendship", err)
+	}
+
+	return &pb.RequestFriendshipResponse{Friendship: friendshipToProto(resp.Friendship)}, nil
+}
+
+// AcceptFriendship accepts a friend request sent to a user.
+func (u *UserService) AcceptFriendship(ctx context.Context, req *pb.AcceptFriendshipRequest) (*pb.AcceptFriendshipResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, friendID, err := parseFriendIDs(req.UserId, req.FriendId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.usecase.AcceptFriendship(ctx, model.AnswerFriendshipArgs{UserID: id, FriendID: friendID})
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "friend request not found")
+	}
+	if err != nil {
+		return nil, usecaseError("AcceptFriendship", err)
+	}
+
+	return &pb.AcceptFriendshipResponse{}, nil
+}
+
+// DeclineFriendship declines a friend request sent to a user.
+func (u *UserService) DeclineFriendship(ctx context.Context, req *pb.DeclineFriendshipRequest) (*pb.DeclineFriendshipResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, friendID, err := parseFriendIDs(req.UserId, req.FriendId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.usecase.DeclineFriendship(ctx, model.AnswerFriendshipArgs{UserID: id, FriendID: friendID})
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "friend request not found")
+	}
+	if err != nil {
+		return nil, usecaseError("DeclineFriendship", err)
+	}
+
+	return &pb.DeclineFriendshipResponse{}, nil
+}
+
+// RemoveFriend removes a friendship of a user.
+func (u *UserService) RemoveFriend(ctx context.Context, req *pb.RemoveFriendRequest) (*pb.RemoveFriendResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, friendID, err := parseFriendIDs(req.UserId, req.FriendId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.usecase.RemoveFriend(ctx, model.RemoveFriendArgs{UserID: id, FriendID: friendID})
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "friendship not found")
+	}
+	if err != nil {
+		return nil, usecaseError("RemoveFriend", err)
+	}
+
+	return &pb.RemoveFriendResponse{}, nil
+}
+
+// ListFriends lists the friendships of a user.
+func (u *UserService) ListFriends(ctx context.Context, req *pb.ListFriendsRequest) (*pb.ListFriendsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.ListFriends(ctx, model.ListFriendsArgs{
+		UserID: id,
+		Status: model.FriendshipStatus(req.Status),
+		Limit:  req.GetPageSize(),
+		Offset: req.GetOffset(),
+	})
+	if err != nil {
+		return nil, usecaseError("ListFriends", err)
+	}
+
+	friendships := make([]*pb.Friendship, len(resp.Friendships))
+	for i, friendship := range resp.Friendships {
+		friendships[i] = friendshipToProto(friendship)
+	}
+	return &pb.ListFriendsResponse{Friendships: friendships}, nil
+}
+
+// parseFriendIDs parses the ids of the user and of the other user, e.g. their friend.
+func parseFriendIDs(userID, friendID string) (uuid.UUID, uuid.UUID, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+	friend, err := uuid.Parse(friendID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+	return id, friend, nil
+}
+
+func friendshipToProto(friendship model.Friendship) *pb.Friendship {
+	ret := &pb.Friendship{
+		RequesterId: friendship.RequesterID.String(),
+		AddresseeId: friendship.AddresseeID.String(),
+		Status:      string(friendship.Status),
+		CreatedAt:   timestamppb.New(friendship.CreatedAt),
+	}
+	if !friendship.AcceptedAt.IsZero() {
+		ret.AcceptedAt = timestamppb.New(friendship.AcceptedAt)
+	}
+	return ret
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// requestIDHeader carries the request id. It is generated when the client does not supply one.
+	requestIDHeader = "x-request-id"
+
+	// forwardedForHeader is set by the HTTP gateway with the address of the HTTP client.
+	forwardedForHeader = "x-forwarded-for"
+
+	// userAgentHeader carries the user agent of the gRPC clients.
+	userAgentHeader = "user-agent"
+
+	// gatewayUserAgentHeader is set by the HTTP gateway with the user agent of the HTTP client.
+	gatewayUserAgentHeader = "grpcgateway-user-agent"
+
+	// ForwardedClientIdentityHeader is set by the HTTP gateway with the SANs of the verified client certificate of the
+	// HTTP client. It is only trusted on the calls of the gateway, which must never forward it from the HTTP headers.
+	ForwardedClientIdentityHeader = "x-forwarded-client-identity"
+)
+
+// RequestMetadataUnaryInterceptor attaches the model.RequestMetadata of the call to the context of the handler and
+// echoes the request id back to the client in the response headers.
+func RequestMetadataUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := firstValue(md, requestIDHeader)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+	ctx = model.ContextWithRequestMetadata(ctx, model.RequestMetadata{
+		RequestID: requestID,
+		SourceIP:  sourceIP(ctx, md),
+		UserAgent: userAgent(md),
+	})
+	return handler(ctx, req)
+}
+
+// GatewayClientIdentity returns the metadata forwarding the SANs of the verified client certificate of the HTTP request
+// to the gRPC server, to be attached by the gateway with runtime.WithMetadata.
+func GatewayClientIdentity(ctx context.Context, r *http.Request) metadata.MD {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return metadata.MD{ForwardedClientIdentityHeader: certificateSANs(r.TLS.VerifiedChains[0][0])}
+}
+
+// sourceIP returns the IP address of the client. The x-forwarded-for header is only trusted when the call comes from
+// the loopback interface, i.e. from the HTTP gateway, and only its last entry (the address the gateway observed).
+func sourceIP(ctx context.Context, md metadata.MD) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if forwarded := md.Get(forwardedForHeader); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			return strings.TrimSpace(hops[len(hops)-1])
+		}
+	}
+	return host
+}
+
+// userAgent returns the user agent of the client, the one of the HTTP client for the calls through the gateway.
+func userAgent(md metadata.MD) string {
+	if userAgent := firstValue(md, gatewayUserAgentHeader); userAgent != "" {
+		return userAgent
+	}
+	return firstValue(md, userAgentHeader)
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
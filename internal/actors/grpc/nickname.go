@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetNicknameHistory lists the nickname changes of a user.
+func (u *UserService) GetNicknameHistory(ctx context.Context, req *pb.GetNicknameHistoryRequest) (*pb.GetNicknameHistoryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.GetNicknameHistory(ctx, model.GetNicknameHistoryArgs{
+		UserID: id,
+		Limit:  req.GetPageSize(),
+		Offset: req.GetOffset(),
+	})
+	if err != nil {
+		return nil, usecaseError("GetNicknameHistory", err)
+	}
+
+	changes := make([]*pb.NicknameChange, len(resp.Changes))
+	for i, change := range resp.Changes {
+		changes[i] = &pb.NicknameChange{
+			OldNickname: change.OldNickname,
+			NewNickname: change.NewNickname,
+			ChangedAt:   timestamppb.New(change.ChangedAt),
+		}
+	}
+	return &pb.GetNicknameHistoryResponse{Changes: changes}, nil
+}
+
+// CheckNicknameAvailability tells whether a nickname can be taken.
+func (u *UserService) CheckNicknameAvailability(ctx context.Context, req *pb.CheckNicknameAvailabilityRequest) (*pb.CheckNicknameAvailabilityResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := u.usecase.CheckNicknameAvailability(ctx, model.CheckNicknameAvailabilityArgs{
+		Nickname: req.GetNickname(),
+		Reserve:  req.GetReserve(),
+	})
+	if err != nil {
+		return nil, usecaseError("CheckNicknameAvailability", err)
+	}
+
+	ret := &pb.CheckNicknameAvailabilityResponse{
+		Available:        resp.Available,
+		Rejection:        string(resp.Rejection),
+		Nickname:         resp.Nickname,
+		Suggestions:      resp.Suggestions,
+		ReservationToken: resp.ReservationToken,
+	}
+	if !resp.ReservationExpiresAt.IsZero() {
+		ret.ReservationExpiresAt = timestamppb.New(resp.ReservationExpiresAt)
+	}
+	return ret, nil
+}
+
+// nicknameStatus translates the rejection of a nickname into a gRPC status carrying an errdetails.ErrorInfo with the
+// reason, e.g. NICKNAME_PROFANE.
+func nicknameStatus(err *model.NicknameError) error {
+	code := codes.InvalidArgument
+	if errors.Is(err, model.ErrAlreadyExists) {
+		code = codes.AlreadyExists
+	}
+	st, detailsErr := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason:   "NICKNAME_" + strings.ToUpper(string(err.Rejection)),
+		Domain:   "faceittha",
+		Metadata: map[string]string{"nickname": err.Nickname},
+	})
+	if detailsErr != nil {
+		return status.Errorf(code, err.Error())
+	}
+	return st.Err()
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetPublicProfile returns the public profile of a user, by ID or by nickname.
+func (u *UserService) GetPublicProfile(ctx context.Context, req *pb.GetPublicProfileRequest) (*pb.GetPublicProfileResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if (req.GetUserId() == "") == (req.GetNickname() == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "either user_id or nickname must be set")
+	}
+	args := model.GetPublicProfileArgs{Nickname: req.GetNickname()}
+	if req.GetUserId() != "" {
+		id, err := uuid.Parse(req.GetUserId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+		}
+		args.UserID = id
+	}
+
+	resp, err := u.usecase.GetPublicProfile(ctx, args)
+	if err != nil {
+		return nil, usecaseError("GetPublicProfile", err)
+	}
+
+	profile := &pb.PublicProfile{
+		UserId:     resp.Profile.UserID.String(),
+		Nickname:   resp.Profile.Nickname,
+		Visibility: string(resp.Profile.Visibility),
+		Restricted: resp.Profile.Restricted,
+		Country:    resp.Profile.Country,
+		Status:     string(resp.Profile.Status),
+	}
+	if !resp.Profile.JoinedAt.IsZero() {
+		profile.JoinedAt = timestamppb.New(resp.Profile.JoinedAt)
+	}
+	return &pb.GetPublicProfileResponse{Profile: profile}, nil
+}
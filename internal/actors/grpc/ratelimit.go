@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// apiKeyHeader carries the API key of the clients.
+const apiKeyHeader = "x-api-key"
+
+// DefaultMethodLimit is the key of RateLimitInterceptorArgs.MethodLimits applying to the methods without a limit.
+const DefaultMethodLimit = "*"
+
+// RateLimitInterceptorArgs are the mandatory args to instantiate the RateLimitInterceptor.
+type RateLimitInterceptorArgs struct {
+	// Store keeps the token buckets.
+	Store rateLimitStore
+
+	// MethodLimits are the limits of every client by full method name (e.g. /faceittha.v1.UserService/CreateUser).
+	// The DefaultMethodLimit entry applies to the other methods, methods without a limit are not limited.
+	MethodLimits map[string]model.RateLimit
+
+	// IdentityLimits override the method limits of specific clients (e.g. trusted services), by client key as
+	// returned by ClientKey.
+	IdentityLimits map[string]model.RateLimit
+}
+
+// NewRateLimitInterceptor creates a new RateLimitInterceptor.
+func NewRateLimitInterceptor(args RateLimitInterceptorArgs) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		store:          args.Store,
+		methodLimits:   args.MethodLimits,
+		identityLimits: args.IdentityLimits,
+	}
+}
+
+// RateLimitInterceptor limits the rate of the calls of every client to every method with token buckets. Calls
+// exceeding the limit are rejected with codes.ResourceExhausted and an errdetails.RetryInfo telling when to retry.
+// It must be chained after the authentication, so that authenticated clients are told apart by their identity.
+type RateLimitInterceptor struct {
+	store          rateLimitStore
+	methodLimits   map[string]model.RateLimit
+	identityLimits map[string]model.RateLimit
+}
+
+// Unary is the unary server interceptor.
+func (r *RateLimitInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := r.limit(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream is the stream server interceptor.
+func (r *RateLimitInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := r.limit(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (r *RateLimitInterceptor) limit(ctx context.Context, method string) error {
+	clientKey := ClientKey(ctx)
+	limit, ok := r.identityLimits[clientKey]
+	if !ok {
+		limit, ok = r.methodLimits[method]
+	}
+	if !ok {
+		limit, ok = r.methodLimits[DefaultMethodLimit]
+	}
+	if !ok || limit.Rate <= 0 {
+		return nil
+	}
+
+	wait, err := r.store.TakeToken(ctx, method+" "+clientKey, limit)
+	if err != nil {
+		// the rate limiter must not make the service unavailable, the call is let through
+		log.WithError(err).WithField("method", method).Error("error taking rate limit token")
+		return nil
+	}
+	if wait == 0 {
+		return nil
+	}
+	st, err := status.New(codes.ResourceExhausted, "rate limit exceeded").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait.Round(time.Millisecond))})
+	if err != nil {
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	return st.Err()
+}
+
+// ClientKey identifies the client of the call for rate limiting purposes: by actor ID ("sub:<id>") if authenticated,
+// by API key fingerprint ("key:<sha256>") if one is presented, by source IP ("ip:<ip>") otherwise.
+func ClientKey(ctx context.Context) string {
+	if actor, ok := model.ActorFromContext(ctx); ok && actor.ID != "" {
+		return "sub:" + actor.ID
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if apiKey := firstValue(md, apiKeyHeader); apiKey != "" {
+		fingerprint := sha256.Sum256([]byte(apiKey))
+		return "key:" + hex.EncodeToString(fingerprint[:])
+	}
+	return "ip:" + model.RequestMetadataFromContext(ctx).SourceIP
+}
+
+// rateLimitStore keeps the token buckets.
+type rateLimitStore interface {
+	// TakeToken takes a token from the bucket identified by key. If the bucket is empty no token is taken and the
+	// time to wait until a token is available is returned.
+	TakeToken(ctx context.Context, key string, limit model.RateLimit) (time.Duration, error)
+}
@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GrantRole grants a role to a user.
+func (u *UserService) GrantRole(ctx context.Context, req *pb.GrantRoleRequest) (*pb.GrantRoleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	if err := u.usecase.GrantRole(ctx, model.GrantRoleArgs{UserID: id, Role: model.Role(req.Role)}); err != nil {
+		return nil, usecaseError("GrantRole", err)
+	}
+
+	return &pb.GrantRoleResponse{}, nil
+}
+
+// RevokeRole revokes a role of a user.
+func (u *UserService) RevokeRole(ctx context.Context, req *pb.RevokeRoleRequest) (*pb.RevokeRoleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	if err := u.usecase.RevokeRole(ctx, model.RevokeRoleArgs{UserID: id, Role: model.Role(req.Role)}); err != nil {
+		return nil, usecaseError("RevokeRole", err)
+	}
+
+	return &pb.RevokeRoleResponse{}, nil
+}
+
+func rolesToProto(roles []model.Role) []string {
+	if len(roles) == 0 {
+		return nil
+	}
+	ret := make([]string, len(roles))
+	for i, role := range roles {
+		ret[i] = string(role)
+	}
+	return ret
+}
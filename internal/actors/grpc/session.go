@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Login logs a user in and opens a session.
+func (u *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := u.usecase.Login(ctx, model.LoginArgs{Email: req.Email, Password: req.Password})
+	if err != nil {
+		return nil, usecaseError("Login", err)
+	}
+
+	if resp.Tokens == nil {
+		return &pb.LoginResponse{
+			MfaChallenge:          resp.MFAChallenge,
+			MfaChallengeExpiresAt: timestamppb.New(resp.MFAChallengeExpiresAt),
+		}, nil
+	}
+	return &pb.LoginResponse{Tokens: sessionTokensToProto(*resp.Tokens)}, nil
+}
+
+// CompleteLogin completes a login with a second factor and opens a session.
+func (u *UserService) CompleteLogin(ctx context.Context, req *pb.CompleteLoginRequest) (*pb.CompleteLoginResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tokens, err := u.usecase.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: req.MfaChallenge, Code: req.Code})
+	if err != nil {
+		return nil, usecaseError("CompleteLogin", err)
+	}
+
+	return &pb.CompleteLoginResponse{Tokens: sessionTokensToProto(*tokens)}, nil
+}
+
+// RefreshSession exchanges the refresh token of a session for new session tokens.
+func (u *UserService) RefreshSession(ctx context.Context, req *pb.RefreshSessionRequest) (*pb.RefreshSessionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tokens, err := u.usecase.RefreshSession(ctx, model.RefreshSessionArgs{RefreshToken: req.RefreshToken})
+	if err != nil {
+		return nil, usecaseError("RefreshSession", err)
+	}
+
+	return &pb.RefreshSessionResponse{Tokens: sessionTokensToProto(*tokens)}, nil
+}
+
+// ListSessions lists the active sessions of a user.
+func (u *UserService) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.ListSessions(ctx, model.ListSessionsArgs{UserID: id})
+	if err != nil {
+		return nil, usecaseError("ListSessions", err)
+	}
+
+	sessions := make([]*pb.Session, len(resp.Sessions))
+	for i, session := range resp.Sessions {
+		sessions[i] = sessionToProto(session)
+	}
+	return &pb.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+// RevokeSession revokes a session of a user.
+func (u *UserService) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	err = u.usecase.RevokeSession(ctx, model.RevokeSessionArgs{UserID: userID, SessionID: sessionID})
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "session not found")
+	}
+	if err != nil {
+		return nil, usecaseError("RevokeSession", err)
+	}
+
+	return &pb.RevokeSessionResponse{}, nil
+}
+
+func sessionTokensToProto(tokens model.SessionTokens) *pb.SessionTokens {
+	return &pb.SessionTokens{
+		SessionId:             tokens.SessionID.String(),
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessTokenExpiresAt),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshTokenExpiresAt),
+	}
+}
+
+func sessionToProto(session model.Session) *pb.Session {
+	return &pb.Session{
+		Id:          session.ID.String(),
+		UserId:      session.UserID.String(),
+		UserAgent:   session.UserAgent,
+		SourceIp:    session.SourceIP,
+		CreatedAt:   timestamppb.New(session.CreatedAt),
+		RefreshedAt: timestamppb.New(session.RefreshedAt),
+		ExpiresAt:   timestamppb.New(session.ExpiresAt),
+	}
+}
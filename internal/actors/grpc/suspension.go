@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SuspendUser suspends a user.
+func (u *UserService) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.SuspendUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+	var expiresAt time.Time
+	if req.ExpiresAt.IsValid() {
+		expiresAt = req.ExpiresAt.AsTime()
+	}
+
+	resp, err := u.usecase.SuspendUser(ctx, model.SuspendUserArgs{
+		UserID:    id,
+		Reason:    model.SuspensionReason(req.Reason),
+		Note:      req.Note,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, usecaseError("SuspendUser", err)
+	}
+
+	return &pb.SuspendUserResponse{Suspension: suspensionToProto(resp.Suspension)}, nil
+}
+
+// LiftSuspension lifts the suspension of a user.
+func (u *UserService) LiftSuspension(ctx context.Context, req *pb.LiftSuspensionRequest) (*pb.LiftSuspensionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	if err := u.usecase.LiftSuspension(ctx, model.LiftSuspensionArgs{UserID: id}); err != nil {
+		return nil, usecaseError("LiftSuspension", err)
+	}
+
+	return &pb.LiftSuspensionResponse{}, nil
+}
+
+// ListSuspensions lists the suspensions of a user.
+func (u *UserService) ListSuspensions(ctx context.Context, req *pb.ListSuspensionsRequest) (*pb.ListSuspensionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.ListSuspensions(ctx, model.ListSuspensionsArgs{
+		UserID: id,
+		Limit:  req.GetPageSize(),
+		Offset: req.GetOffset(),
+	})
+	if err != nil {
+		return nil, usecaseError("ListSuspensions", err)
+	}
+
+	suspensions := make([]*pb.Suspension, len(resp.Suspensions))
+	for i, suspension := range resp.Suspensions {
+		suspensions[i] = suspensionToProto(suspension)
+	}
+	return &pb.ListSuspensionsResponse{Suspensions: suspensions}, nil
+}
+
+func suspensionToProto(suspension model.Suspension) *pb.Suspension {
+	ret := &pb.Suspension{
+		Id:        suspension.ID.String(),
+		UserId:    suspension.UserID.String(),
+		Reason:    string(suspension.Reason),
+		Note:      suspension.Note,
+		IssuedBy:  suspension.IssuedBy,
+		CreatedAt: timestamppb.New(suspension.CreatedAt),
+		LiftedBy:  suspension.LiftedBy,
+	}
+	if !suspension.ExpiresAt.IsZero() {
+		ret.ExpiresAt = timestamppb.New(suspension.ExpiresAt)
+	}
+	if !suspension.EndedAt.IsZero() {
+		ret.EndedAt = timestamppb.New(suspension.EndedAt)
+	}
+	return ret
+}
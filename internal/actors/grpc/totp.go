@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	pb "github.com/rbroggi/faceittha/pkg/sdk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EnrollTOTP starts the enrollment of TOTP as second factor of a user.
+func (u *UserService) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	enrollment, err := u.usecase.EnrollTOTP(ctx, model.EnrollTOTPArgs{UserID: id})
+	if err != nil {
+		return nil, usecaseError("EnrollTOTP", err)
+	}
+
+	return &pb.EnrollTOTPResponse{Secret: enrollment.Secret, Uri: enrollment.URI}, nil
+}
+
+// ConfirmTOTP confirms the TOTP enrollment of a user.
+func (u *UserService) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTPRequest) (*pb.ConfirmTOTPResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.ConfirmTOTP(ctx, model.ConfirmTOTPArgs{UserID: id, Code: req.Code})
+	if err != nil {
+		return nil, usecaseError("ConfirmTOTP", err)
+	}
+
+	return &pb.ConfirmTOTPResponse{RecoveryCodes: resp.RecoveryCodes}, nil
+}
+
+// DisableTOTP disables the TOTP second factor of a user.
+func (u *UserService) DisableTOTP(ctx context.Context, req *pb.DisableTOTPRequest) (*pb.DisableTOTPResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	if err := u.usecase.DisableTOTP(ctx, model.DisableTOTPArgs{UserID: id, Code: req.Code}); err != nil {
+		return nil, usecaseError("DisableTOTP", err)
+	}
+
+	return &pb.DisableTOTPResponse{}, nil
+}
@@ -11,6 +11,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -36,30 +37,19 @@ func (u *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 	resp, err := u.usecase.CreateUser(ctx, model.CreateUserArgs{
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Nickname:  req.Nickname,
-		Email:     req.Email,
-		Password:  req.Password,
-		Country:   req.Country,
+		FirstName:                req.FirstName,
+		LastName:                 req.LastName,
+		Nickname:                 req.Nickname,
+		Email:                    req.Email,
+		Password:                 req.Password,
+		Country:                  req.Country,
+		NicknameReservationToken: req.NicknameReservationToken,
 	})
 	if err != nil {
-		log.WithError(err).Error("error invoking usecase CreateUser")
-		return nil, status.Errorf(codes.Internal, "internal error")
-	}
-
-	return &pb.CreateUserResponse{
-		User: &pb.User{
-			Id:        resp.User.ID.String(),
-			FirstName: resp.User.FirstName,
-			LastName:  resp.User.LastName,
-			Nickname:  resp.User.Nickname,
-			Email:     resp.User.Email,
-			Country:   resp.User.Country,
-			CreatedAt: timestamppb.New(resp.User.CreatedAt),
-			UpdatedAt: timestamppb.New(resp.User.UpdatedAt),
-		},
-	}, nil
+		return nil, usecaseError("CreateUser", err)
+	}
+
+	return &pb.CreateUserResponse{User: userToProto(resp.User)}, nil
 }
 
 func (u *UserService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
@@ -76,20 +66,76 @@ func (u *UserService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 	}
 
 	resp, err := u.usecase.ListUsers(ctx, model.ListUsersArgs{
-		Countries:     req.Countries,
-		CreatedAfter:  createdAfter,
-		CreatedBefore: createdBefore,
-		Limit:         req.GetPageSize(),
-		Offset:        req.GetOffset(),
+		Countries:      req.Countries,
+		Statuses:       statusesFromProto(req.Statuses),
+		CreatedAfter:   createdAfter,
+		CreatedBefore:  createdBefore,
+		Limit:          req.GetPageSize(),
+		Offset:         req.GetOffset(),
+		IncludeDeleted: req.ShowDeleted,
+		Attributes:     req.Attributes,
 	})
 	if err != nil {
-		log.WithError(err).Error("error invoking usecase ListUsers")
-		return nil, status.Errorf(codes.Internal, "internal error")
+		return nil, usecaseError("ListUsers", err)
 	}
 
 	return &pb.ListUsersResponse{Users: usersToProto(resp.Users)}, nil
 }
 
+// GetUser gets a user, optionally as it was at a given time.
+func (u *UserService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+	asOf := time.Time{}
+	if req.AsOf.IsValid() {
+		asOf = req.AsOf.AsTime()
+	}
+
+	resp, err := u.usecase.GetUser(ctx, model.GetUserArgs{ID: id, AsOf: asOf, IncludeDeleted: req.ShowDeleted})
+	if err != nil {
+		return nil, usecaseError("GetUser", err)
+	}
+
+	return &pb.GetUserResponse{User: userToProto(resp.User)}, nil
+}
+
+// GetUserHistory lists the versions of a user.
+func (u *UserService) GetUserHistory(ctx context.Context, req *pb.GetUserHistoryRequest) (*pb.GetUserHistoryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.GetUserHistory(ctx, model.GetUserHistoryArgs{
+		UserID: id,
+		Limit:  req.GetPageSize(),
+		Offset: req.GetOffset(),
+	})
+	if err != nil {
+		return nil, usecaseError("GetUserHistory", err)
+	}
+
+	versions := make([]*pb.UserVersion, len(resp.Versions))
+	for i, version := range resp.Versions {
+		versions[i] = &pb.UserVersion{
+			User:      userToProto(version.User),
+			ValidFrom: timestamppb.New(version.ValidFrom),
+		}
+		if !version.ValidTo.IsZero() {
+			versions[i].ValidTo = timestamppb.New(version.ValidTo)
+		}
+	}
+	return &pb.GetUserHistoryResponse{Versions: versions}, nil
+}
+
 // UpdateUser updates a user.
 func (u *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
 	if err := req.Validate(); err != nil {
@@ -102,35 +148,23 @@ func (u *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 	}
 	
 	updateResp, err := u.usecase.UpdateUser(ctx, model.UpdateUserArgs{
-		ID:        id,
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Nickname:  req.Nickname,
-		Email:     req.Email,
-		Country:   req.Country,
+		ID:                id,
+		FirstName:         req.FirstName,
+		LastName:          req.LastName,
+		Nickname:          req.Nickname,
+		Email:             req.Email,
+		Country:           req.Country,
+		Attributes:        req.Attributes.AsMap(),
+		ProfileVisibility: model.ProfileVisibility(req.ProfileVisibility),
 	})
 	if err != nil {
 		if errors.Is(err,model.ErrNotFound) {
 			log.Warn("attempt to update non-existing user")
-			return nil, status.Errorf(codes.NotFound, "user not found")
 		}
+		return nil, usecaseError("UpdateUser", err)
+	}
 
-		log.WithError(err).Error("error invoking usecase UpdateUser")
-		return nil, status.Errorf(codes.Internal, "internal error")
-	}
-
-	return &pb.UpdateUserResponse{
-		User: &pb.User{
-			Id:        updateResp.User.ID.String(),
-			FirstName: updateResp.User.FirstName,
-			LastName:  updateResp.User.LastName,
-			Nickname:  updateResp.User.Nickname,
-			Email:     updateResp.User.Email,
-			Country:   updateResp.User.Country,
-			CreatedAt: timestamppb.New(updateResp.User.CreatedAt),
-			UpdatedAt: timestamppb.New(updateResp.User.UpdatedAt),
-		},
-	}, nil
+	return &pb.UpdateUserResponse{User: userToProto(updateResp.User)}, nil
 }
 
 // RemoveUser deletes a user. 
@@ -148,13 +182,176 @@ func (u *UserService) RemoveUser(ctx context.Context, req *pb.RemoveUserRequest)
 		ID:         id,
 		HardDelete: req.HardDelete,
 	}); err != nil {
-		log.WithError(err).Error("error invoking usecase RemoveUser")
-		return nil, status.Errorf(codes.Internal, "internal error")
+		return nil, usecaseError("RemoveUser", err)
 	}
 
 	return &pb.RemoveUserResponse{}, nil
 }
 
+// RestoreUser restores a soft-deleted user.
+func (u *UserService) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.RestoreUser(ctx, model.RestoreUserArgs{ID: id})
+	if err != nil {
+		return nil, usecaseError("RestoreUser", err)
+	}
+
+	return &pb.RestoreUserResponse{User: userToProto(resp.User)}, nil
+}
+
+// UnlockUser lifts the lock of an account locked after too many failed credential checks.
+func (u *UserService) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest) (*pb.UnlockUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	if err := u.usecase.UnlockUser(ctx, model.UnlockUserArgs{ID: id}); err != nil {
+		return nil, usecaseError("UnlockUser", err)
+	}
+
+	return &pb.UnlockUserResponse{}, nil
+}
+
+// VerifyUser records the verification of the email of a user pending verification, activating their account.
+func (u *UserService) VerifyUser(ctx context.Context, req *pb.VerifyUserRequest) (*pb.VerifyUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	if err := u.usecase.VerifyUser(ctx, model.VerifyUserArgs{ID: id}); err != nil {
+		return nil, usecaseError("VerifyUser", err)
+	}
+
+	return &pb.VerifyUserResponse{}, nil
+}
+
+// ChangePassword changes the password of a user.
+func (u *UserService) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	if err := u.usecase.ChangePassword(ctx, model.ChangePasswordArgs{
+		ID:              id,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	}); err != nil {
+		return nil, usecaseError("ChangePassword", err)
+	}
+
+	return &pb.ChangePasswordResponse{}, nil
+}
+
+// ExportMyData exports all the data held about a user as a signed JSON archive.
+func (u *UserService) ExportMyData(ctx context.Context, req *pb.ExportMyDataRequest) (*pb.ExportMyDataResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	resp, err := u.usecase.ExportUserData(ctx, model.ExportUserDataArgs{UserID: id})
+	if err != nil {
+		return nil, usecaseError("ExportUserData", err)
+	}
+
+	return &pb.ExportMyDataResponse{
+		Archive:            resp.Archive,
+		Signature:          resp.Signature.Value,
+		SignatureAlgorithm: resp.Signature.Algorithm,
+		KeyId:              resp.Signature.KeyID,
+	}, nil
+}
+
+// EraseUser erases the personal data of a user.
+func (u *UserService) EraseUser(ctx context.Context, req *pb.EraseUserRequest) (*pb.EraseUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+
+	if err := u.usecase.EraseUser(ctx, model.EraseUserArgs{ID: id}); err != nil {
+		return nil, usecaseError("EraseUser", err)
+	}
+
+	return &pb.EraseUserResponse{}, nil
+}
+
+// ListUserAuditEntries lists the audit trail of a user.
+func (u *UserService) ListUserAuditEntries(ctx context.Context, req *pb.ListUserAuditEntriesRequest) (*pb.ListUserAuditEntriesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	id, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid")
+	}
+	occurredAfter := time.Time{}
+	if req.OccurredAfter.IsValid() {
+		occurredAfter = req.OccurredAfter.AsTime()
+	}
+	occurredBefore := time.Time{}
+	if req.OccurredBefore.IsValid() {
+		occurredBefore = req.OccurredBefore.AsTime()
+	}
+	actions := make([]model.AuditAction, len(req.Actions))
+	for i, action := range req.Actions {
+		actions[i] = model.AuditAction(action)
+	}
+
+	resp, err := u.usecase.ListAuditEntries(ctx, model.ListAuditEntriesArgs{
+		UserID:         id,
+		Actions:        actions,
+		ActorID:        req.ActorId,
+		OccurredAfter:  occurredAfter,
+		OccurredBefore: occurredBefore,
+		Limit:          req.GetPageSize(),
+		Offset:         req.GetOffset(),
+	})
+	if err != nil {
+		return nil, usecaseError("ListAuditEntries", err)
+	}
+
+	entries := make([]*pb.AuditEntry, len(resp.Entries))
+	for i, entry := range resp.Entries {
+		entries[i] = &pb.AuditEntry{
+			Id:            entry.ID.String(),
+			UserId:        entry.UserID.String(),
+			ActorId:       entry.ActorID,
+			Action:        string(entry.Action),
+			RequestId:     entry.RequestID,
+			SourceIp:      entry.SourceIP,
+			ChangedFields: entry.ChangedFields,
+			OccurredAt:    timestamppb.New(entry.OccurredAt),
+		}
+	}
+	return &pb.ListUserAuditEntriesResponse{Entries: entries}, nil
+}
+
 // userServiceUsecase
 type userServiceUsecase interface {
 	// CreateUser creates a user.
@@ -165,9 +362,154 @@ type userServiceUsecase interface {
 
 	// ListUsers lists users.
 	ListUsers(ctx context.Context, args model.ListUsersArgs) (*model.ListUsersResponse, error)
+
+	// GetUser gets a user.
+	GetUser(ctx context.Context, args model.GetUserArgs) (*model.GetUserResponse, error)
+
+	// GetUserHistory lists the versions of a user.
+	GetUserHistory(ctx context.Context, args model.GetUserHistoryArgs) (*model.GetUserHistoryResponse, error)
 	
 	// DeleteUser deletes a user.
 	DeleteUser(ctx context.Context, args model.DeleteUserArgs) error
+
+	// ExportUserData exports the data held about a user.
+	ExportUserData(ctx context.Context, args model.ExportUserDataArgs) (*model.ExportUserDataResponse, error)
+
+	// EraseUser erases the personal data of a user.
+	EraseUser(ctx context.Context, args model.EraseUserArgs) error
+
+	// RestoreUser restores a soft-deleted user.
+	RestoreUser(ctx context.Context, args model.RestoreUserArgs) (*model.RestoreUserResponse, error)
+
+	// UnlockUser lifts the lock of an account.
+	UnlockUser(ctx context.Context, args model.UnlockUserArgs) error
+
+	// VerifyUser records the verification of the email of a user.
+	VerifyUser(ctx context.Context, args model.VerifyUserArgs) error
+
+	// ChangePassword changes the password of a user.
+	ChangePassword(ctx context.Context, args model.ChangePasswordArgs) error
+
+	// ListAuditEntries lists the audit trail of a user.
+	ListAuditEntries(ctx context.Context, args model.ListAuditEntriesArgs) (*model.ListAuditEntriesResponse, error)
+
+	// Login logs a user in and opens a session.
+	Login(ctx context.Context, args model.LoginArgs) (*model.LoginResponse, error)
+
+	// CompleteLogin completes a login with a second factor.
+	CompleteLogin(ctx context.Context, args model.CompleteLoginArgs) (*model.SessionTokens, error)
+
+	// RefreshSession refreshes a session.
+	RefreshSession(ctx context.Context, args model.RefreshSessionArgs) (*model.SessionTokens, error)
+
+	// ListSessions lists the active sessions of a user.
+	ListSessions(ctx context.Context, args model.ListSessionsArgs) (*model.ListSessionsResponse, error)
+
+	// RevokeSession revokes a session of a user.
+	RevokeSession(ctx context.Context, args model.RevokeSessionArgs) error
+
+	// EnrollTOTP enrolls TOTP as second factor of a user.
+	EnrollTOTP(ctx context.Context, args model.EnrollTOTPArgs) (*model.TOTPEnrollment, error)
+
+	// ConfirmTOTP confirms the TOTP enrollment of a user.
+	ConfirmTOTP(ctx context.Context, args model.ConfirmTOTPArgs) (*model.ConfirmTOTPResponse, error)
+
+	// DisableTOTP disables the TOTP second factor of a user.
+	DisableTOTP(ctx context.Context, args model.DisableTOTPArgs) error
+
+	// CreateAPIKey creates an API key.
+	CreateAPIKey(ctx context.Context, args model.CreateAPIKeyArgs) (*model.CreateAPIKeyResponse, error)
+
+	// ListAPIKeys lists the API keys.
+	ListAPIKeys(ctx context.Context) (*model.ListAPIKeysResponse, error)
+
+	// RevokeAPIKey revokes an API key.
+	RevokeAPIKey(ctx context.Context, args model.RevokeAPIKeyArgs) error
+
+	// GrantRole grants a role to a user.
+	GrantRole(ctx context.Context, args model.GrantRoleArgs) error
+
+	// RevokeRole revokes a role of a user.
+	RevokeRole(ctx context.Context, args model.RevokeRoleArgs) error
+
+	// RequestFriendship sends a friend request on behalf of a user.
+	RequestFriendship(ctx context.Context, args model.RequestFriendshipArgs) (*model.RequestFriendshipResponse, error)
+
+	// AcceptFriendship accepts a friend request sent to a user.
+	AcceptFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error
+
+	// DeclineFriendship declines a friend request sent to a user.
+	DeclineFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error
+
+	// RemoveFriend removes a friendship of a user.
+	RemoveFriend(ctx context.Context, args model.RemoveFriendArgs) error
+
+	// ListFriends lists the friendships of a user.
+	ListFriends(ctx context.Context, args model.ListFriendsArgs) (*model.ListFriendsResponse, error)
+
+	// BlockUser blocks a user on behalf of another one.
+	BlockUser(ctx context.Context, args model.BlockUserArgs) (*model.BlockUserResponse, error)
+
+	// UnblockUser lifts a block of a user.
+	UnblockUser(ctx context.Context, args model.UnblockUserArgs) error
+
+	// ListBlockedUsers lists the users blocked by a user.
+	ListBlockedUsers(ctx context.Context, args model.ListBlockedUsersArgs) (*model.ListBlockedUsersResponse, error)
+
+	// IsBlocked reports whether either user blocked the other.
+	IsBlocked(ctx context.Context, args model.IsBlockedArgs) (bool, error)
+
+	// SuspendUser suspends a user.
+	SuspendUser(ctx context.Context, args model.SuspendUserArgs) (*model.SuspendUserResponse, error)
+
+	// LiftSuspension lifts the suspension of a user.
+	LiftSuspension(ctx context.Context, args model.LiftSuspensionArgs) error
+
+	// ListSuspensions lists the suspensions of a user.
+	ListSuspensions(ctx context.Context, args model.ListSuspensionsArgs) (*model.ListSuspensionsResponse, error)
+
+	// RequestAccountDeletion schedules the deletion of the account of a user.
+	RequestAccountDeletion(ctx context.Context, args model.RequestAccountDeletionArgs) (*model.RequestAccountDeletionResponse, error)
+
+	// CancelAccountDeletion cancels the scheduled deletion of the account of a user.
+	CancelAccountDeletion(ctx context.Context, args model.CancelAccountDeletionArgs) error
+
+	// GetNicknameHistory lists the nickname changes of a user.
+	GetNicknameHistory(ctx context.Context, args model.GetNicknameHistoryArgs) (*model.GetNicknameHistoryResponse, error)
+
+	// CheckNicknameAvailability tells whether a nickname can be taken.
+	CheckNicknameAvailability(ctx context.Context, args model.CheckNicknameAvailabilityArgs) (*model.CheckNicknameAvailabilityResponse, error)
+
+	// GetPublicProfile returns the public profile of a user.
+	GetPublicProfile(ctx context.Context, args model.GetPublicProfileArgs) (*model.GetPublicProfileResponse, error)
+}
+
+// usecaseError translates an error returned by the usecase into a gRPC status error. Unexpected errors are logged.
+func usecaseError(method string, err error) error {
+	var nicknameErr *model.NicknameError
+	if errors.As(err, &nicknameErr) {
+		return nicknameStatus(nicknameErr)
+	}
+	switch {
+	case errors.Is(err, model.ErrUnauthenticated):
+		return status.Errorf(codes.Unauthenticated, "unauthenticated")
+	case errors.Is(err, model.ErrInvalidCredentials):
+		return status.Errorf(codes.Unauthenticated, "invalid credentials")
+	case errors.Is(err, model.ErrSuspended):
+		return status.Errorf(codes.PermissionDenied, "account suspended")
+	case errors.Is(err, model.ErrPermissionDenied):
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, model.ErrFailedPrecondition):
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrInvalidArgument):
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrNotFound):
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	log.WithError(err).Errorf("error invoking usecase %s", method)
+	return status.Errorf(codes.Internal, "internal error")
 }
 
 func usersToProto(users []model.User) []*pb.User {
@@ -179,14 +521,51 @@ func usersToProto(users []model.User) []*pb.User {
 }
 
 func userToProto(user model.User) *pb.User {
-	return &pb.User{
-		Id:        user.ID.String(),
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Nickname:  user.Nickname,
-		Email:     user.Email,
-		Country:   user.Country,
-		CreatedAt: timestamppb.New(user.CreatedAt),
-		UpdatedAt: timestamppb.New(user.UpdatedAt),
+	ret := &pb.User{
+		Id:                user.ID.String(),
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		Nickname:          user.Nickname,
+		Email:             user.Email,
+		Country:           user.Country,
+		CreatedAt:         timestamppb.New(user.CreatedAt),
+		UpdatedAt:         timestamppb.New(user.UpdatedAt),
+		TotpEnabled:       !user.TOTPEnabledAt.IsZero(),
+		Roles:             rolesToProto(user.Roles),
+		Attributes:        attributesToProto(user.Attributes),
+		Status:            string(user.Status),
+		SuspensionReason:  string(user.SuspensionReason),
+		ProfileVisibility: string(user.ProfileVisibility.OrDefault()),
+		MaskedFields:      user.MaskedFields,
+	}
+	if !user.SuspendedAt.IsZero() {
+		ret.SuspendedAt = timestamppb.New(user.SuspendedAt)
+	}
+	if !user.SuspendedUntil.IsZero() {
+		ret.SuspendedUntil = timestamppb.New(user.SuspendedUntil)
 	}
+	if !user.DeletionScheduledFor.IsZero() {
+		ret.DeletionScheduledFor = timestamppb.New(user.DeletionScheduledFor)
+	}
+	return ret
+}
+
+func statusesFromProto(statuses []string) []model.UserStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+	ret := make([]model.UserStatus, len(statuses))
+	for i, userStatus := range statuses {
+		ret[i] = model.UserStatus(userStatus)
+	}
+	return ret
+}
+
+func attributesToProto(attributes map[string]interface{}) *structpb.Struct {
+	if len(attributes) == 0 {
+		return nil
+	}
+	// the attributes are decoded from JSON, they are always representable
+	ret, _ := structpb.NewStruct(attributes)
+	return ret
 }
\ No newline at end of file
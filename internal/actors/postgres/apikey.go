@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// SaveAPIKey saves a new API key.
+func (p *PostgresDB) SaveAPIKey(ctx context.Context, key *model.APIKey) error {
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+	_, err := p.db.ModelContext(ctx, &apiKeyDB{
+		ID:        key.ID,
+		Name:      key.Name,
+		KeyHash:   key.Hash,
+		Scopes:    scopes,
+		CreatedBy: key.CreatedBy,
+		CreatedAt: key.CreatedAt,
+		ExpiresAt: key.ExpiresAt,
+		RevokedAt: key.RevokedAt,
+	}).Insert()
+	return err
+}
+
+// GetAPIKeyByHash returns the API key by digest. It returns model.ErrNotFound if it does not exist.
+func (p *PostgresDB) GetAPIKeyByHash(ctx context.Context, hash string) (*model.APIKey, error) {
+	key := new(apiKeyDB)
+	if err := p.db.ModelContext(ctx, key).Where("key_hash = ?", hash).Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+	ret := translateAPIKey(*key)
+	return &ret, nil
+}
+
+// ListAPIKeys lists the API keys, revoked and expired ones included, most recently created first.
+func (p *PostgresDB) ListAPIKeys(ctx context.Context) ([]model.APIKey, error) {
+	var keys []apiKeyDB
+	if err := p.db.ModelContext(ctx, &keys).Order("created_at DESC").Select(); err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+	ret := make([]model.APIKey, len(keys))
+	for i, key := range keys {
+		ret[i] = translateAPIKey(key)
+	}
+	return ret, nil
+}
+
+// RevokeAPIKey revokes the API key. It returns model.ErrNotFound if the key does not exist or was revoked already.
+func (p *PostgresDB) RevokeAPIKey(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	res, err := p.db.ModelContext(ctx, (*apiKeyDB)(nil)).
+		Set("revoked_at = ?", revokedAt).
+		Where("id = ?", id).
+		Where("revoked_at IS NULL").
+		Update()
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+func translateAPIKey(key apiKeyDB) model.APIKey {
+	scopes := make([]model.Role, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = model.Role(scope)
+	}
+	return model.APIKey{
+		ID:        key.ID,
+		Name:      key.Name,
+		Hash:      key.KeyHash,
+		Scopes:    scopes,
+		CreatedBy: key.CreatedBy,
+		CreatedAt: key.CreatedAt,
+		ExpiresAt: key.ExpiresAt,
+		RevokedAt: key.RevokedAt,
+	}
+}
+
+type apiKeyDB struct {
+	tableName struct{} `pg:"faceittha.api_keys"`
+
+	// ID unique identifier of the key.
+	ID uuid.UUID `pg:"id,pk,type:uuid"`
+
+	// Name describes the holder of the key.
+	Name string `pg:"name,use_zero"`
+
+	// KeyHash is the SHA-256 digest of the key, hex encoded.
+	KeyHash string `pg:"key_hash"`
+
+	// Scopes are the roles granted to the holder of the key.
+	Scopes []string `pg:"scopes,array,use_zero"`
+
+	// CreatedBy is the ID of the actor that created the key.
+	CreatedBy string `pg:"created_by,use_zero"`
+
+	// CreatedAt is the time of the creation of the key.
+	CreatedAt time.Time `pg:"created_at"`
+
+	// ExpiresAt is the time after which the key is rejected. Zero-valued if the key does not expire.
+	ExpiresAt time.Time `pg:"expires_at"`
+
+	// RevokedAt is the time at which the key was revoked. Zero-valued if the key was not revoked.
+	RevokedAt time.Time `pg:"revoked_at"`
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+func (suite *PostgresDBTestSuite) TestAPIKeys() {
+	ctx := context.Background()
+	first := &model.APIKey{
+		ID:        uuid.New(),
+		Name:      "matchmaking",
+		Hash:      "first",
+		Scopes:    []model.Role{model.RoleSupport},
+		CreatedBy: "admin",
+		CreatedAt: dummyTime,
+		ExpiresAt: dummyTime.Add(time.Hour),
+	}
+	second := &model.APIKey{
+		ID:        uuid.New(),
+		Name:      "backoffice",
+		Hash:      "second",
+		Scopes:    []model.Role{},
+		CreatedBy: "admin",
+		CreatedAt: dummyTime.Add(time.Minute),
+	}
+	suite.Require().NoError(suite.postgresAdapter.SaveAPIKey(ctx, first))
+	suite.Require().NoError(suite.postgresAdapter.SaveAPIKey(ctx, second))
+
+	got, err := suite.postgresAdapter.GetAPIKeyByHash(ctx, "first")
+	suite.Require().NoError(err)
+	suite.Equal(*first, *got)
+	_, err = suite.postgresAdapter.GetAPIKeyByHash(ctx, "unknown")
+	suite.ErrorIs(err, model.ErrNotFound)
+
+	keys, err := suite.postgresAdapter.ListAPIKeys(ctx)
+	suite.Require().NoError(err)
+	suite.Equal([]model.APIKey{*second, *first}, keys)
+
+	suite.Require().NoError(suite.postgresAdapter.RevokeAPIKey(ctx, first.ID, dummyTime))
+	suite.ErrorIs(suite.postgresAdapter.RevokeAPIKey(ctx, first.ID, dummyTime), model.ErrNotFound)
+	suite.ErrorIs(suite.postgresAdapter.RevokeAPIKey(ctx, uuid.New(), dummyTime), model.ErrNotFound)
+	got, err = suite.postgresAdapter.GetAPIKeyByHash(ctx, "first")
+	suite.Require().NoError(err)
+	suite.Equal(dummyTime, got.RevokedAt)
+}
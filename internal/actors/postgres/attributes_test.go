@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestAttributes() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, jane))
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, john))
+
+	update := &model.User{ID: jane.ID, Attributes: map[string]interface{}{"team": "red", "level": float64(3)}}
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, update, ports.UpdateUserQuery{}))
+	suite.Equal(map[string]interface{}{"team": "red", "level": float64(3)}, update.Attributes)
+
+	// the attributes are merged, a nil value removes the key
+	update = &model.User{ID: jane.ID, Attributes: map[string]interface{}{"team": nil, "platform": "pc"}}
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, update, ports.UpdateUserQuery{}))
+	got, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(map[string]interface{}{"level": float64(3), "platform": "pc"}, got.Attributes)
+
+	// updates of the profile leave the attributes untouched
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "jd2"}, ports.UpdateUserQuery{}))
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(map[string]interface{}{"level": float64(3), "platform": "pc"}, got.Attributes)
+
+	res, err := suite.postgresAdapter.ListUsers(ctx, ports.ListUsersQuery{Attributes: map[string]string{"level": "3", "platform": "pc"}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Users, 1)
+	suite.Equal(jane.ID, res.Users[0].ID)
+
+	res, err = suite.postgresAdapter.ListUsers(ctx, ports.ListUsersQuery{Attributes: map[string]string{"platform": "console"}})
+	suite.Require().NoError(err)
+	suite.Empty(res.Users)
+
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: john.ID})
+	suite.Require().NoError(err)
+	suite.Nil(got.Attributes)
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// SaveAuditEntry appends the entry to the audit trail.
+func (p *PostgresDB) SaveAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	if entry == nil {
+		return errors.New("nil audit entry passed to save method")
+	}
+
+	dbEntry := &auditEntryDB{
+		ID:            entry.ID,
+		UserID:        entry.UserID,
+		ActorID:       entry.ActorID,
+		Action:        string(entry.Action),
+		RequestID:     entry.RequestID,
+		SourceIP:      entry.SourceIP,
+		ChangedFields: entry.ChangedFields,
+		OccurredAt:    entry.OccurredAt,
+	}
+	if dbEntry.ID == uuid.Nil {
+		dbEntry.ID = uuid.New()
+	}
+	if dbEntry.OccurredAt.IsZero() {
+		dbEntry.OccurredAt = p.nowFunc()
+	}
+	if _, err := p.db.ModelContext(ctx, dbEntry).Insert(); err != nil {
+		return err
+	}
+
+	entry.ID = dbEntry.ID
+	entry.OccurredAt = dbEntry.OccurredAt
+	return nil
+}
+
+// ListAuditEntries lists the audit entries matching the parameters in input, oldest first.
+func (p *PostgresDB) ListAuditEntries(ctx context.Context, query ports.ListAuditEntriesQuery) (*ports.ListAuditEntriesResult, error) {
+	var entries []auditEntryDB
+	q := p.db.ModelContext(ctx, &entries).
+		Where("user_id = ?", query.UserID).
+		Order("occurred_at ASC", "id ASC")
+
+	if len(query.Actions) > 0 {
+		q = q.WhereIn("action IN (?)", query.Actions)
+	}
+	if query.ActorID != "" {
+		q = q.Where("actor_id = ?", query.ActorID)
+	}
+	if !query.OccurredAfter.IsZero() {
+		q = q.Where("occurred_at > ?", query.OccurredAfter)
+	}
+	if !query.OccurredBefore.IsZero() {
+		q = q.Where("occurred_at < ?", query.OccurredBefore)
+	}
+	if query.Limit != uint32(0) {
+		q = q.Limit(int(query.Limit))
+	}
+	if query.Offset != uint32(0) {
+		q = q.Offset(int(query.Offset))
+	}
+	if err := q.Select(); err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+
+	ret := make([]model.AuditEntry, len(entries))
+	for i, entry := range entries {
+		ret[i] = model.AuditEntry{
+			ID:            entry.ID,
+			UserID:        entry.UserID,
+			ActorID:       entry.ActorID,
+			Action:        model.AuditAction(entry.Action),
+			RequestID:     entry.RequestID,
+			SourceIP:      entry.SourceIP,
+			ChangedFields: entry.ChangedFields,
+			OccurredAt:    entry.OccurredAt,
+		}
+	}
+	return &ports.ListAuditEntriesResult{Entries: ret}, nil
+}
+
+type auditEntryDB struct {
+	tableName struct{} `pg:"faceittha.user_audit"`
+
+	// ID unique identifier of the entry.
+	ID uuid.UUID `pg:"id,type:uuid"`
+
+	// UserID is the id of the user the operation was performed on.
+	UserID uuid.UUID `pg:"user_id,type:uuid"`
+
+	// ActorID is the id of the actor that performed the operation.
+	ActorID string `pg:"actor_id,use_zero"`
+
+	// Action is the operation that was performed.
+	Action string `pg:"action"`
+
+	// RequestID is the id of the request that performed the operation.
+	RequestID string `pg:"request_id,use_zero"`
+
+	// SourceIP is the IP address of the client that originated the request.
+	SourceIP string `pg:"source_ip,use_zero"`
+
+	// ChangedFields are the names of the user fields modified by the operation.
+	ChangedFields []string `pg:"changed_fields,array"`
+
+	// OccurredAt is the time at which the operation was performed.
+	OccurredAt time.Time `pg:"occurred_at"`
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestAuditEntries() {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	otherUserID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-5e60a5b5d5de")
+
+	first := &model.AuditEntry{
+		UserID:        userID,
+		ActorID:       userID.String(),
+		Action:        model.AuditActionUpdate,
+		RequestID:     "request-1",
+		SourceIP:      "10.0.0.1",
+		ChangedFields: []string{"first_name", "email"},
+		OccurredAt:    dummyTime.Add(-time.Minute),
+	}
+	second := &model.AuditEntry{
+		UserID: userID,
+		Action: model.AuditActionExportDenied,
+	}
+	other := &model.AuditEntry{
+		UserID:  otherUserID,
+		ActorID: "admin",
+		Action:  model.AuditActionExport,
+	}
+	for _, entry := range []*model.AuditEntry{second, first, other} {
+		suite.Require().NoError(suite.postgresAdapter.SaveAuditEntry(context.Background(), entry))
+		suite.NotEqual(uuid.Nil, entry.ID)
+	}
+	suite.Equal(dummyTime, second.OccurredAt)
+
+	got, err := suite.postgresAdapter.ListAuditEntries(context.Background(), ports.ListAuditEntriesQuery{UserID: userID})
+	suite.Require().NoError(err)
+	suite.Equal([]model.AuditEntry{*first, *second}, got.Entries)
+}
+
+func (suite *PostgresDBTestSuite) TestListAuditEntriesFilters() {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	entries := []*model.AuditEntry{
+		{UserID: userID, ActorID: "admin", Action: model.AuditActionCreate, OccurredAt: dummyTime.Add(-3 * time.Minute)},
+		{UserID: userID, ActorID: userID.String(), Action: model.AuditActionUpdate, OccurredAt: dummyTime.Add(-2 * time.Minute)},
+		{UserID: userID, ActorID: "admin", Action: model.AuditActionDelete, OccurredAt: dummyTime.Add(-time.Minute)},
+		{UserID: userID, ActorID: "admin", Action: model.AuditActionRestore, OccurredAt: dummyTime},
+	}
+	for _, entry := range entries {
+		suite.Require().NoError(suite.postgresAdapter.SaveAuditEntry(context.Background(), entry))
+	}
+
+	tests := []struct {
+		name     string
+		query    ports.ListAuditEntriesQuery
+		expected []model.AuditEntry
+	}{
+		{
+			name:     "filter by actions",
+			query:    ports.ListAuditEntriesQuery{UserID: userID, Actions: []model.AuditAction{model.AuditActionDelete, model.AuditActionRestore}},
+			expected: []model.AuditEntry{*entries[2], *entries[3]},
+		},
+		{
+			name:     "filter by actor",
+			query:    ports.ListAuditEntriesQuery{UserID: userID, ActorID: userID.String()},
+			expected: []model.AuditEntry{*entries[1]},
+		},
+		{
+			name:     "filter by time interval",
+			query:    ports.ListAuditEntriesQuery{UserID: userID, OccurredAfter: dummyTime.Add(-150 * time.Second), OccurredBefore: dummyTime},
+			expected: []model.AuditEntry{*entries[1], *entries[2]},
+		},
+		{
+			name:     "pagination",
+			query:    ports.ListAuditEntriesQuery{UserID: userID, Limit: 2, Offset: 1},
+			expected: []model.AuditEntry{*entries[1], *entries[2]},
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			got, err := suite.postgresAdapter.ListAuditEntries(context.Background(), test.query)
+			suite.Require().NoError(err)
+			suite.Equal(test.expected, got.Entries)
+		})
+	}
+}
+
+func (suite *PostgresDBTestSuite) TestAuditEntriesAreAppendOnly() {
+	entry := &model.AuditEntry{
+		UserID: uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+		Action: model.AuditActionCreate,
+	}
+	suite.Require().NoError(suite.postgresAdapter.SaveAuditEntry(context.Background(), entry))
+
+	_, err := suite.db.Exec("UPDATE faceittha.user_audit SET actor_id = 'someone' WHERE id = ?", entry.ID)
+	suite.Error(err)
+	_, err = suite.db.Exec("DELETE FROM faceittha.user_audit WHERE id = ?", entry.ID)
+	suite.Error(err)
+}
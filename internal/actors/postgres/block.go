@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// SaveBlock saves the block and deletes the friendship between the users, if any. It returns model.ErrNotFound if
+// either user does not exist or is deleted, and an error wrapping model.ErrFailedPrecondition if the user is blocked
+// already.
+func (p *PostgresDB) SaveBlock(ctx context.Context, block *model.Block) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		var users []userDB
+		err := tx.ModelContext(ctx, &users).
+			Column("id").
+			WhereIn("id IN (?)", []uuid.UUID{block.BlockerID, block.BlockedID}).
+			Where("deleted_at IS NULL").
+			For("SHARE").
+			Select()
+		if err != nil && err != pg.ErrNoRows {
+			return err
+		}
+		if len(users) != 2 {
+			return model.ErrNotFound
+		}
+		res, err := tx.ModelContext(ctx, &userBlockDB{
+			BlockerID: block.BlockerID,
+			BlockedID: block.BlockedID,
+			CreatedAt: block.CreatedAt,
+		}).OnConflict("DO NOTHING").Insert()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: user already blocked", model.ErrFailedPrecondition)
+		}
+		_, err = tx.ModelContext(ctx, (*friendshipDB)(nil)).
+			Where("(requester_id = ? AND addressee_id = ?) OR (requester_id = ? AND addressee_id = ?)",
+				block.BlockerID, block.BlockedID, block.BlockedID, block.BlockerID).
+			Delete()
+		return err
+	})
+}
+
+// DeleteBlock deletes the block of the blocked user by the blocker. It returns model.ErrNotFound if there is no such
+// block.
+func (p *PostgresDB) DeleteBlock(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	res, err := p.db.ModelContext(ctx, (*userBlockDB)(nil)).
+		Where("blocker_id = ?", blockerID).
+		Where("blocked_id = ?", blockedID).
+		Delete()
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// ListBlocks lists the blocks of the blocker, most recent first.
+func (p *PostgresDB) ListBlocks(ctx context.Context, query ports.ListBlocksQuery) ([]model.Block, error) {
+	var blocks []userBlockDB
+	q := p.db.ModelContext(ctx, &blocks).
+		Where("blocker_id = ?", query.BlockerID).
+		Order("created_at DESC", "blocked_id ASC")
+	if query.Limit != uint32(0) {
+		q = q.Limit(int(query.Limit))
+	}
+	if query.Offset != uint32(0) {
+		q = q.Offset(int(query.Offset))
+	}
+	if err := q.Select(); err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+	ret := make([]model.Block, len(blocks))
+	for i, block := range blocks {
+		ret[i] = model.Block{BlockerID: block.BlockerID, BlockedID: block.BlockedID, CreatedAt: block.CreatedAt}
+	}
+	return ret, nil
+}
+
+// IsBlocked reports whether either user blocked the other.
+func (p *PostgresDB) IsBlocked(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error) {
+	return p.db.ModelContext(ctx, (*userBlockDB)(nil)).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherUserID, otherUserID, userID).
+		Exists()
+}
+
+type userBlockDB struct {
+	tableName struct{} `pg:"faceittha.user_blocks"`
+
+	// BlockerID is the id of the user who blocked the other one.
+	BlockerID uuid.UUID `pg:"blocker_id,pk,type:uuid"`
+
+	// BlockedID is the id of the blocked user.
+	BlockedID uuid.UUID `pg:"blocked_id,pk,type:uuid"`
+
+	// CreatedAt is the time of the block.
+	CreatedAt time.Time `pg:"created_at"`
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestBlocks() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	jim := &model.User{ID: uuid.New(), Nickname: "ji", Email: "jim@example.com", PasswordHash: "hash"}
+	for _, user := range []*model.User{jane, john, jim} {
+		suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+	}
+
+	block := func(blocker, blocked *model.User, createdAt time.Time) error {
+		return suite.postgresAdapter.SaveBlock(ctx, &model.Block{BlockerID: blocker.ID, BlockedID: blocked.ID, CreatedAt: createdAt})
+	}
+	// blocking deletes the friendship
+	suite.Require().NoError(suite.postgresAdapter.SaveFriendship(ctx, &model.Friendship{
+		RequesterID: john.ID,
+		AddresseeID: jane.ID,
+		Status:      model.FriendshipStatusPending,
+		CreatedAt:   dummyTime,
+	}))
+	suite.Require().NoError(block(jane, john, dummyTime))
+	_, err := suite.postgresAdapter.GetFriendship(ctx, jane.ID, john.ID)
+	suite.ErrorIs(err, model.ErrNotFound)
+	suite.ErrorIs(block(jane, john, dummyTime), model.ErrFailedPrecondition)
+	suite.ErrorIs(block(jane, &model.User{ID: uuid.New()}, dummyTime), model.ErrNotFound)
+	suite.Require().NoError(block(jane, jim, dummyTime.Add(time.Minute)))
+
+	// blocks are checked in both directions
+	blocked, err := suite.postgresAdapter.IsBlocked(ctx, john.ID, jane.ID)
+	suite.Require().NoError(err)
+	suite.True(blocked)
+	blocked, err = suite.postgresAdapter.IsBlocked(ctx, john.ID, jim.ID)
+	suite.Require().NoError(err)
+	suite.False(blocked)
+
+	blocks, err := suite.postgresAdapter.ListBlocks(ctx, ports.ListBlocksQuery{BlockerID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal([]model.Block{
+		{BlockerID: jane.ID, BlockedID: jim.ID, CreatedAt: dummyTime.Add(time.Minute)},
+		{BlockerID: jane.ID, BlockedID: john.ID, CreatedAt: dummyTime},
+	}, blocks)
+	blocks, err = suite.postgresAdapter.ListBlocks(ctx, ports.ListBlocksQuery{BlockerID: jane.ID, Limit: 1, Offset: 1})
+	suite.Require().NoError(err)
+	suite.Len(blocks, 1)
+
+	// only the blocker lifts the block
+	suite.ErrorIs(suite.postgresAdapter.DeleteBlock(ctx, john.ID, jane.ID), model.ErrNotFound)
+	suite.Require().NoError(suite.postgresAdapter.DeleteBlock(ctx, jane.ID, john.ID))
+	blocked, err = suite.postgresAdapter.IsBlocked(ctx, john.ID, jane.ID)
+	suite.Require().NoError(err)
+	suite.False(blocked)
+
+	// erasing a user deletes their blocks
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(ctx, jim.ID))
+	blocks, err = suite.postgresAdapter.ListBlocks(ctx, ports.ListBlocksQuery{BlockerID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Empty(blocks)
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// GetDataKey returns the data key of the user. It returns model.ErrNotFound if the user has no data key.
+func (p *PostgresDB) GetDataKey(ctx context.Context, userID uuid.UUID) (*model.DataKey, error) {
+	key := new(dataKeyDB)
+	if err := p.db.ModelContext(ctx, key).Where("user_id = ?", userID).Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+	return &model.DataKey{
+		UserID:      key.UserID,
+		WrappedKey:  key.WrappedKey,
+		CreatedAt:   key.CreatedAt,
+		DestroyedAt: key.DestroyedAt,
+	}, nil
+}
+
+// SaveDataKey saves the data key unless the user already has one.
+func (p *PostgresDB) SaveDataKey(ctx context.Context, key *model.DataKey) error {
+	if key == nil {
+		return errors.New("nil data key passed to save method")
+	}
+	dbKey := &dataKeyDB{
+		UserID:     key.UserID,
+		WrappedKey: key.WrappedKey,
+		CreatedAt:  p.nowFunc(),
+	}
+	if _, err := p.db.ModelContext(ctx, dbKey).OnConflict("(user_id) DO NOTHING").Insert(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DestroyDataKey discards the wrapped data key of the user and marks it as destroyed. Users without a data key get a
+// destroyed marker so that no key can be created for them afterwards.
+func (p *PostgresDB) DestroyDataKey(ctx context.Context, userID uuid.UUID) error {
+	now := p.nowFunc()
+	_, err := p.db.ExecContext(ctx, `
+		INSERT INTO faceittha.user_data_keys (user_id, wrapped_key, created_at, destroyed_at) VALUES (?, NULL, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE
+		SET wrapped_key = NULL, destroyed_at = COALESCE(faceittha.user_data_keys.destroyed_at, EXCLUDED.destroyed_at)`,
+		userID, now, now)
+	return err
+}
+
+type dataKeyDB struct {
+	tableName struct{} `pg:"faceittha.user_data_keys"`
+
+	// UserID is the id of the user the key belongs to.
+	UserID uuid.UUID `pg:"user_id,pk,type:uuid"`
+
+	// WrappedKey is the data key encrypted with the master key. NULL if the key was destroyed.
+	WrappedKey []byte `pg:"wrapped_key"`
+
+	// CreatedAt is the time at which the key was created.
+	CreatedAt time.Time `pg:"created_at"`
+
+	// DestroyedAt is the time at which the key was destroyed.
+	DestroyedAt time.Time `pg:"destroyed_at"`
+}
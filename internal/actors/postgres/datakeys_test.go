@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/actors/vault"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestDataKeys() {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+
+	_, err := suite.postgresAdapter.GetDataKey(context.Background(), userID)
+	suite.ErrorIs(err, model.ErrNotFound)
+
+	suite.Require().NoError(suite.postgresAdapter.SaveDataKey(context.Background(), &model.DataKey{UserID: userID, WrappedKey: []byte("first")}))
+	// the first key wins
+	suite.Require().NoError(suite.postgresAdapter.SaveDataKey(context.Background(), &model.DataKey{UserID: userID, WrappedKey: []byte("second")}))
+	got, err := suite.postgresAdapter.GetDataKey(context.Background(), userID)
+	suite.Require().NoError(err)
+	suite.Equal(&model.DataKey{UserID: userID, WrappedKey: []byte("first"), CreatedAt: dummyTime}, got)
+
+	suite.Require().NoError(suite.postgresAdapter.DestroyDataKey(context.Background(), userID))
+	got, err = suite.postgresAdapter.GetDataKey(context.Background(), userID)
+	suite.Require().NoError(err)
+	suite.Equal(&model.DataKey{UserID: userID, CreatedAt: dummyTime, DestroyedAt: dummyTime}, got)
+
+	// users without a key get a destroyed marker
+	otherUserID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-5e60a5b5d5de")
+	suite.Require().NoError(suite.postgresAdapter.DestroyDataKey(context.Background(), otherUserID))
+	got, err = suite.postgresAdapter.GetDataKey(context.Background(), otherUserID)
+	suite.Require().NoError(err)
+	suite.Equal(dummyTime, got.DestroyedAt)
+}
+
+func (suite *PostgresDBTestSuite) TestPersonalDataIsSealed() {
+	v, err := vault.NewVault(vault.VaultArgs{Repository: suite.postgresAdapter, MasterKey: []byte(strings.Repeat("k", 32))})
+	suite.Require().NoError(err)
+	adapter, err := NewPostgresDB(PostgresDBArgs{DB: suite.db}, WithNowFunc(suite.postgresAdapter.nowFunc), WithVault(v))
+	suite.Require().NoError(err)
+
+	user := &model.User{
+		ID:           uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+		FirstName:    "fn1",
+		LastName:     "ln1",
+		Nickname:     "n1",
+		Email:        "e1",
+		PasswordHash: "h1",
+		Country:      "uk",
+	}
+	suite.Require().NoError(adapter.SaveUser(context.Background(), user))
+
+	stored := new(userDB)
+	suite.Require().NoError(suite.db.Model(stored).Where("id = ?", user.ID).Select())
+	suite.NotEqual("fn1", stored.FirstName)
+	suite.NotEqual("ln1", stored.LastName)
+	suite.NotEqual("e1", stored.Email)
+	suite.Equal("n1", stored.Nickname)
+
+	got, err := adapter.GetUser(context.Background(), ports.GetUserQuery{ID: user.ID})
+	suite.Require().NoError(err)
+	suite.Equal("fn1", got.FirstName)
+	suite.Equal("ln1", got.LastName)
+	suite.Equal("e1", got.Email)
+
+	// the data of a user whose key was destroyed reads as empty
+	suite.Require().NoError(v.DestroyKey(context.Background(), user.ID))
+	got, err = adapter.GetUser(context.Background(), ports.GetUserQuery{ID: user.ID})
+	suite.Require().NoError(err)
+	suite.Empty(got.FirstName)
+	suite.Empty(got.Email)
+	suite.Equal("n1", got.Nickname)
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// ScheduleDeletion schedules the deletion of the user for the given time. It returns model.ErrNotFound if the user
+// does not exist or is deleted, and an error wrapping model.ErrFailedPrecondition if the user is scheduled for deletion
+// already or cannot be in their status.
+func (p *PostgresDB) ScheduleDeletion(ctx context.Context, userID uuid.UUID, scheduledFor time.Time, requestedAt time.Time) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		user, err := lockStatus(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if !user.DeletedAt.IsZero() {
+			return model.ErrNotFound
+		}
+		if err := model.UserStatus(user.Status).CheckTransition(model.UserStatusScheduledForDeletion); err != nil {
+			return err
+		}
+		_, err = tx.ModelContext(ctx, (*userDB)(nil)).
+			Set("deletion_scheduled_for = ?", scheduledFor).
+			Set("status = ?", model.UserStatusScheduledForDeletion).
+			Set("updated_at = ?", requestedAt).
+			Where("id = ?", userID).
+			Update()
+		return err
+	})
+}
+
+// CancelDeletion cancels the scheduled deletion of the user, who settles back in their status. It returns
+// model.ErrNotFound if the user does not exist or is deleted, and an error wrapping model.ErrFailedPrecondition if the
+// user is not scheduled for deletion.
+func (p *PostgresDB) CancelDeletion(ctx context.Context, userID uuid.UUID, cancelledAt time.Time) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		user, err := lockStatus(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if !user.DeletedAt.IsZero() {
+			return model.ErrNotFound
+		}
+		if user.Status != string(model.UserStatusScheduledForDeletion) {
+			return fmt.Errorf("%w: user is %s, not scheduled for deletion", model.ErrFailedPrecondition, user.Status)
+		}
+		_, err = tx.ModelContext(ctx, (*userDB)(nil)).
+			Set("deletion_scheduled_for = NULL").
+			Set("status = ?", translateDBToModel(*user).SettledStatus()).
+			Set("updated_at = ?", cancelledAt).
+			Where("id = ?", userID).
+			Update()
+		return err
+	})
+}
+
+// ListDueDeletions returns the ids of the users whose deletion is due at the given time.
+func (p *PostgresDB) ListDueDeletions(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := p.db.ModelContext(ctx, (*userDB)(nil)).
+		Column("id").
+		Where("status = ?", model.UserStatusScheduledForDeletion).
+		Where("deletion_scheduled_for <= ?", now).
+		Order("deletion_scheduled_for ASC").
+		Select(&userIDs)
+	if err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// DeleteScheduledUser soft-deletes the user if their deletion is due at the given time. The user is locked and checked
+// again, the deletion may have been cancelled since it was listed. It returns model.ErrNotFound if the user does not
+// exist and an error wrapping model.ErrFailedPrecondition if the deletion of the user is not due.
+func (p *PostgresDB) DeleteScheduledUser(ctx context.Context, userID uuid.UUID, now time.Time) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		user, err := lockStatus(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if user.Status != string(model.UserStatusScheduledForDeletion) || user.DeletionScheduledFor.After(now) {
+			return fmt.Errorf("%w: deletion of user is not due", model.ErrFailedPrecondition)
+		}
+		_, err = tx.ModelContext(ctx, (*userDB)(nil)).
+			Set("deleted_at = ?", now).
+			Set("status = ?", model.UserStatusDeleted).
+			Set("deletion_scheduled_for = NULL").
+			Set("updated_at = ?", now).
+			Where("id = ?", userID).
+			Update()
+		return err
+	})
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestAccountDeletion() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	for _, user := range []*model.User{jane, john} {
+		suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+	}
+	suite.Require().NoError(suite.postgresAdapter.VerifyUser(ctx, jane.ID, dummyTime))
+	scheduledFor := dummyTime.Add(time.Hour)
+
+	suite.Require().NoError(suite.postgresAdapter.ScheduleDeletion(ctx, jane.ID, scheduledFor, dummyTime))
+	suite.ErrorIs(suite.postgresAdapter.ScheduleDeletion(ctx, jane.ID, scheduledFor, dummyTime), model.ErrFailedPrecondition)
+	suite.ErrorIs(suite.postgresAdapter.ScheduleDeletion(ctx, uuid.New(), scheduledFor, dummyTime), model.ErrNotFound)
+	got, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(model.UserStatusScheduledForDeletion, got.Status)
+	suite.Equal(scheduledFor, got.DeletionScheduledFor)
+
+	// updates of the profile leave the deletion untouched
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "jd2"}, ports.UpdateUserQuery{}))
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(scheduledFor, got.DeletionScheduledFor)
+
+	suite.Require().NoError(suite.postgresAdapter.CancelDeletion(ctx, jane.ID, dummyTime))
+	suite.ErrorIs(suite.postgresAdapter.CancelDeletion(ctx, jane.ID, dummyTime), model.ErrFailedPrecondition)
+	suite.ErrorIs(suite.postgresAdapter.CancelDeletion(ctx, uuid.New(), dummyTime), model.ErrNotFound)
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(model.UserStatusActive, got.Status)
+	suite.True(got.DeletionScheduledFor.IsZero())
+
+	// suspending a user cancels their deletion
+	suite.Require().NoError(suite.postgresAdapter.ScheduleDeletion(ctx, jane.ID, scheduledFor, dummyTime))
+	suite.Require().NoError(suite.postgresAdapter.SaveSuspension(ctx, &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    jane.ID,
+		Reason:    model.SuspensionReasonCheating,
+		CreatedAt: dummyTime,
+	}))
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(model.UserStatusSuspended, got.Status)
+	suite.True(got.DeletionScheduledFor.IsZero())
+	suite.ErrorIs(suite.postgresAdapter.ScheduleDeletion(ctx, jane.ID, scheduledFor, dummyTime), model.ErrFailedPrecondition)
+	suite.Require().NoError(suite.postgresAdapter.LiftSuspension(ctx, jane.ID, "support", dummyTime))
+
+	// the due deletions are listed and carried out, checked again
+	suite.Require().NoError(suite.postgresAdapter.ScheduleDeletion(ctx, jane.ID, scheduledFor, dummyTime))
+	suite.Require().NoError(suite.postgresAdapter.ScheduleDeletion(ctx, john.ID, scheduledFor.Add(time.Hour), dummyTime))
+	due, err := suite.postgresAdapter.ListDueDeletions(ctx, dummyTime)
+	suite.Require().NoError(err)
+	suite.Empty(due)
+	due, err = suite.postgresAdapter.ListDueDeletions(ctx, scheduledFor)
+	suite.Require().NoError(err)
+	suite.Equal([]uuid.UUID{jane.ID}, due)
+	suite.ErrorIs(suite.postgresAdapter.DeleteScheduledUser(ctx, john.ID, scheduledFor), model.ErrFailedPrecondition)
+	suite.ErrorIs(suite.postgresAdapter.DeleteScheduledUser(ctx, uuid.New(), scheduledFor), model.ErrNotFound)
+	suite.Require().NoError(suite.postgresAdapter.DeleteScheduledUser(ctx, jane.ID, scheduledFor))
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID, IncludeDeleted: true})
+	suite.Require().NoError(err)
+	suite.Equal(model.UserStatusDeleted, got.Status)
+	suite.Equal(scheduledFor, got.DeletedAt)
+	suite.True(got.DeletionScheduledFor.IsZero())
+	suite.ErrorIs(suite.postgresAdapter.CancelDeletion(ctx, jane.ID, dummyTime), model.ErrNotFound)
+	suite.ErrorIs(suite.postgresAdapter.DeleteScheduledUser(ctx, jane.ID, scheduledFor), model.ErrFailedPrecondition)
+
+	// restored users settle back in their status
+	restored, err := suite.postgresAdapter.RestoreUser(ctx, jane.ID)
+	suite.Require().NoError(err)
+	suite.Equal(model.UserStatusActive, restored.Status)
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// emailIndexBatchSize is the number of users read per query by ReindexEmails.
+const emailIndexBatchSize = 500
+
+// EmailIndexCollision is a user who could not be indexed by their canonical email, since it is the index of another
+// user. The user keeps their current index until the collision is resolved manually, e.g. by changing one of the emails.
+type EmailIndexCollision struct {
+	UserID   uuid.UUID
+	HolderID uuid.UUID
+}
+
+// ReindexEmails indexes the users by their canonical email: the ones who have no index, e.g. the ones created before
+// its introduction, and the ones still indexed by the legacy form of their email. Their email is opened with the vault
+// to be indexed. It is run once by the cmd/reindexemails job after the migrations, and can be run again, e.g. once the
+// collisions are resolved. It returns the number of users indexed and the collisions, which are left untouched. The
+// oldest users are indexed first and erased users are skipped.
+func (p *PostgresDB) ReindexEmails(ctx context.Context) (int, []EmailIndexCollision, error) {
+	indexed := 0
+	var collisions []EmailIndexCollision
+	var after userDB
+	for {
+		var users []userDB
+		q := p.db.ModelContext(ctx, &users).
+			Column("id", "email", "email_index", "created_at").
+			Where("erased_at IS NULL").
+			Order("created_at ASC", "id ASC").
+			Limit(emailIndexBatchSize)
+		if after.ID != uuid.Nil {
+			q = q.Where("(created_at, id) > (?, ?)", after.CreatedAt, after.ID)
+		}
+		if err := q.Select(); err != nil {
+			return indexed, collisions, err
+		}
+		for _, user := range users {
+			after = user
+			email, err := p.openEmail(ctx, user)
+			if err != nil {
+				return indexed, collisions, fmt.Errorf("error opening email of user %s: %w", user.ID, err)
+			}
+			index := p.emailIndex(email)
+			if email == "" || user.EmailIndex == index {
+				continue
+			}
+			holder, err := p.setEmailIndex(ctx, user.ID, index)
+			if err != nil {
+				return indexed, collisions, err
+			}
+			if holder != uuid.Nil {
+				collisions = append(collisions, EmailIndexCollision{UserID: user.ID, HolderID: holder})
+				continue
+			}
+			indexed++
+		}
+		if len(users) < emailIndexBatchSize {
+			return indexed, collisions, nil
+		}
+	}
+}
+
+// setEmailIndex stores the email index of the user, unless it is the index of another user, whose id is returned.
+func (p *PostgresDB) setEmailIndex(ctx context.Context, id uuid.UUID, index string) (uuid.UUID, error) {
+	_, err := p.db.ModelContext(ctx, (*userDB)(nil)).
+		Set("email_index = ?", index).
+		Where("id = ?", id).
+		Update()
+	if !uniqueViolation(err, "users_email_index_key") {
+		return uuid.Nil, err
+	}
+	holder := new(userDB)
+	if err := p.db.ModelContext(ctx, holder).Column("id").Where("email_index = ?", index).Select(); err != nil {
+		return uuid.Nil, err
+	}
+	return holder.ID, nil
+}
+
+// openEmail returns the email of the user, decrypted if a vault is configured. The email of a user whose data key was
+// destroyed reads as empty.
+func (p *PostgresDB) openEmail(ctx context.Context, user userDB) (string, error) {
+	email := user.Email
+	if p.vault == nil {
+		return email, nil
+	}
+	err := p.vault.Open(ctx, user.ID, &email)
+	if errors.Is(err, model.ErrDataKeyDestroyed) {
+		return "", nil
+	}
+	return email, err
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/actors/vault"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestReindexEmails() {
+	ctx := context.Background()
+	v, err := vault.NewVault(vault.VaultArgs{Repository: suite.postgresAdapter, MasterKey: []byte(strings.Repeat("k", 32))})
+	suite.Require().NoError(err)
+	adapter, err := NewPostgresDB(PostgresDBArgs{DB: suite.db}, WithNowFunc(suite.postgresAdapter.nowFunc), WithVault(v))
+	suite.Require().NoError(err)
+
+	unindexed := &model.User{ID: uuid.New(), Nickname: "unindexed", Email: "unindexed@example.com", PasswordHash: "hash", CreatedAt: dummyTime.Add(-time.Hour)}
+	legacy := &model.User{ID: uuid.New(), Nickname: "legacy", Email: "John.Doe@gmail.com", PasswordHash: "hash", CreatedAt: dummyTime.Add(-time.Minute)}
+	duplicate := &model.User{ID: uuid.New(), Nickname: "duplicate", Email: "duplicate@example.com", PasswordHash: "hash"}
+	erased := &model.User{ID: uuid.New(), Nickname: "erased", Email: "erased@example.com", PasswordHash: "hash"}
+	for _, user := range []*model.User{unindexed, legacy, duplicate, erased} {
+		suite.Require().NoError(adapter.SaveUser(ctx, user))
+	}
+	suite.Require().NoError(adapter.EraseUser(ctx, erased.ID))
+	// the users created before the introduction of the index have none, the others have the lowercase email as index
+	// before the introduction of the canonical emails
+	_, err = suite.db.Exec("UPDATE faceittha.users SET email_index = NULL WHERE id = ?", unindexed.ID)
+	suite.Require().NoError(err)
+	_, err = suite.db.Exec("UPDATE faceittha.users SET email_index = ? WHERE id = ?", adapter.blindEmail("john.doe@gmail.com"), legacy.ID)
+	suite.Require().NoError(err)
+	email := "John.Doe+news@gmail.com"
+	suite.Require().NoError(adapter.vault.Seal(ctx, duplicate.ID, &email))
+	_, err = suite.db.Exec("UPDATE faceittha.users SET email = ?, email_index = ? WHERE id = ?", email, adapter.blindEmail("john.doe+news@gmail.com"), duplicate.ID)
+	suite.Require().NoError(err)
+	_, err = adapter.GetUser(ctx, ports.GetUserQuery{Email: "unindexed@example.com"})
+	suite.Require().ErrorIs(err, model.ErrNotFound)
+	// the users not yet re-indexed log in with their legacy index
+	got, err := adapter.GetUser(ctx, ports.GetUserQuery{Email: "John.Doe@gmail.com"})
+	suite.Require().NoError(err)
+	suite.Equal(legacy.ID, got.ID)
+
+	indexed, collisions, err := adapter.ReindexEmails(ctx)
+	suite.Require().NoError(err)
+	suite.Equal(2, indexed)
+	// the canonical email of the duplicate is held by the older legacy user: it is reported and left untouched
+	suite.Equal([]EmailIndexCollision{{UserID: duplicate.ID, HolderID: legacy.ID}}, collisions)
+	got, err = adapter.GetUser(ctx, ports.GetUserQuery{Email: "unindexed@example.com"})
+	suite.Require().NoError(err)
+	suite.Equal(unindexed.ID, got.ID)
+	got, err = adapter.GetUser(ctx, ports.GetUserQuery{Email: "j.o.h.n.doe@gmail.com"})
+	suite.Require().NoError(err)
+	suite.Equal(legacy.ID, got.ID)
+	var index string
+	_, err = suite.db.QueryOne(pg.Scan(&index), "SELECT email_index FROM faceittha.users WHERE id = ?", duplicate.ID)
+	suite.Require().NoError(err)
+	suite.Equal(adapter.blindEmail("john.doe+news@gmail.com"), index)
+
+	// the re-index is idempotent, the collisions are reported until they are resolved
+	indexed, collisions, err = adapter.ReindexEmails(ctx)
+	suite.Require().NoError(err)
+	suite.Zero(indexed)
+	suite.Len(collisions, 1)
+}
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// SaveFriendship saves a pending friendship. It returns model.ErrNotFound if either user does not exist or is deleted,
+// and an error wrapping model.ErrFailedPrecondition if the users already have a friendship, whatever its direction and
+// status.
+func (p *PostgresDB) SaveFriendship(ctx context.Context, friendship *model.Friendship) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		// the users are kept from being deleted until the friendship is saved
+		var users []userDB
+		err := tx.ModelContext(ctx, &users).
+			Column("id").
+			WhereIn("id IN (?)", []uuid.UUID{friendship.RequesterID, friendship.AddresseeID}).
+			Where("deleted_at IS NULL").
+			For("SHARE").
+			Select()
+		if err != nil && err != pg.ErrNoRows {
+			return err
+		}
+		if len(users) != 2 {
+			return model.ErrNotFound
+		}
+		// the unique index on the pair of users rejects the requests in the opposite direction too
+		res, err := tx.ModelContext(ctx, toFriendshipDB(*friendship)).OnConflict("DO NOTHING").Insert()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: friendship already exists", model.ErrFailedPrecondition)
+		}
+		return nil
+	})
+}
+
+// GetFriendship returns the friendship between the two users, whatever its direction. It returns model.ErrNotFound if
+// the users have no friendship.
+func (p *PostgresDB) GetFriendship(ctx context.Context, userID, friendID uuid.UUID) (*model.Friendship, error) {
+	friendship := &friendshipDB{}
+	err := p.db.ModelContext(ctx, friendship).
+		Where("(requester_id = ? AND addressee_id = ?) OR (requester_id = ? AND addressee_id = ?)", userID, friendID, friendID, userID).
+		Select()
+	if err == pg.ErrNoRows {
+		return nil, model.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	ret := translateFriendship(*friendship)
+	return &ret, nil
+}
+
+// AcceptFriendship accepts the pending friend request of the requester to the addressee. It returns model.ErrNotFound
+// if there is no such pending request.
+func (p *PostgresDB) AcceptFriendship(ctx context.Context, requesterID, addresseeID uuid.UUID, acceptedAt time.Time) error {
+	res, err := p.db.ModelContext(ctx, (*friendshipDB)(nil)).
+		Set("status = ?", model.FriendshipStatusAccepted).
+		Set("accepted_at = ?", acceptedAt).
+		Where("requester_id = ?", requesterID).
+		Where("addressee_id = ?", addresseeID).
+		Where("status = ?", model.FriendshipStatusPending).
+		Update()
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteFriendship deletes the friendship if it is still in the given status. It returns model.ErrNotFound otherwise.
+func (p *PostgresDB) DeleteFriendship(ctx context.Context, friendship model.Friendship) error {
+	res, err := p.db.ModelContext(ctx, (*friendshipDB)(nil)).
+		Where("requester_id = ?", friendship.RequesterID).
+		Where("addressee_id = ?", friendship.AddresseeID).
+		Where("status = ?", friendship.Status).
+		Delete()
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// ListFriendships lists the friendships of a user, in both directions, oldest first.
+func (p *PostgresDB) ListFriendships(ctx context.Context, query ports.ListFriendshipsQuery) ([]model.Friendship, error) {
+	var friendships []friendshipDB
+	q := p.db.ModelContext(ctx, &friendships).
+		Where("requester_id = ? OR addressee_id = ?", query.UserID, query.UserID).
+		Order("created_at ASC", "requester_id ASC", "addressee_id ASC")
+	if query.Status != "" {
+		q = q.Where("status = ?", query.Status)
+	}
+	if query.Limit != uint32(0) {
+		q = q.Limit(int(query.Limit))
+	}
+	if query.Offset != uint32(0) {
+		q = q.Offset(int(query.Offset))
+	}
+	if err := q.Select(); err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+	ret := make([]model.Friendship, len(friendships))
+	for i, friendship := range friendships {
+		ret[i] = translateFriendship(friendship)
+	}
+	return ret, nil
+}
+
+func toFriendshipDB(friendship model.Friendship) *friendshipDB {
+	return &friendshipDB{
+		RequesterID: friendship.RequesterID,
+		AddresseeID: friendship.AddresseeID,
+		Status:      string(friendship.Status),
+		CreatedAt:   friendship.CreatedAt,
+		AcceptedAt:  friendship.AcceptedAt,
+	}
+}
+
+func translateFriendship(friendship friendshipDB) model.Friendship {
+	return model.Friendship{
+		RequesterID: friendship.RequesterID,
+		AddresseeID: friendship.AddresseeID,
+		Status:      model.FriendshipStatus(friendship.Status),
+		CreatedAt:   friendship.CreatedAt,
+		AcceptedAt:  friendship.AcceptedAt,
+	}
+}
+
+type friendshipDB struct {
+	tableName struct{} `pg:"faceittha.friendships"`
+
+	// RequesterID is the id of the user who sent the friend request.
+	RequesterID uuid.UUID `pg:"requester_id,pk,type:uuid"`
+
+	// AddresseeID is the id of the user the friend request was sent to.
+	AddresseeID uuid.UUID `pg:"addressee_id,pk,type:uuid"`
+
+	// Status is the state of the friendship.
+	Status string `pg:"status"`
+
+	// CreatedAt is the time of the friend request.
+	CreatedAt time.Time `pg:"created_at"`
+
+	// AcceptedAt is the time at which the friend request was accepted. Zero-valued while pending.
+	AcceptedAt time.Time `pg:"accepted_at"`
+}
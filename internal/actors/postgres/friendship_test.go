@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestFriendships() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	jim := &model.User{ID: uuid.New(), Nickname: "ji", Email: "jim@example.com", PasswordHash: "hash"}
+	for _, user := range []*model.User{jane, john, jim} {
+		suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+	}
+
+	request := func(requester, addressee *model.User) error {
+		return suite.postgresAdapter.SaveFriendship(ctx, &model.Friendship{
+			RequesterID: requester.ID,
+			AddresseeID: addressee.ID,
+			Status:      model.FriendshipStatusPending,
+			CreatedAt:   dummyTime,
+		})
+	}
+	suite.Require().NoError(request(jane, john))
+	suite.Require().NoError(request(jim, jane))
+	// the users have a single friendship, whatever its direction
+	suite.ErrorIs(request(jane, john), model.ErrFailedPrecondition)
+	suite.ErrorIs(request(john, jane), model.ErrFailedPrecondition)
+	suite.ErrorIs(request(jane, &model.User{ID: uuid.New()}), model.ErrNotFound)
+
+	got, err := suite.postgresAdapter.GetFriendship(ctx, john.ID, jane.ID)
+	suite.Require().NoError(err)
+	suite.Equal(model.Friendship{RequesterID: jane.ID, AddresseeID: john.ID, Status: model.FriendshipStatusPending, CreatedAt: dummyTime}, *got)
+
+	// only the addressee accepts
+	suite.ErrorIs(suite.postgresAdapter.AcceptFriendship(ctx, john.ID, jane.ID, dummyTime), model.ErrNotFound)
+	suite.Require().NoError(suite.postgresAdapter.AcceptFriendship(ctx, jane.ID, john.ID, dummyTime))
+	suite.ErrorIs(suite.postgresAdapter.AcceptFriendship(ctx, jane.ID, john.ID, dummyTime), model.ErrNotFound)
+
+	friendships, err := suite.postgresAdapter.ListFriendships(ctx, ports.ListFriendshipsQuery{UserID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Len(friendships, 2)
+	friendships, err = suite.postgresAdapter.ListFriendships(ctx, ports.ListFriendshipsQuery{UserID: jane.ID, Status: model.FriendshipStatusAccepted})
+	suite.Require().NoError(err)
+	suite.Require().Len(friendships, 1)
+	suite.Equal(john.ID, friendships[0].FriendID(jane.ID))
+	suite.Equal(dummyTime, friendships[0].AcceptedAt)
+	friendships, err = suite.postgresAdapter.ListFriendships(ctx, ports.ListFriendshipsQuery{UserID: jane.ID, Limit: 1, Offset: 1})
+	suite.Require().NoError(err)
+	suite.Len(friendships, 1)
+
+	// the deletion fails if the friendship is not in the given status
+	pending := model.Friendship{RequesterID: jim.ID, AddresseeID: jane.ID, Status: model.FriendshipStatusAccepted}
+	suite.ErrorIs(suite.postgresAdapter.DeleteFriendship(ctx, pending), model.ErrNotFound)
+	pending.Status = model.FriendshipStatusPending
+	suite.Require().NoError(suite.postgresAdapter.DeleteFriendship(ctx, pending))
+	_, err = suite.postgresAdapter.GetFriendship(ctx, jim.ID, jane.ID)
+	suite.ErrorIs(err, model.ErrNotFound)
+
+	// erasing a user deletes their friendships
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(ctx, john.ID))
+	_, err = suite.postgresAdapter.GetFriendship(ctx, jane.ID, john.ID)
+	suite.ErrorIs(err, model.ErrNotFound)
+}
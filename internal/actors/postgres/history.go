@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// ListUserVersions lists the versions of a user matching the parameters in input, oldest first.
+// The versions are recorded by a trigger on every change of the users table.
+func (p *PostgresDB) ListUserVersions(ctx context.Context, query ports.ListUserVersionsQuery) (*ports.ListUserVersionsResult, error) {
+	var versions []userVersionDB
+	q := p.db.ModelContext(ctx, &versions).
+		Where("user_id = ?", query.UserID).
+		Order("valid_from ASC", "valid_to ASC NULLS LAST")
+	if query.Limit != uint32(0) {
+		q = q.Limit(int(query.Limit))
+	}
+	if query.Offset != uint32(0) {
+		q = q.Offset(int(query.Offset))
+	}
+	if err := q.Select(); err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+
+	ret := make([]model.UserVersion, len(versions))
+	for i := range versions {
+		version, err := p.translateVersion(ctx, versions[i])
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = version
+	}
+	return &ports.ListUserVersionsResult{Versions: ret}, nil
+}
+
+// getUserAsOf returns the version of the user valid at the time in input.
+func (p *PostgresDB) getUserAsOf(ctx context.Context, query ports.GetUserQuery) (*model.User, error) {
+	version := new(userVersionDB)
+	q := p.db.ModelContext(ctx, version).
+		Where("user_id = ?", query.ID).
+		Where("valid_from <= ?", query.AsOf).
+		Where("(valid_to IS NULL OR valid_to > ?)", query.AsOf)
+	if !query.IncludeDeleted {
+		q = q.Where("deleted_at IS NULL")
+	}
+	if err := q.Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+
+	ret, err := p.translateVersion(ctx, *version)
+	if err != nil {
+		return nil, err
+	}
+	return &ret.User, nil
+}
+
+func (p *PostgresDB) translateVersion(ctx context.Context, version userVersionDB) (model.UserVersion, error) {
+	user := userDB{
+		ID:        version.UserID,
+		FirstName: version.FirstName,
+		LastName:  version.LastName,
+		Nickname:  version.Nickname,
+		Email:     version.Email,
+		Country:   version.Country,
+		CreatedAt: version.CreatedAt,
+		UpdatedAt: version.UpdatedAt,
+		DeletedAt: version.DeletedAt,
+		ErasedAt:  version.ErasedAt,
+	}
+	// the personal data is copied sealed by the trigger.
+	if err := p.open(ctx, &user); err != nil {
+		return model.UserVersion{}, err
+	}
+	return model.UserVersion{
+		User:      translateDBToModel(user),
+		ValidFrom: version.ValidFrom,
+		ValidTo:   version.ValidTo,
+	}, nil
+}
+
+type userVersionDB struct {
+	tableName struct{} `pg:"faceittha.users_history"`
+
+	// VersionID unique identifier of the version.
+	VersionID uuid.UUID `pg:"version_id,type:uuid"`
+
+	// UserID is the id of the versioned user.
+	UserID uuid.UUID `pg:"user_id,type:uuid"`
+
+	// FirstName is the user first name.
+	FirstName string `pg:"first_name"`
+
+	// LastName is the user last name.
+	LastName string `pg:"last_name"`
+
+	// Nickname is the user nickname
+	Nickname string `pg:"nickname"`
+
+	// Email is the user email
+	Email string `pg:"email"`
+
+	// Country is the user country
+	Country string `pg:"country"`
+
+	// CreatedAt is the time at which the user was created in the system.
+	CreatedAt time.Time `pg:"created_at"`
+
+	// UpdatedAt is the time at which the user was last updated
+	UpdatedAt time.Time `pg:"updated_at"`
+
+	// DeletedAt is the time at which the user was deleted. Zero-valued if user not deleted
+	DeletedAt time.Time `pg:"deleted_at"`
+
+	// ErasedAt is the time at which the user personal data was erased. Zero-valued if user not erased
+	ErasedAt time.Time `pg:"erased_at"`
+
+	// ValidFrom is the time from which the version was the current state of the user.
+	ValidFrom time.Time `pg:"valid_from"`
+
+	// ValidTo is the time at which the version was superseded. Zero-valued for the current version.
+	ValidTo time.Time `pg:"valid_to"`
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestUserHistory() {
+	now := dummyTime
+	adapter, err := NewPostgresDB(PostgresDBArgs{DB: suite.db}, WithNowFunc(func() time.Time { return now }))
+	suite.Require().NoError(err)
+
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	t0, t1, t2 := dummyTime, dummyTime.Add(time.Hour), dummyTime.Add(2*time.Hour)
+
+	suite.Require().NoError(adapter.SaveUser(context.Background(), &model.User{
+		ID:           userID,
+		FirstName:    "fn1",
+		Nickname:     "n1",
+		PasswordHash: "h1",
+		Country:      "uk",
+		CreatedAt:    t0,
+	}))
+	now = t1
+	suite.Require().NoError(adapter.UpdateUser(context.Background(), &model.User{ID: userID, FirstName: "fn2"}, ports.UpdateUserQuery{}))
+	// changes of the password only do not make a new version
+	suite.Require().NoError(adapter.UpdateUser(context.Background(), &model.User{ID: userID, PasswordHash: "h2"}, ports.UpdateUserQuery{}))
+	now = t2
+	suite.Require().NoError(adapter.DeleteUser(context.Background(), ports.DeleteUserQuery{ID: userID}))
+
+	first := model.User{ID: userID, FirstName: "fn1", Nickname: "n1", Country: "uk", CreatedAt: t0, UpdatedAt: t0}
+	second := model.User{ID: userID, FirstName: "fn2", Nickname: "n1", Country: "uk", CreatedAt: t0, UpdatedAt: t1}
+	deleted := model.User{ID: userID, FirstName: "fn2", Nickname: "n1", Country: "uk", CreatedAt: t0, UpdatedAt: t2, DeletedAt: t2}
+
+	got, err := adapter.ListUserVersions(context.Background(), ports.ListUserVersionsQuery{UserID: userID})
+	suite.Require().NoError(err)
+	suite.Equal([]model.UserVersion{
+		{User: first, ValidFrom: t0, ValidTo: t1},
+		{User: second, ValidFrom: t1, ValidTo: t2},
+		{User: deleted, ValidFrom: t2},
+	}, got.Versions)
+
+	got, err = adapter.ListUserVersions(context.Background(), ports.ListUserVersionsQuery{UserID: userID, Limit: 1, Offset: 1})
+	suite.Require().NoError(err)
+	suite.Equal([]model.UserVersion{{User: second, ValidFrom: t1, ValidTo: t2}}, got.Versions)
+
+	tests := []struct {
+		name        string
+		query       ports.GetUserQuery
+		expected    *model.User
+		expectedErr error
+	}{
+		{
+			name:        "before the creation",
+			query:       ports.GetUserQuery{ID: userID, AsOf: t0.Add(-time.Minute)},
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			name:     "first version",
+			query:    ports.GetUserQuery{ID: userID, AsOf: t0.Add(time.Minute)},
+			expected: &first,
+		},
+		{
+			name:     "version starting at the requested time",
+			query:    ports.GetUserQuery{ID: userID, AsOf: t1},
+			expected: &second,
+		},
+		{
+			name:        "deleted at the requested time",
+			query:       ports.GetUserQuery{ID: userID, AsOf: t2.Add(time.Minute)},
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			name:     "deleted at the requested time including deleted",
+			query:    ports.GetUserQuery{ID: userID, AsOf: t2.Add(time.Minute), IncludeDeleted: true},
+			expected: &deleted,
+		},
+	}
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			got, err := adapter.GetUser(context.Background(), test.query)
+			if test.expectedErr != nil {
+				suite.ErrorIs(err, test.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Equal(test.expected, got)
+		})
+	}
+}
+
+func (suite *PostgresDBTestSuite) TestUserHistoryIsPurgedOnErasure() {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(context.Background(), &model.User{
+		ID:        userID,
+		FirstName: "fn1",
+		Nickname:  "n1",
+		CreatedAt: dummyTime,
+	}))
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(context.Background(), &model.User{ID: userID, Nickname: "n2"}, ports.UpdateUserQuery{}))
+
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(context.Background(), userID))
+
+	got, err := suite.postgresAdapter.ListUserVersions(context.Background(), ports.ListUserVersionsQuery{UserID: userID})
+	suite.Require().NoError(err)
+	suite.Equal([]model.UserVersion{{
+		User:      model.User{ID: userID, CreatedAt: dummyTime, UpdatedAt: dummyTime, DeletedAt: dummyTime, ErasedAt: dummyTime},
+		ValidFrom: dummyTime,
+	}}, got.Versions)
+}
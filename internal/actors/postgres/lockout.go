@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// GetCredentialFailures returns the failures counted against the key. It returns model.ErrNotFound if there are none.
+func (p *PostgresDB) GetCredentialFailures(ctx context.Context, scope model.CredentialScope, key string) (*model.CredentialFailures, error) {
+	failures := new(credentialFailuresDB)
+	err := p.db.ModelContext(ctx, failures).
+		Where("scope = ?", scope).
+		Where("key = ?", p.credentialKey(scope, key)).
+		Select()
+	if err == pg.ErrNoRows {
+		return nil, model.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	ret := translateCredentialFailures(*failures)
+	return &ret, nil
+}
+
+// RecordCredentialFailure atomically counts a failure against the key and returns the updated counter. The counter
+// restarts if its last failure happened before query.ResetBefore.
+func (p *PostgresDB) RecordCredentialFailure(ctx context.Context, query ports.RecordCredentialFailureQuery) (*model.CredentialFailures, error) {
+	failures := &credentialFailuresDB{
+		Scope:         string(query.Scope),
+		Key:           p.credentialKey(query.Scope, query.Key),
+		Failures:      1,
+		LastFailureAt: p.nowFunc(),
+	}
+	_, err := p.db.ModelContext(ctx, failures).
+		OnConflict("(scope, key) DO UPDATE").
+		Set("failures = CASE WHEN credential_failures_db.last_failure_at < ? THEN 1 ELSE credential_failures_db.failures + 1 END", query.ResetBefore).
+		Set("last_failure_at = EXCLUDED.last_failure_at").
+		Returning("*").
+		Insert()
+	if err != nil {
+		return nil, err
+	}
+	ret := translateCredentialFailures(*failures)
+	return &ret, nil
+}
+
+// LockCredentials rejects the credential checks of the key until the given time. The locks of accounts are also set
+// on the users holding the email, if any, so that they are captured by CDC.
+func (p *PostgresDB) LockCredentials(ctx context.Context, scope model.CredentialScope, key string, until time.Time) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		_, err := tx.ModelContext(ctx, (*credentialFailuresDB)(nil)).
+			Set("locked_until = ?", until).
+			Where("scope = ?", scope).
+			Where("key = ?", p.credentialKey(scope, key)).
+			Update()
+		if err != nil || scope != model.CredentialScopeEmail {
+			return err
+		}
+		_, err = tx.ModelContext(ctx, (*userDB)(nil)).
+			Set("locked_until = ?", until).
+			WhereIn("email_index IN (?)", p.emailIndexes(key)).
+			Update()
+		return err
+	})
+}
+
+// ResetCredentialFailures forgets the failures counted against the key and lifts its lock.
+func (p *PostgresDB) ResetCredentialFailures(ctx context.Context, scope model.CredentialScope, key string) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		_, err := tx.ModelContext(ctx, (*credentialFailuresDB)(nil)).
+			Where("scope = ?", scope).
+			Where("key = ?", p.credentialKey(scope, key)).
+			Delete()
+		if err != nil || scope != model.CredentialScopeEmail {
+			return err
+		}
+		_, err = tx.ModelContext(ctx, (*userDB)(nil)).
+			Set("locked_until = NULL").
+			WhereIn("email_index IN (?)", p.emailIndexes(key)).
+			Where("locked_until IS NOT NULL").
+			Update()
+		return err
+	})
+}
+
+// credentialKey is the key the failures are stored with: the emails are stored as their index, like in the users table,
+// so that the failures of unknown accounts do not leak their email.
+func (p *PostgresDB) credentialKey(scope model.CredentialScope, key string) string {
+	if scope == model.CredentialScopeEmail {
+		return p.emailIndex(key)
+	}
+	return key
+}
+
+func translateCredentialFailures(failures credentialFailuresDB) model.CredentialFailures {
+	return model.CredentialFailures{
+		Scope:         model.CredentialScope(failures.Scope),
+		Key:           failures.Key,
+		Failures:      failures.Failures,
+		LastFailureAt: failures.LastFailureAt,
+		LockedUntil:   failures.LockedUntil,
+	}
+}
+
+type credentialFailuresDB struct {
+	tableName struct{} `pg:"faceittha.credential_failures"`
+
+	// Scope is what the failures are counted against.
+	Scope string `pg:"scope,pk"`
+
+	// Key identifies the account, by the index of its email, or the source IP within the scope.
+	Key string `pg:"key,pk"`
+
+	// Failures is the number of consecutive failures.
+	Failures int `pg:"failures,use_zero"`
+
+	// LastFailureAt is the time of the last failure.
+	LastFailureAt time.Time `pg:"last_failure_at"`
+
+	// LockedUntil is the time until which the credential checks are rejected.
+	LockedUntil time.Time `pg:"locked_until"`
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestCredentialFailures() {
+	ctx := context.Background()
+	user := &model.User{ID: uuid.New(), Nickname: "locked", Email: "locked@example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+	// the failures of an email are shared by its variants
+	key := "locked@Example.com"
+
+	_, err := suite.postgresAdapter.GetCredentialFailures(ctx, model.CredentialScopeEmail, key)
+	suite.ErrorIs(err, model.ErrNotFound)
+
+	for i := 1; i <= 3; i++ {
+		failures, err := suite.postgresAdapter.RecordCredentialFailure(ctx, ports.RecordCredentialFailureQuery{
+			Scope:       model.CredentialScopeEmail,
+			Key:         key,
+			ResetBefore: dummyTime.Add(-time.Hour),
+		})
+		suite.Require().NoError(err)
+		suite.Equal(i, failures.Failures)
+	}
+
+	// the counter restarts when the last failure is too old
+	failures, err := suite.postgresAdapter.RecordCredentialFailure(ctx, ports.RecordCredentialFailureQuery{
+		Scope:       model.CredentialScopeEmail,
+		Key:         key,
+		ResetBefore: dummyTime.Add(time.Second),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(1, failures.Failures)
+
+	// the counters of the scopes are independent
+	failures, err = suite.postgresAdapter.RecordCredentialFailure(ctx, ports.RecordCredentialFailureQuery{
+		Scope: model.CredentialScopeIP,
+		Key:   key,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(1, failures.Failures)
+
+	// the lock of an account is set on the user holding the email too
+	until := dummyTime.Add(time.Minute)
+	suite.Require().NoError(suite.postgresAdapter.LockCredentials(ctx, model.CredentialScopeEmail, key, until))
+	failures, err = suite.postgresAdapter.GetCredentialFailures(ctx, model.CredentialScopeEmail, key)
+	suite.Require().NoError(err)
+	suite.True(until.Equal(failures.LockedUntil))
+	got, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: user.ID})
+	suite.Require().NoError(err)
+	suite.True(until.Equal(got.LockedUntil))
+
+	suite.Require().NoError(suite.postgresAdapter.ResetCredentialFailures(ctx, model.CredentialScopeEmail, key))
+	_, err = suite.postgresAdapter.GetCredentialFailures(ctx, model.CredentialScopeEmail, key)
+	suite.ErrorIs(err, model.ErrNotFound)
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: user.ID})
+	suite.Require().NoError(err)
+	suite.True(got.LockedUntil.IsZero())
+	_, err = suite.postgresAdapter.GetCredentialFailures(ctx, model.CredentialScopeIP, key)
+	suite.NoError(err)
+}
@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// ListNicknameChanges lists the nickname changes of a user, most recent first.
+func (p *PostgresDB) ListNicknameChanges(ctx context.Context, query ports.ListNicknameChangesQuery) ([]model.NicknameChange, error) {
+	var changes []nicknameChangeDB
+	q := p.db.ModelContext(ctx, &changes).
+		Where("user_id = ?", query.UserID).
+		Order("changed_at DESC", "id ASC")
+	if query.Limit != uint32(0) {
+		q = q.Limit(int(query.Limit))
+	}
+	if query.Offset != uint32(0) {
+		q = q.Offset(int(query.Offset))
+	}
+	if err := q.Select(); err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+	ret := make([]model.NicknameChange, len(changes))
+	for i, change := range changes {
+		ret[i] = translateNicknameChange(change)
+	}
+	return ret, nil
+}
+
+// NicknameTaken reports whether a nickname with the given key is held by another user, was released by another user
+// since the given time or is reserved by another token.
+func (p *PostgresDB) NicknameTaken(ctx context.Context, query ports.NicknameTakenQuery) (bool, error) {
+	held, err := p.db.ModelContext(ctx, (*userDB)(nil)).
+		Where("nickname_key = ?", query.Key).
+		Where("id != ?", query.UserID).
+		Exists()
+	if err != nil || held {
+		return held, err
+	}
+	released, err := p.db.ModelContext(ctx, (*nicknameChangeDB)(nil)).
+		Where("old_nickname_key = ?", query.Key).
+		Where("changed_at >= ?", query.ReleasedSince).
+		Where("user_id != ?", query.UserID).
+		Exists()
+	if err != nil || released {
+		return released, err
+	}
+	return p.db.ModelContext(ctx, (*nicknameReservationDB)(nil)).
+		Where("nickname_key = ?", query.Key).
+		Where("expires_at > ?", p.nowFunc()).
+		Where("token_hash != ?", query.TokenHash).
+		Exists()
+}
+
+// ReserveNickname holds the nickname until the reservation expires, the expired reservations are taken over. It
+// returns model.ErrAlreadyExists if the nickname is reserved already.
+func (p *PostgresDB) ReserveNickname(ctx context.Context, reservation model.NicknameReservation) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		_, err := tx.ModelContext(ctx, (*nicknameReservationDB)(nil)).
+			Where("expires_at <= ?", p.nowFunc()).
+			Delete()
+		if err != nil {
+			return err
+		}
+		res, err := tx.ModelContext(ctx, &nicknameReservationDB{
+			TokenHash:   reservation.TokenHash,
+			NicknameKey: model.NicknameKey(reservation.Nickname),
+			Nickname:    reservation.Nickname,
+			ExpiresAt:   reservation.ExpiresAt,
+		}).OnConflict("DO NOTHING").Insert()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: nickname %q is reserved", model.ErrAlreadyExists, reservation.Nickname)
+		}
+		return nil
+	})
+}
+
+// SaveUserWithReservation saves the user and claims the reservation of their nickname with the token hash in the same
+// transaction. It returns model.ErrFailedPrecondition if the token holds no live reservation of the nickname.
+func (p *PostgresDB) SaveUserWithReservation(ctx context.Context, user *model.User, tokenHash string) error {
+	if user == nil {
+		return errors.New("nil user passed to save method")
+	}
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		res, err := tx.ModelContext(ctx, (*nicknameReservationDB)(nil)).
+			Where("token_hash = ?", tokenHash).
+			Where("nickname_key = ?", model.NicknameKey(user.Nickname)).
+			Where("expires_at > ?", p.nowFunc()).
+			Delete()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: no live reservation of nickname %q for the token", model.ErrFailedPrecondition, user.Nickname)
+		}
+		return p.insertUser(ctx, tx, user)
+	})
+}
+
+// nicknameKeyBatchSize is the number of rows keyed per query by BackfillNicknameKeys.
+const nicknameKeyBatchSize = 500
+
+// BackfillNicknameKeys computes the nickname keys cleared by the migrations changing model.NicknameKey, of the users
+// that are not erased and of the nickname changes. It must complete before the nicknames are looked up or checked and
+// returns the number of users keyed. A user whose key is held by an older user keeps their nickname under the key
+// suffixed by their id, as done by 0023_unique_nickname_keys, until they rename.
+func (p *PostgresDB) BackfillNicknameKeys(ctx context.Context) (int, error) {
+	keyed := 0
+	// the oldest users are keyed first, so that they keep the key of a duplicate nickname
+	var after userDB
+	for {
+		var users []userDB
+		q := p.db.ModelContext(ctx, &users).
+			Column("id", "nickname", "created_at").
+			Where("nickname_key = ''").
+			Where("nickname <> ''").
+			Where("erased_at IS NULL").
+			Order("created_at ASC", "id ASC").
+			Limit(nicknameKeyBatchSize)
+		if after.ID != uuid.Nil {
+			q = q.Where("(created_at, id) > (?, ?)", after.CreatedAt, after.ID)
+		}
+		if err := q.Select(); err != nil {
+			return keyed, err
+		}
+		for _, user := range users {
+			after = user
+			if err := p.setNicknameKey(ctx, user); err != nil {
+				return keyed, fmt.Errorf("error keying nickname of user %s: %w", user.ID, err)
+			}
+			keyed++
+		}
+		if len(users) < nicknameKeyBatchSize {
+			break
+		}
+	}
+
+	afterChange := uuid.Nil
+	for {
+		var changes []nicknameChangeDB
+		err := p.db.ModelContext(ctx, &changes).
+			Column("id", "old_nickname").
+			Where("old_nickname_key = ''").
+			Where("old_nickname <> ''").
+			Where("id > ?", afterChange).
+			Order("id ASC").
+			Limit(nicknameKeyBatchSize).
+			Select()
+		if err != nil {
+			return keyed, err
+		}
+		for _, change := range changes {
+			afterChange = change.ID
+			_, err := p.db.ModelContext(ctx, (*nicknameChangeDB)(nil)).
+				Set("old_nickname_key = ?", model.NicknameKey(change.OldNickname)).
+				Where("id = ?", change.ID).
+				Update()
+			if err != nil {
+				return keyed, err
+			}
+		}
+		if len(changes) < nicknameKeyBatchSize {
+			return keyed, nil
+		}
+	}
+}
+
+// setNicknameKey stores the key of the nickname of the user, suffixed by their id if it is held by another user or if
+// the nickname has no key, e.g. a legacy nickname made of separators only.
+func (p *PostgresDB) setNicknameKey(ctx context.Context, user userDB) error {
+	key := model.NicknameKey(user.Nickname)
+	if key != "" {
+		_, err := p.db.ModelContext(ctx, (*userDB)(nil)).
+			Set("nickname_key = ?", key).
+			Where("id = ?", user.ID).
+			Update()
+		if !uniqueViolation(err, "users_nickname_key_key") {
+			return err
+		}
+	}
+	_, err := p.db.ModelContext(ctx, (*userDB)(nil)).
+		Set("nickname_key = ?", key+"#"+user.ID.String()).
+		Where("id = ?", user.ID).
+		Update()
+	return err
+}
+
+// checkNicknameCooldown returns an error wrapping model.ErrFailedPrecondition if the last nickname change of the user
+// is more recent than the cooldown. It runs in the transaction holding the lock of the user.
+func (p *PostgresDB) checkNicknameCooldown(tx *pg.Tx, userID uuid.UUID, cooldown time.Duration) error {
+	last := new(nicknameChangeDB)
+	err := tx.Model(last).
+		Where("user_id = ?", userID).
+		Order("changed_at DESC").
+		Limit(1).
+		Select()
+	if err == pg.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if next := last.ChangedAt.Add(cooldown); next.After(p.nowFunc()) {
+		return fmt.Errorf("%w: nickname cannot be changed before %s", model.ErrFailedPrecondition, next.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func translateNicknameChange(change nicknameChangeDB) model.NicknameChange {
+	return model.NicknameChange{
+		ID:          change.ID,
+		UserID:      change.UserID,
+		OldNickname: change.OldNickname,
+		NewNickname: change.NewNickname,
+		ChangedAt:   change.ChangedAt,
+	}
+}
+
+type nicknameChangeDB struct {
+	tableName struct{} `pg:"faceittha.nickname_changes"`
+
+	// ID is the id of the change.
+	ID uuid.UUID `pg:"id,pk,type:uuid"`
+
+	// UserID is the id of the user who changed their nickname.
+	UserID uuid.UUID `pg:"user_id,type:uuid"`
+
+	// OldNickname is the nickname released by the change.
+	OldNickname string `pg:"old_nickname,use_zero"`
+
+	// OldNicknameKey is the key of the nickname released by the change, see model.NicknameKey.
+	OldNicknameKey string `pg:"old_nickname_key,use_zero"`
+
+	// NewNickname is the nickname taken by the change.
+	NewNickname string `pg:"new_nickname,use_zero"`
+
+	// ChangedAt is the time of the change.
+	ChangedAt time.Time `pg:"changed_at"`
+}
+
+type nicknameReservationDB struct {
+	tableName struct{} `pg:"faceittha.nickname_reservations"`
+
+	// TokenHash is the hash of the token the reservation is claimed with.
+	TokenHash string `pg:"token_hash,pk"`
+
+	// NicknameKey is the key of the reserved nickname, see model.NicknameKey.
+	NicknameKey string `pg:"nickname_key,use_zero"`
+
+	// Nickname is the reserved nickname.
+	Nickname string `pg:"nickname,use_zero"`
+
+	// ExpiresAt is the time the reservation expires.
+	ExpiresAt time.Time `pg:"expires_at"`
+}
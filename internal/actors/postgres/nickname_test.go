@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestNicknameChanges() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	for _, user := range []*model.User{jane, john} {
+		suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+	}
+
+	// only the changes of the nickname are recorded
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "ace"}, ports.UpdateUserQuery{}))
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "ace", Country: "PT"}, ports.UpdateUserQuery{}))
+	changes, err := suite.postgresAdapter.ListNicknameChanges(ctx, ports.ListNicknameChangesQuery{UserID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Require().Len(changes, 1)
+	suite.Equal(jane.ID, changes[0].UserID)
+	suite.Equal("jd", changes[0].OldNickname)
+	suite.Equal("ace", changes[0].NewNickname)
+	suite.Equal(dummyTime, changes[0].ChangedAt)
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "jd"}, ports.UpdateUserQuery{}))
+	changes, err = suite.postgresAdapter.ListNicknameChanges(ctx, ports.ListNicknameChangesQuery{UserID: jane.ID, Limit: 1, Offset: 1})
+	suite.Require().NoError(err)
+	suite.Len(changes, 1)
+	changes, err = suite.postgresAdapter.ListNicknameChanges(ctx, ports.ListNicknameChangesQuery{UserID: john.ID})
+	suite.Require().NoError(err)
+	suite.Empty(changes)
+
+	// the nicknames are looked up by their key, the released ones are taken except for their previous holder
+	taken, err := suite.postgresAdapter.NicknameTaken(ctx, ports.NicknameTakenQuery{Key: model.NicknameKey("ACE"), UserID: john.ID, ReleasedSince: dummyTime.Add(-time.Hour)})
+	suite.Require().NoError(err)
+	suite.True(taken)
+	taken, err = suite.postgresAdapter.NicknameTaken(ctx, ports.NicknameTakenQuery{Key: model.NicknameKey("ace"), UserID: jane.ID, ReleasedSince: dummyTime.Add(-time.Hour)})
+	suite.Require().NoError(err)
+	suite.False(taken)
+	taken, err = suite.postgresAdapter.NicknameTaken(ctx, ports.NicknameTakenQuery{Key: model.NicknameKey("ace"), UserID: john.ID, ReleasedSince: dummyTime.Add(time.Hour)})
+	suite.Require().NoError(err)
+	suite.False(taken)
+	taken, err = suite.postgresAdapter.NicknameTaken(ctx, ports.NicknameTakenQuery{Key: model.NicknameKey("J_D"), UserID: john.ID, ReleasedSince: dummyTime.Add(time.Hour)})
+	suite.Require().NoError(err)
+	suite.True(taken)
+	taken, err = suite.postgresAdapter.NicknameTaken(ctx, ports.NicknameTakenQuery{Key: model.NicknameKey("ЈD"), UserID: john.ID, ReleasedSince: dummyTime.Add(time.Hour)})
+	suite.Require().NoError(err)
+	suite.True(taken)
+
+	// the erasure releases the nicknames of the user
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(ctx, jane.ID))
+	changes, err = suite.postgresAdapter.ListNicknameChanges(ctx, ports.ListNicknameChangesQuery{UserID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Empty(changes)
+	taken, err = suite.postgresAdapter.NicknameTaken(ctx, ports.NicknameTakenQuery{Key: model.NicknameKey("jd"), UserID: john.ID, ReleasedSince: dummyTime.Add(-time.Hour)})
+	suite.Require().NoError(err)
+	suite.False(taken)
+}
+
+func (suite *PostgresDBTestSuite) TestNicknameChangeCooldown() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, jane))
+	cooldown := ports.UpdateUserQuery{NicknameChangeCooldown: time.Hour}
+
+	// the first change is free, the next ones wait for the cooldown, the other fields change meanwhile
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "ace"}, cooldown))
+	err := suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "jd"}, cooldown)
+	suite.Require().ErrorIs(err, model.ErrFailedPrecondition)
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "ace", Country: "PT"}, cooldown))
+	user, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal("ace", user.Nickname)
+
+	later, err := NewPostgresDB(PostgresDBArgs{DB: suite.db}, WithNowFunc(func() time.Time { return dummyTime.Add(2 * time.Hour) }))
+	suite.Require().NoError(err)
+	suite.Require().NoError(later.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "jd"}, cooldown))
+}
+
+func (suite *PostgresDBTestSuite) TestNicknameReservations() {
+	ctx := context.Background()
+	expiresAt := dummyTime.Add(10 * time.Minute)
+
+	// a nickname has a single live reservation
+	suite.Require().NoError(suite.postgresAdapter.ReserveNickname(ctx, model.NicknameReservation{Nickname: "Ace", TokenHash: "h1", ExpiresAt: expiresAt}))
+	err := suite.postgresAdapter.ReserveNickname(ctx, model.NicknameReservation{Nickname: "4ce", TokenHash: "h2", ExpiresAt: expiresAt})
+	suite.Require().ErrorIs(err, model.ErrAlreadyExists)
+	taken, err := suite.postgresAdapter.NicknameTaken(ctx, ports.NicknameTakenQuery{Key: model.NicknameKey("ace")})
+	suite.Require().NoError(err)
+	suite.True(taken)
+	taken, err = suite.postgresAdapter.NicknameTaken(ctx, ports.NicknameTakenQuery{Key: model.NicknameKey("ace"), TokenHash: "h1"})
+	suite.Require().NoError(err)
+	suite.False(taken)
+
+	// the reservation is claimed once, by its token
+	jane := &model.User{ID: uuid.New(), Nickname: "ace", Email: "jane@example.com", PasswordHash: "hash"}
+	err = suite.postgresAdapter.SaveUserWithReservation(ctx, jane, "h2")
+	suite.Require().ErrorIs(err, model.ErrFailedPrecondition)
+	suite.Require().NoError(suite.postgresAdapter.SaveUserWithReservation(ctx, jane, "h1"))
+	saved, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal("ace", saved.Nickname)
+	john := &model.User{ID: uuid.New(), Nickname: "ace", Email: "john@example.com", PasswordHash: "hash"}
+	err = suite.postgresAdapter.SaveUserWithReservation(ctx, john, "h1")
+	suite.Require().ErrorIs(err, model.ErrFailedPrecondition)
+
+	// the expired reservations are taken over and cannot be claimed
+	suite.Require().NoError(suite.postgresAdapter.ReserveNickname(ctx, model.NicknameReservation{Nickname: "bob", TokenHash: "h3", ExpiresAt: dummyTime.Add(-time.Minute)}))
+	err = suite.postgresAdapter.SaveUserWithReservation(ctx, &model.User{ID: uuid.New(), Nickname: "bob", Email: "bob@example.com", PasswordHash: "hash"}, "h3")
+	suite.Require().ErrorIs(err, model.ErrFailedPrecondition)
+	suite.Require().NoError(suite.postgresAdapter.ReserveNickname(ctx, model.NicknameReservation{Nickname: "b0b", TokenHash: "h4", ExpiresAt: expiresAt}))
+}
+
+func (suite *PostgresDBTestSuite) TestUniqueNicknameKeys() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "ace", Email: "jane@example.com", PasswordHash: "hash"}
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	for _, user := range []*model.User{jane, john} {
+		suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+	}
+
+	// the look-alike nicknames are rejected as taken by the database, whatever the checks of the service
+	var nicknameErr *model.NicknameError
+	err := suite.postgresAdapter.SaveUser(ctx, &model.User{ID: uuid.New(), Nickname: "4CE", Email: "jim@example.com", PasswordHash: "hash"})
+	suite.Require().ErrorAs(err, &nicknameErr)
+	suite.Equal(model.NicknameRejectionTaken, nicknameErr.Rejection)
+	suite.ErrorIs(err, model.ErrAlreadyExists)
+	err = suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: john.ID, Nickname: "Ace"}, ports.UpdateUserQuery{})
+	suite.Require().ErrorAs(err, &nicknameErr)
+	suite.Equal("Ace", nicknameErr.Nickname)
+	user, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: john.ID})
+	suite.Require().NoError(err)
+	suite.Equal("jo", user.Nickname)
+
+	// the erasure releases the key
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(ctx, jane.ID))
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: john.ID, Nickname: "Ace"}, ports.UpdateUserQuery{}))
+}
+
+func (suite *PostgresDBTestSuite) TestBackfillNicknameKeys() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "ace", Email: "jane@example.com", PasswordHash: "hash", CreatedAt: dummyTime.Add(-time.Hour)}
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	for _, user := range []*model.User{jane, john} {
+		suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+	}
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "jane"}, ports.UpdateUserQuery{}))
+	// the keys are cleared by the migrations changing them, john took a look-alike of the nickname of jane before
+	_, err := suite.db.Exec("UPDATE faceittha.users SET nickname = 'јаnе' WHERE id = ?", john.ID)
+	suite.Require().NoError(err)
+	_, err = suite.db.Exec("UPDATE faceittha.users SET nickname_key = ''")
+	suite.Require().NoError(err)
+	_, err = suite.db.Exec("UPDATE faceittha.nickname_changes SET old_nickname_key = ''")
+	suite.Require().NoError(err)
+
+	keyed, err := suite.postgresAdapter.BackfillNicknameKeys(ctx)
+	suite.Require().NoError(err)
+	suite.Equal(2, keyed)
+	// the oldest user keeps the key, the other one keeps their nickname under a key of their own
+	user, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Nickname: "JANE"})
+	suite.Require().NoError(err)
+	suite.Equal(jane.ID, user.ID)
+	got := new(userDB)
+	suite.Require().NoError(suite.db.Model(got).Where("id = ?", john.ID).Select())
+	suite.Equal("jane#"+john.ID.String(), got.NicknameKey)
+	suite.Equal("јаnе", got.Nickname)
+	taken, err := suite.postgresAdapter.NicknameTaken(ctx, ports.NicknameTakenQuery{Key: model.NicknameKey("4CE"), UserID: john.ID, ReleasedSince: dummyTime.Add(-time.Hour)})
+	suite.Require().NoError(err)
+	suite.True(taken)
+
+	// the backfill is idempotent
+	keyed, err = suite.postgresAdapter.BackfillNicknameKeys(ctx)
+	suite.Require().NoError(err)
+	suite.Zero(keyed)
+}
+
+func (suite *PostgresDBTestSuite) TestGetUserByNickname() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "Jane_Doe", Email: "jane@example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, jane))
+
+	// the users are looked up by the key of their nickname
+	for _, nickname := range []string{"Jane_Doe", "janedoe", "JANE.D0E"} {
+		user, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Nickname: nickname})
+		suite.Require().NoError(err, nickname)
+		suite.Equal(jane.ID, user.ID, nickname)
+	}
+	_, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Nickname: "john"})
+	suite.ErrorIs(err, model.ErrNotFound)
+
+	// the profile visibility defaults to public and is left untouched by the updates not setting it
+	user, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(model.ProfileVisibilityPublic, user.ProfileVisibility.OrDefault())
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, ProfileVisibility: model.ProfileVisibilityFriends}, ports.UpdateUserQuery{}))
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Country: "PT"}, ports.UpdateUserQuery{}))
+	user, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(model.ProfileVisibilityFriends, user.ProfileVisibility)
+
+	// deleted users are not found
+	suite.Require().NoError(suite.postgresAdapter.DeleteUser(ctx, ports.DeleteUserQuery{ID: jane.ID}))
+	_, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Nickname: "janedoe"})
+	suite.ErrorIs(err, model.ErrNotFound)
+}
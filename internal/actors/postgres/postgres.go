@@ -3,9 +3,12 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
 	"github.com/google/uuid"
 	"github.com/rbroggi/faceittha/internal/core/model"
 	"github.com/rbroggi/faceittha/internal/core/ports"
@@ -15,6 +18,7 @@ import (
 type PostgresDB struct {
 	db      *pg.DB
 	nowFunc func() time.Time
+	vault   ports.Vault
 }
 
 // PostgresDBArgs are the mandatory arguments for the creation of a PostgresDB
@@ -33,6 +37,13 @@ func WithNowFunc(nowFunc func() time.Time) PostgresDBOptArgs {
 	}
 }
 
+// WithVault makes the adapter seal the personal data of the users with the vault before storing it.
+func WithVault(vault ports.Vault) PostgresDBOptArgs {
+	return func(p *PostgresDB) {
+		p.vault = vault
+	}
+}
+
 // NewPostgresDB creates a new PostgresDB.
 func NewPostgresDB(args PostgresDBArgs, optArgs ...PostgresDBOptArgs) (*PostgresDB, error) {
 	pg := &PostgresDB{db: args.DB, nowFunc: func() time.Time { return time.Now().UTC() }}
@@ -48,20 +59,29 @@ func (p *PostgresDB) SaveUser(ctx context.Context, user *model.User) error {
 	if user == nil {
 		return errors.New("nil user passed to save method")
 	}
+	return p.insertUser(ctx, p.db, user)
+}
 
+// insertUser inserts the user with the given connection or transaction.
+func (p *PostgresDB) insertUser(ctx context.Context, db orm.DB, user *model.User) error {
 	existingUser := p.toDBModel(user)
-	if _, err := p.db.Model(existingUser).Insert(); err != nil {
+	if err := p.seal(ctx, existingUser); err != nil {
 		return err
 	}
+	if _, err := db.ModelContext(ctx, existingUser).Insert(); err != nil {
+		return takenError(err, user.Nickname)
+	}
 
 	user.ID = existingUser.ID
 	user.CreatedAt = existingUser.CreatedAt
 	user.UpdatedAt = existingUser.UpdatedAt
+	user.Status = model.UserStatus(existingUser.Status)
 	return nil
 }
 
-// UpdateUser will update user. It returns model.ErrNotFound if the input user does not exist.
-func (p *PostgresDB) UpdateUser(ctx context.Context, user *model.User) error {
+// UpdateUser will update user. It returns model.ErrNotFound if the input user does not exist and an error wrapping
+// model.ErrFailedPrecondition if the nickname changes before the cooldown of the query elapsed.
+func (p *PostgresDB) UpdateUser(ctx context.Context, user *model.User, query ports.UpdateUserQuery) error {
 	if user == nil {
 		return errors.New("nil user passed to update method")
 	}
@@ -75,49 +95,140 @@ func (p *PostgresDB) UpdateUser(ctx context.Context, user *model.User) error {
 	}
 	defer tx.Rollback()
 
+	// the lock of the user serializes their concurrent updates for the cooldown of the nickname changes
 	existingUser := new(userDB)
-	err = tx.Model(existingUser).Where("id = ?", user.ID).Select()
+	err = tx.Model(existingUser).Where("id = ?", user.ID).For("UPDATE").Select()
 	if err != nil && err != pg.ErrNoRows {
 		return err
 	} else if err == pg.ErrNoRows {
 		return model.ErrNotFound
 	}
 
+	if err := p.open(ctx, existingUser); err != nil {
+		return err
+	}
+	oldNickname, oldNicknameKey := existingUser.Nickname, existingUser.NicknameKey
+	oldEmail, oldEmailIndex := existingUser.Email, existingUser.EmailIndex
 	p.updateExisting(existingUser, user)
-	if _, err := tx.Model(existingUser).WherePK().Update(); err != nil {
+	updatedUser := *existingUser
+	if updatedUser.Nickname != oldNickname && query.NicknameChangeCooldown > 0 {
+		if err := p.checkNicknameCooldown(tx, existingUser.ID, query.NicknameChangeCooldown); err != nil {
+			return err
+		}
+	}
+	if err := p.seal(ctx, existingUser); err != nil {
 		return err
 	}
+	// the keys of a kept nickname and email are kept too, e.g. the duplicate nickname keys suffixed by their id and the
+	// legacy email indexes not yet re-indexed by ReindexEmails
+	if updatedUser.Nickname == oldNickname {
+		existingUser.NicknameKey = oldNicknameKey
+	}
+	if updatedUser.Email == oldEmail {
+		existingUser.EmailIndex = oldEmailIndex
+	}
+	// the roles, the suspension and the status are only changed through their own transitions
+	if _, err := tx.Model(existingUser).WherePK().
+		ExcludeColumn("roles", "suspension_reason", "suspended_at", "suspended_until", "status", "verified_at",
+			"deletion_scheduled_for").
+		Update(); err != nil {
+		return takenError(err, existingUser.Nickname)
+	}
+	if existingUser.Nickname != oldNickname {
+		change := &nicknameChangeDB{
+			ID:             uuid.New(),
+			UserID:         existingUser.ID,
+			OldNickname:    oldNickname,
+			OldNicknameKey: model.NicknameKey(oldNickname),
+			NewNickname:    existingUser.Nickname,
+			ChangedAt:      existingUser.UpdatedAt,
+		}
+		if _, err := tx.Model(change).Insert(); err != nil {
+			return err
+		}
+	}
 
 	if err = tx.Commit(); err != nil {
 		return err
 	}
 
-	user.FirstName = existingUser.FirstName
-	user.LastName = existingUser.LastName
-	user.Nickname = existingUser.Nickname
-	user.Email = existingUser.Email
-	user.PasswordHash = existingUser.PasswordHash
-	user.Country = existingUser.Country
-	user.CreatedAt = existingUser.CreatedAt
-	user.UpdatedAt = existingUser.UpdatedAt
+	user.FirstName = updatedUser.FirstName
+	user.LastName = updatedUser.LastName
+	user.Nickname = updatedUser.Nickname
+	user.Email = updatedUser.Email
+	user.PasswordHash = updatedUser.PasswordHash
+	user.Country = updatedUser.Country
+	user.CreatedAt = updatedUser.CreatedAt
+	user.UpdatedAt = updatedUser.UpdatedAt
+	user.Roles = translateRoles(updatedUser.Roles)
+	user.Attributes = translateAttributes(updatedUser.Attributes)
+	user.SuspensionReason = model.SuspensionReason(updatedUser.SuspensionReason)
+	user.SuspendedAt = updatedUser.SuspendedAt
+	user.SuspendedUntil = updatedUser.SuspendedUntil
+	user.Status = model.UserStatus(updatedUser.Status)
+	user.VerifiedAt = updatedUser.VerifiedAt
+	user.DeletionScheduledFor = updatedUser.DeletionScheduledFor
+	user.ProfileVisibility = model.ProfileVisibility(updatedUser.ProfileVisibility)
 	return nil
 
 }
 
+// GetUser returns the user matching the parameters in input. It returns model.ErrNotFound if no user matches.
+// When query.AsOf is set the user is read from the history.
+func (p *PostgresDB) GetUser(ctx context.Context, query ports.GetUserQuery) (*model.User, error) {
+	if !query.AsOf.IsZero() {
+		return p.getUserAsOf(ctx, query)
+	}
+	user := new(userDB)
+	q := p.db.ModelContext(ctx, user)
+	switch {
+	case query.Email != "":
+		q = q.WhereIn("email_index IN (?)", p.emailIndexes(query.Email)).Order("created_at ASC").Limit(1)
+	case query.Nickname != "":
+		q = q.Where("nickname_key = ?", model.NicknameKey(query.Nickname)).Order("created_at ASC").Limit(1)
+	default:
+		q = q.Where("id = ?", query.ID)
+	}
+	if !query.IncludeDeleted {
+		q = q.Where("deleted_at IS NULL")
+	}
+	if err := q.Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+	if err := p.open(ctx, user); err != nil {
+		return nil, err
+	}
+
+	ret := translateDBToModel(*user)
+	return &ret, nil
+}
+
 // ListUsers list users matching the parameters in input
 func (p *PostgresDB) ListUsers(ctx context.Context, query ports.ListUsersQuery) (*ports.ListUsersResult, error) {
 	var users []userDB
-	q := p.db.Model(&users).Order("created_at ASC").Where("deleted_at IS NULL")
+	q := p.db.Model(&users).Order("created_at ASC")
+	if !query.IncludeDeleted {
+		q = q.Where("deleted_at IS NULL")
+	}
 
 	if len(query.Countries) > 0 {
 		q = q.WhereIn("country IN (?)", query.Countries)
 	}
+	if len(query.Statuses) > 0 {
+		q = q.WhereIn("status IN (?)", query.Statuses)
+	}
 	if !query.CreatedAfter.IsZero() {
 		q = q.Where("created_at > ?", query.CreatedAfter)
 	}
 	if !query.CreatedBefore.IsZero() {
 		q = q.Where("created_at < ?", query.CreatedBefore)
 	}
+	for key, value := range query.Attributes {
+		q = q.Where("attributes ->> ? = ?", key, value)
+	}
 	if query.Limit != uint32(0) {
 		q = q.Limit(int(query.Limit))
 	}
@@ -127,6 +238,11 @@ func (p *PostgresDB) ListUsers(ctx context.Context, query ports.ListUsersQuery)
 	if err := q.Select(); err != nil && err != pg.ErrNoRows {
 		return nil, err
 	}
+	for i := range users {
+		if err := p.open(ctx, &users[i]); err != nil {
+			return nil, err
+		}
+	}
 
 	model := translateDBToModels(users)
 	return &ports.ListUsersResult{
@@ -134,7 +250,8 @@ func (p *PostgresDB) ListUsers(ctx context.Context, query ports.ListUsersQuery)
 	}, nil
 }
 
-// DeleteUser will delete a user from the database.
+// DeleteUser will delete a user from the database. Soft-deletions return model.ErrNotFound if the user does not exist
+// and an error wrapping model.ErrFailedPrecondition if the user is deleted already.
 func (p *PostgresDB) DeleteUser(ctx context.Context, query ports.DeleteUserQuery) error {
 	userDB := &userDB{ID: query.ID}
 	if query.HardDelete {
@@ -143,10 +260,187 @@ func (p *PostgresDB) DeleteUser(ctx context.Context, query ports.DeleteUserQuery
 		}
 		return nil
 	}
-	if _, err := p.db.Model(userDB).WherePK().Set("deleted_at = ?", p.nowFunc()).Update(); err != nil {
+	now := p.nowFunc()
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		user, err := lockStatus(ctx, tx, query.ID)
+		if err != nil {
+			return err
+		}
+		if err := model.UserStatus(user.Status).CheckTransition(model.UserStatusDeleted); err != nil {
+			return err
+		}
+		_, err = tx.ModelContext(ctx, userDB).WherePK().
+			Set("deleted_at = ?", now).
+			Set("status = ?", model.UserStatusDeleted).
+			Set("deletion_scheduled_for = NULL").
+			Set("updated_at = ?", now).
+			Update()
+		return err
+	})
+}
+
+// RestoreUser reverts the soft-deletion of a user, who settles back in their status. It returns model.ErrNotFound if
+// the user does not exist or is erased, erased users cannot be restored, and an error wrapping
+// model.ErrFailedPrecondition if the user is not deleted.
+func (p *PostgresDB) RestoreUser(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	user := &userDB{ID: id}
+	err := p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		locked, err := lockStatus(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if !locked.ErasedAt.IsZero() {
+			return model.ErrNotFound
+		}
+		to := translateDBToModel(*locked).SettledStatus()
+		if err := model.UserStatus(locked.Status).CheckTransition(to); err != nil {
+			return err
+		}
+		_, err = tx.ModelContext(ctx, user).
+			Set("deleted_at = NULL").
+			Set("status = ?", to).
+			Set("updated_at = ?", p.nowFunc()).
+			Where("id = ?", id).
+			Returning("*").
+			Update()
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	if err := p.open(ctx, user); err != nil {
+		return nil, err
+	}
+
+	ret := translateDBToModel(*user)
+	return &ret, nil
+}
+
+// EraseUser replaces the user with an anonymized and deleted tombstone, deletes their friendships, blocks and
+// nickname history and clears the notes of their suspensions. It returns model.ErrNotFound if the user does not exist.
+func (p *PostgresDB) EraseUser(ctx context.Context, id uuid.UUID) error {
+	now := p.nowFunc()
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		// the erasure is a legal obligation, it deletes the user whatever their status
+		res, err := tx.ModelContext(ctx, (*userDB)(nil)).
+			Set("first_name = ''").
+			Set("last_name = ''").
+			Set("nickname = ''").
+			Set("nickname_key = ''").
+			Set("email = ''").
+			Set("email_index = NULL").
+			Set("password_hash = ''").
+			Set("country = ''").
+			Set("totp_enabled_at = NULL").
+			Set("attributes = '{}'").
+			Set("deleted_at = COALESCE(deleted_at, ?)", now).
+			Set("erased_at = COALESCE(erased_at, ?)", now).
+			Set("status = ?", model.UserStatusDeleted).
+			Set("deletion_scheduled_for = NULL").
+			Set("updated_at = ?", now).
+			Where("id = ?", id).
+			Update()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return model.ErrNotFound
+		}
+		// the relationships of the user are personal data too
+		_, err = tx.ModelContext(ctx, (*friendshipDB)(nil)).
+			Where("requester_id = ? OR addressee_id = ?", id, id).
+			Delete()
+		if err != nil {
+			return err
+		}
+		_, err = tx.ModelContext(ctx, (*userBlockDB)(nil)).
+			Where("blocker_id = ? OR blocked_id = ?", id, id).
+			Delete()
+		if err != nil {
+			return err
+		}
+		// the nicknames held by the user are released
+		_, err = tx.ModelContext(ctx, (*nicknameChangeDB)(nil)).
+			Where("user_id = ?", id).
+			Delete()
+		if err != nil {
+			return err
+		}
+		// the suspensions are kept for the anti-cheat, without the notes that may hold personal data
+		_, err = tx.ModelContext(ctx, (*userSuspensionDB)(nil)).
+			Set("note = ''").
+			Where("user_id = ?", id).
+			Update()
+		return err
+	})
+}
+
+// seal encrypts the personal data of the user, if a vault is configured, after indexing the email and the nickname.
+func (p *PostgresDB) seal(ctx context.Context, user *userDB) error {
+	if user.Email != "" {
+		user.EmailIndex = p.emailIndex(user.Email)
+	}
+	user.NicknameKey = model.NicknameKey(user.Nickname)
+	if p.vault == nil {
+		return nil
+	}
+	return p.vault.Seal(ctx, user.ID, &user.FirstName, &user.LastName, &user.Email)
+}
+
+// open decrypts the personal data of the user, if a vault is configured.
+// The data of erased users is unrecoverable and reads as empty.
+func (p *PostgresDB) open(ctx context.Context, user *userDB) error {
+	if p.vault == nil {
+		return nil
+	}
+	err := p.vault.Open(ctx, user.ID, &user.FirstName, &user.LastName, &user.Email)
+	if errors.Is(err, model.ErrDataKeyDestroyed) {
+		user.FirstName, user.LastName, user.Email = "", "", ""
+		return nil
+	}
+	return err
+}
+
+// takenError translates the violations of the uniqueness of the users, i.e. another user took the same nickname or
+// email concurrently: a model.NicknameError rejecting the nickname as taken for the nickname keys and an error wrapping
+// model.ErrAlreadyExists for the email indexes. Other errors are returned as is.
+func takenError(err error, nickname string) error {
+	switch {
+	case uniqueViolation(err, "users_nickname_key_key"):
+		return &model.NicknameError{Nickname: nickname, Rejection: model.NicknameRejectionTaken}
+	case uniqueViolation(err, "users_email_index_key"):
+		return fmt.Errorf("%w: email is taken", model.ErrAlreadyExists)
+	}
+	return err
+}
+
+// uniqueViolation reports whether err is the violation of the unique index or constraint with the given name.
+func uniqueViolation(err error, name string) bool {
+	var pgErr pg.Error
+	return errors.As(err, &pgErr) && pgErr.Field('C') == "23505" && pgErr.Field('n') == name
+}
+
+// emailIndex is the value users are looked up by email with: the canonical email, see model.CanonicalEmail, blinded if
+// a vault is configured.
+func (p *PostgresDB) emailIndex(email string) string {
+	return p.blindEmail(model.CanonicalEmail(email))
+}
+
+// emailIndexes are the values users are looked up by email with: the index of the email and its lowercase form, the
+// index of the users not yet re-indexed by ReindexEmails since the canonical emails were introduced.
+func (p *PostgresDB) emailIndexes(email string) []string {
+	index, legacy := p.emailIndex(email), p.blindEmail(strings.ToLower(strings.TrimSpace(email)))
+	if index == legacy {
+		return []string{index}
+	}
+	return []string{index, legacy}
+}
+
+func (p *PostgresDB) blindEmail(email string) string {
+	if p.vault == nil {
+		return email
+	}
+	return p.vault.BlindIndex(email)
 }
 
 func (p *PostgresDB) toDBModel(user *model.User) *userDB {
@@ -181,6 +475,17 @@ func (p *PostgresDB) toDBModel(user *model.User) *userDB {
 	if !user.DeletedAt.IsZero() {
 		dbUser.DeletedAt = user.CreatedAt
 	}
+	switch {
+	case user.Status != "":
+		dbUser.Status = string(user.Status)
+	case !user.DeletedAt.IsZero():
+		dbUser.Status = string(model.UserStatusDeleted)
+	default:
+		dbUser.Status = string(model.UserStatusPendingVerification)
+	}
+	dbUser.VerifiedAt = user.VerifiedAt
+	dbUser.DeletionScheduledFor = user.DeletionScheduledFor
+	dbUser.ProfileVisibility = string(user.ProfileVisibility)
 	dbUser.UpdatedAt = p.nowFunc()
 	return dbUser
 }
@@ -207,6 +512,19 @@ func (p *PostgresDB) updateExisting(existingDBUser *userDB, user *model.User) {
 	if !user.DeletedAt.IsZero() {
 		existingDBUser.DeletedAt = user.CreatedAt
 	}
+	if user.ProfileVisibility != "" {
+		existingDBUser.ProfileVisibility = string(user.ProfileVisibility)
+	}
+	if existingDBUser.Attributes == nil {
+		existingDBUser.Attributes = map[string]interface{}{}
+	}
+	for key, value := range user.Attributes {
+		if value == nil {
+			delete(existingDBUser.Attributes, key)
+		} else {
+			existingDBUser.Attributes[key] = value
+		}
+	}
 	existingDBUser.UpdatedAt = p.nowFunc()
 }
 
@@ -220,17 +538,47 @@ func translateDBToModels(dbUsers []userDB) []model.User {
 
 func translateDBToModel(dbUser userDB) model.User {
 	return model.User{
-		ID:           dbUser.ID,
-		FirstName:    dbUser.FirstName,
-		LastName:     dbUser.LastName,
-		Nickname:     dbUser.Nickname,
-		Email:        dbUser.Email,
-		PasswordHash: dbUser.PasswordHash,
-		Country:      dbUser.Country,
-		CreatedAt:    dbUser.CreatedAt,
-		UpdatedAt:    dbUser.UpdatedAt,
-		DeletedAt:    dbUser.DeletedAt,
+		ID:                   dbUser.ID,
+		FirstName:            dbUser.FirstName,
+		LastName:             dbUser.LastName,
+		Nickname:             dbUser.Nickname,
+		Email:                dbUser.Email,
+		PasswordHash:         dbUser.PasswordHash,
+		Country:              dbUser.Country,
+		CreatedAt:            dbUser.CreatedAt,
+		UpdatedAt:            dbUser.UpdatedAt,
+		DeletedAt:            dbUser.DeletedAt,
+		ErasedAt:             dbUser.ErasedAt,
+		LockedUntil:          dbUser.LockedUntil,
+		TOTPEnabledAt:        dbUser.TOTPEnabledAt,
+		Roles:                translateRoles(dbUser.Roles),
+		Attributes:           translateAttributes(dbUser.Attributes),
+		SuspensionReason:     model.SuspensionReason(dbUser.SuspensionReason),
+		SuspendedAt:          dbUser.SuspendedAt,
+		SuspendedUntil:       dbUser.SuspendedUntil,
+		Status:               model.UserStatus(dbUser.Status),
+		VerifiedAt:           dbUser.VerifiedAt,
+		DeletionScheduledFor: dbUser.DeletionScheduledFor,
+		ProfileVisibility:    model.ProfileVisibility(dbUser.ProfileVisibility),
+	}
+}
+
+func translateAttributes(dbAttributes map[string]interface{}) map[string]interface{} {
+	if len(dbAttributes) == 0 {
+		return nil
 	}
+	return dbAttributes
+}
+
+func translateRoles(dbRoles []string) []model.Role {
+	if len(dbRoles) == 0 {
+		return nil
+	}
+	roles := make([]model.Role, len(dbRoles))
+	for i, role := range dbRoles {
+		roles[i] = model.Role(role)
+	}
+	return roles
 }
 
 type userDB struct {
@@ -248,9 +596,15 @@ type userDB struct {
 	// Nickname is the user nickname
 	Nickname string `pg:"nickname"`
 
+	// NicknameKey is the key the nickname is compared by, see model.NicknameKey.
+	NicknameKey string `pg:"nickname_key,use_zero"`
+
 	// Email is the user email
 	Email string `pg:"email"`
 
+	// EmailIndex is the value the user is looked up by email with.
+	EmailIndex string `pg:"email_index"`
+
 	// PasswordHash contains the password hash.
 	PasswordHash string `pg:"password_hash"`
 
@@ -265,4 +619,41 @@ type userDB struct {
 
 	// DeletedAt is the time at which the user was deleted. Zero-valued if user not deleted
 	DeletedAt time.Time `pg:"deleted_at"`
+
+	// ErasedAt is the time at which the user personal data was erased. Zero-valued if user not erased
+	ErasedAt time.Time `pg:"erased_at"`
+
+	// LockedUntil is the time until which the credential checks of the user are rejected. Zero-valued if never locked
+	LockedUntil time.Time `pg:"locked_until"`
+
+	// TOTPEnabledAt is the time at which the user enabled the TOTP second factor. Zero-valued if not enabled
+	TOTPEnabledAt time.Time `pg:"totp_enabled_at"`
+
+	// Roles are the roles granted to the user, mirrored from the user_roles table
+	Roles []string `pg:"roles,array"`
+
+	// Attributes are the custom profile attributes of the user, by key
+	Attributes map[string]interface{} `pg:"attributes,type:jsonb"`
+
+	// SuspensionReason is the reason code of the suspension in force, mirrored from the user_suspensions table
+	SuspensionReason string `pg:"suspension_reason"`
+
+	// SuspendedAt is the time of the suspension in force. Zero-valued if not suspended
+	SuspendedAt time.Time `pg:"suspended_at"`
+
+	// SuspendedUntil is the expiry of the suspension in force. Zero-valued for bans and if not suspended
+	SuspendedUntil time.Time `pg:"suspended_until"`
+
+	// Status is the state of the account of the user
+	Status string `pg:"status"`
+
+	// VerifiedAt is the time at which the email of the user was verified. Zero-valued if not verified
+	VerifiedAt time.Time `pg:"verified_at"`
+
+	// DeletionScheduledFor is the time at which the deletion requested by the user is carried out. Zero-valued unless
+	// scheduled for deletion
+	DeletionScheduledFor time.Time `pg:"deletion_scheduled_for"`
+
+	// ProfileVisibility decides who can see the public profile of the user. Empty means public
+	ProfileVisibility string `pg:"profile_visibility,use_zero"`
 }
@@ -43,7 +43,7 @@ func (suite *PostgresDBTestSuite) SetupSuite() {
 }
 
 func (suite *PostgresDBTestSuite) SetupTest() {
-	_, err := suite.db.Exec("TRUNCATE TABLE faceittha.users")
+	_, err := suite.db.Exec("TRUNCATE TABLE faceittha.users, faceittha.users_history, faceittha.user_audit, faceittha.user_data_keys, faceittha.rate_limits, faceittha.credential_failures, faceittha.sessions, faceittha.refresh_tokens, faceittha.totp_credentials, faceittha.recovery_codes, faceittha.login_challenges, faceittha.api_keys, faceittha.user_roles, faceittha.friendships, faceittha.user_blocks, faceittha.user_suspensions, faceittha.nickname_changes, faceittha.nickname_reservations")
 	suite.Require().NoError(err)
 }
 
@@ -154,7 +154,7 @@ func (suite *PostgresDBTestSuite) TestUpdateUser() {
 				suite.Require().NoError(suite.postgresAdapter.SaveUser(context.Background(), test.existing))
 			}
 			// insert or update the user
-			err := suite.postgresAdapter.UpdateUser(context.Background(), test.input)
+			err := suite.postgresAdapter.UpdateUser(context.Background(), test.input, ports.UpdateUserQuery{})
 			if test.expectedErr != nil {
 				test.expectedErr(suite.T(), err)
 			} else {
@@ -226,6 +226,7 @@ func (suite *PostgresDBTestSuite) TestListUsers() {
 					Country:      "uk",
 					CreatedAt:    dummyTime.Add(-10 * time.Minute),
 					UpdatedAt:    dummyTime,
+					Status:       model.UserStatusPendingVerification,
 				},
 				{
 					ID:           uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5df"),
@@ -237,6 +238,7 @@ func (suite *PostgresDBTestSuite) TestListUsers() {
 					Country:      "br",
 					CreatedAt:    dummyTime.Add(-5 * time.Minute),
 					UpdatedAt:    dummyTime,
+					Status:       model.UserStatusPendingVerification,
 				},
 			},
 		},
@@ -292,6 +294,7 @@ func (suite *PostgresDBTestSuite) TestListUsers() {
 					Country:      "br",
 					CreatedAt:    dummyTime.Add(-5 * time.Minute),
 					UpdatedAt:    dummyTime,
+					Status:       model.UserStatusPendingVerification,
 				},
 			},
 		},
@@ -346,6 +349,7 @@ func (suite *PostgresDBTestSuite) TestListUsers() {
 					Country:      "uk",
 					CreatedAt:    dummyTime.Add(-10 * time.Minute),
 					UpdatedAt:    dummyTime,
+					Status:       model.UserStatusPendingVerification,
 				},
 				{
 					ID:           uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5df"),
@@ -357,6 +361,7 @@ func (suite *PostgresDBTestSuite) TestListUsers() {
 					Country:      "br",
 					CreatedAt:    dummyTime.Add(-5 * time.Minute),
 					UpdatedAt:    dummyTime,
+					Status:       model.UserStatusPendingVerification,
 				},
 			},
 		},
@@ -412,6 +417,7 @@ func (suite *PostgresDBTestSuite) TestListUsers() {
 					Country:      "us",
 					CreatedAt:    dummyTime.Add(-1 * time.Minute),
 					UpdatedAt:    dummyTime,
+					Status:       model.UserStatusPendingVerification,
 				},
 			},
 		},
@@ -510,6 +516,7 @@ func (suite *PostgresDBTestSuite) TestListUsers() {
 					Country:      "uk",
 					CreatedAt:    dummyTime.Add(-10 * time.Minute),
 					UpdatedAt:    dummyTime,
+					Status:       model.UserStatusPendingVerification,
 				},
 			},
 		},
@@ -580,6 +587,7 @@ func (suite *PostgresDBTestSuite) TestDeleteUser() {
 					CreatedAt:    dummyTime.Add(-10 * time.Minute),
 					UpdatedAt:    dummyTime,
 					DeletedAt:    dummyTime,
+					Status:       string(model.UserStatusDeleted),
 				}
 				suite.Equal(expected, got)
 			},
@@ -613,13 +621,29 @@ func (suite *PostgresDBTestSuite) TestDeleteUser() {
 				ID:         uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-5e60a5b5d5de"),
 				HardDelete: false,
 			},
+			expectedErr: model.ErrNotFound,
 		},
 		{
 			name: "hard-delete non-existing record",
 			query: ports.DeleteUserQuery{
 				ID:         uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-6e60a5b5d5de"),
+				HardDelete: true,
+			},
+		},
+		{
+			name: "soft-delete deleted record",
+			existing: &model.User{
+				ID:        uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+				Nickname:  "n1",
+				Email:     "e1",
+				CreatedAt: dummyTime.Add(-10 * time.Minute),
+				DeletedAt: dummyTime,
+			},
+			query: ports.DeleteUserQuery{
+				ID:         uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
 				HardDelete: false,
 			},
+			expectedErr: model.ErrFailedPrecondition,
 		},
 	}
 
@@ -648,6 +672,162 @@ func (suite *PostgresDBTestSuite) TestDeleteUser() {
 	}
 }
 
+func (suite *PostgresDBTestSuite) TestGetUser() {
+	existing := &model.User{
+		ID:           uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+		FirstName:    "fn1",
+		LastName:     "ln1",
+		Nickname:     "n1",
+		Email:        "e1",
+		PasswordHash: "h1",
+		Country:      "uk",
+		CreatedAt:    dummyTime.Add(-10 * time.Minute),
+	}
+	tests := []struct {
+		name        string
+		softDeleted bool
+		query       ports.GetUserQuery
+		expectedErr error
+		expected    *model.User
+	}{
+		{
+			name:  "existing user",
+			query: ports.GetUserQuery{ID: existing.ID},
+			expected: &model.User{
+				ID:           existing.ID,
+				FirstName:    "fn1",
+				LastName:     "ln1",
+				Nickname:     "n1",
+				Email:        "e1",
+				PasswordHash: "h1",
+				Country:      "uk",
+				CreatedAt:    dummyTime.Add(-10 * time.Minute),
+				UpdatedAt:    dummyTime,
+				Status:       model.UserStatusPendingVerification,
+			},
+		},
+		{
+			name:        "non-existing user",
+			query:       ports.GetUserQuery{ID: uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-5e60a5b5d5de")},
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			name:        "soft-deleted user is not returned by default",
+			softDeleted: true,
+			query:       ports.GetUserQuery{ID: existing.ID},
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			name:        "soft-deleted user is returned when including deleted",
+			softDeleted: true,
+			query:       ports.GetUserQuery{ID: existing.ID, IncludeDeleted: true},
+			expected: &model.User{
+				ID:           existing.ID,
+				FirstName:    "fn1",
+				LastName:     "ln1",
+				Nickname:     "n1",
+				Email:        "e1",
+				PasswordHash: "h1",
+				Country:      "uk",
+				CreatedAt:    dummyTime.Add(-10 * time.Minute),
+				UpdatedAt:    dummyTime,
+				DeletedAt:    dummyTime,
+				Status:       model.UserStatusDeleted,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			_, err := suite.db.Exec("TRUNCATE TABLE faceittha.users")
+			suite.Require().NoError(err)
+			user := *existing
+			suite.Require().NoError(suite.postgresAdapter.SaveUser(context.Background(), &user))
+			if test.softDeleted {
+				suite.Require().NoError(suite.postgresAdapter.DeleteUser(context.Background(), ports.DeleteUserQuery{ID: existing.ID}))
+			}
+
+			got, err := suite.postgresAdapter.GetUser(context.Background(), test.query)
+			if test.expectedErr != nil {
+				suite.ErrorIs(err, test.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Equal(test.expected, got)
+		})
+	}
+}
+
+func (suite *PostgresDBTestSuite) TestEraseUser() {
+	existing := &model.User{
+		ID:           uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+		FirstName:    "fn1",
+		LastName:     "ln1",
+		Nickname:     "n1",
+		Email:        "e1",
+		PasswordHash: "h1",
+		Country:      "uk",
+		CreatedAt:    dummyTime.Add(-10 * time.Minute),
+	}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(context.Background(), existing))
+
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(context.Background(), existing.ID))
+
+	got := new(userDB)
+	suite.Require().NoError(suite.db.Model(got).Where("id = ?", existing.ID).Select())
+	suite.Equal(&userDB{
+		ID:        existing.ID,
+		CreatedAt: dummyTime.Add(-10 * time.Minute),
+		UpdatedAt: dummyTime,
+		DeletedAt: dummyTime,
+		ErasedAt:  dummyTime,
+		Status:    string(model.UserStatusDeleted),
+	}, got)
+
+	suite.ErrorIs(suite.postgresAdapter.EraseUser(context.Background(), uuid.New()), model.ErrNotFound)
+}
+
+func (suite *PostgresDBTestSuite) TestRestoreUser() {
+	existing := &model.User{
+		ID:           uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+		FirstName:    "fn1",
+		LastName:     "ln1",
+		Nickname:     "n1",
+		Email:        "e1",
+		PasswordHash: "h1",
+		Country:      "uk",
+		CreatedAt:    dummyTime.Add(-10 * time.Minute),
+	}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(context.Background(), existing))
+
+	// only soft-deleted users can be restored
+	_, err := suite.postgresAdapter.RestoreUser(context.Background(), existing.ID)
+	suite.ErrorIs(err, model.ErrFailedPrecondition)
+	_, err = suite.postgresAdapter.RestoreUser(context.Background(), uuid.New())
+	suite.ErrorIs(err, model.ErrNotFound)
+
+	suite.Require().NoError(suite.postgresAdapter.DeleteUser(context.Background(), ports.DeleteUserQuery{ID: existing.ID}))
+	got, err := suite.postgresAdapter.RestoreUser(context.Background(), existing.ID)
+	suite.Require().NoError(err)
+	suite.Equal(&model.User{
+		ID:           existing.ID,
+		FirstName:    "fn1",
+		LastName:     "ln1",
+		Nickname:     "n1",
+		Email:        "e1",
+		PasswordHash: "h1",
+		Country:      "uk",
+		CreatedAt:    dummyTime.Add(-10 * time.Minute),
+		UpdatedAt:    dummyTime,
+		Status:       model.UserStatusPendingVerification,
+	}, got)
+
+	// erased users cannot be restored
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(context.Background(), existing.ID))
+	_, err = suite.postgresAdapter.RestoreUser(context.Background(), existing.ID)
+	suite.ErrorIs(err, model.ErrNotFound)
+}
+
 func TestPostgresDBSuite(t *testing.T) {
 	suite.Run(t, new(PostgresDBTestSuite))
 }
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// TakeToken takes a token from the bucket identified by key. If the bucket is empty no token is taken and the time to
+// wait until a token is available is returned. The buckets are shared by every replica using the database, the time
+// of the database is used so that the replicas do not need synchronized clocks.
+func (p *PostgresDB) TakeToken(ctx context.Context, key string, limit model.RateLimit) (time.Duration, error) {
+	interval := limit.Interval().Microseconds()
+	burst := interval * int64(limit.Burst)
+
+	res, err := p.db.ExecContext(ctx, `
+		INSERT INTO faceittha.rate_limits AS r (key, tat)
+		VALUES (?0, now() + make_interval(secs => ?1 / 1e6))
+		ON CONFLICT (key) DO UPDATE
+			SET tat = GREATEST(r.tat, now()) + make_interval(secs => ?1 / 1e6)
+			WHERE GREATEST(r.tat, now()) + make_interval(secs => (?1 - ?2) / 1e6) <= now()`,
+		key, interval, burst)
+	if err != nil {
+		return 0, err
+	}
+	if res.RowsAffected() > 0 {
+		return 0, nil
+	}
+
+	var wait float64
+	_, err = p.db.QueryOneContext(ctx, pg.Scan(&wait), `
+		SELECT GREATEST(EXTRACT(EPOCH FROM GREATEST(tat, now()) - now()) * 1e6 + ?1 - ?2, 0)
+		FROM faceittha.rate_limits WHERE key = ?0`,
+		key, interval, burst)
+	if err == pg.ErrNoRows {
+		// the bucket was removed in the meantime, it is full
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(wait) * time.Microsecond, nil
+}
+
+// DeleteFullRateLimitBuckets removes the buckets that are full, they are equivalent to missing ones.
+func (p *PostgresDB) DeleteFullRateLimitBuckets(ctx context.Context) (int, error) {
+	res, err := p.db.ExecContext(ctx, `DELETE FROM faceittha.rate_limits WHERE tat < now()`)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+func (suite *PostgresDBTestSuite) TestTakeToken() {
+	adapter, err := NewPostgresDB(PostgresDBArgs{DB: suite.db})
+	suite.Require().NoError(err)
+	ctx := context.Background()
+	limit := model.RateLimit{Rate: 1.0 / 60, Burst: 2}
+
+	for i := 0; i < limit.Burst; i++ {
+		wait, err := adapter.TakeToken(ctx, "CreateUser ip:10.0.0.1", limit)
+		suite.Require().NoError(err)
+		suite.Zero(wait)
+	}
+	wait, err := adapter.TakeToken(ctx, "CreateUser ip:10.0.0.1", limit)
+	suite.Require().NoError(err)
+	suite.InDelta(time.Minute, wait, float64(time.Second))
+
+	// the buckets are independent
+	wait, err = adapter.TakeToken(ctx, "CreateUser ip:10.0.0.2", limit)
+	suite.Require().NoError(err)
+	suite.Zero(wait)
+
+	// buckets that are not full are kept
+	deleted, err := adapter.DeleteFullRateLimitBuckets(ctx)
+	suite.Require().NoError(err)
+	suite.Zero(deleted)
+	_, err = suite.db.Exec("UPDATE faceittha.rate_limits SET tat = now() - interval '1 second'")
+	suite.Require().NoError(err)
+	deleted, err = adapter.DeleteFullRateLimitBuckets(ctx)
+	suite.Require().NoError(err)
+	suite.Equal(2, deleted)
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// GrantRole grants the role to the user. It returns model.ErrNotFound if the user does not exist or is deleted, and
+// an error wrapping model.ErrFailedPrecondition if the role is granted already.
+func (p *PostgresDB) GrantRole(ctx context.Context, query ports.GrantRoleQuery) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		if err := lockUser(ctx, tx, query.UserID); err != nil {
+			return err
+		}
+		res, err := tx.ModelContext(ctx, &userRoleDB{
+			UserID:    query.UserID,
+			Role:      string(query.Role),
+			GrantedBy: query.GrantedBy,
+			GrantedAt: query.GrantedAt,
+		}).OnConflict("DO NOTHING").Insert()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: role already granted", model.ErrFailedPrecondition)
+		}
+		return mirrorRoles(ctx, tx, query.UserID, query.GrantedAt)
+	})
+}
+
+// RevokeRole revokes the role of the user. It returns model.ErrNotFound if the user does not exist or is deleted, and
+// an error wrapping model.ErrFailedPrecondition if the role is not granted.
+func (p *PostgresDB) RevokeRole(ctx context.Context, userID uuid.UUID, role model.Role, revokedAt time.Time) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		if err := lockUser(ctx, tx, userID); err != nil {
+			return err
+		}
+		res, err := tx.ModelContext(ctx, (*userRoleDB)(nil)).
+			Where("user_id = ?", userID).
+			Where("role = ?", role).
+			Delete()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: role not granted", model.ErrFailedPrecondition)
+		}
+		return mirrorRoles(ctx, tx, userID, revokedAt)
+	})
+}
+
+// lockUser locks the row of the user until the end of the transaction, serializing the changes of their roles. It
+// returns model.ErrNotFound if the user does not exist or is deleted.
+func lockUser(ctx context.Context, tx *pg.Tx, userID uuid.UUID) error {
+	err := tx.ModelContext(ctx, &userDB{}).
+		Column("id").
+		Where("id = ?", userID).
+		Where("deleted_at IS NULL").
+		For("UPDATE").
+		Select()
+	if err == pg.ErrNoRows {
+		return model.ErrNotFound
+	}
+	return err
+}
+
+// mirrorRoles copies the roles of the user to the users table, where CDC captures them.
+func mirrorRoles(ctx context.Context, tx *pg.Tx, userID uuid.UUID, updatedAt time.Time) error {
+	_, err := tx.ModelContext(ctx, (*userDB)(nil)).
+		Set("roles = ARRAY(SELECT role FROM faceittha.user_roles WHERE user_id = ? ORDER BY role)", userID).
+		Set("updated_at = ?", updatedAt).
+		Where("id = ?", userID).
+		Update()
+	return err
+}
+
+type userRoleDB struct {
+	tableName struct{} `pg:"faceittha.user_roles"`
+
+	// UserID is the id of the user.
+	UserID uuid.UUID `pg:"user_id,pk,type:uuid"`
+
+	// Role is the granted role.
+	Role string `pg:"role,pk"`
+
+	// GrantedBy is the ID of the actor that granted the role.
+	GrantedBy string `pg:"granted_by,use_zero"`
+
+	// GrantedAt is the time of the grant.
+	GrantedAt time.Time `pg:"granted_at"`
+}
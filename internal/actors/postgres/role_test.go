@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestRoles() {
+	ctx := context.Background()
+	user := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+
+	suite.Require().NoError(suite.postgresAdapter.GrantRole(ctx, ports.GrantRoleQuery{UserID: user.ID, Role: model.RoleSupport, GrantedBy: "admin", GrantedAt: dummyTime}))
+	suite.Require().NoError(suite.postgresAdapter.GrantRole(ctx, ports.GrantRoleQuery{UserID: user.ID, Role: model.RoleAdmin, GrantedBy: "admin", GrantedAt: dummyTime}))
+	suite.ErrorIs(suite.postgresAdapter.GrantRole(ctx, ports.GrantRoleQuery{UserID: user.ID, Role: model.RoleAdmin, GrantedBy: "admin", GrantedAt: dummyTime}), model.ErrFailedPrecondition)
+	suite.ErrorIs(suite.postgresAdapter.GrantRole(ctx, ports.GrantRoleQuery{UserID: uuid.New(), Role: model.RoleAdmin, GrantedBy: "admin", GrantedAt: dummyTime}), model.ErrNotFound)
+	got, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: user.ID})
+	suite.Require().NoError(err)
+	suite.Equal([]model.Role{model.RoleAdmin, model.RoleSupport}, got.Roles)
+
+	// updates of the profile leave the roles untouched
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: user.ID, Nickname: "jd2"}, ports.UpdateUserQuery{}))
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: user.ID})
+	suite.Require().NoError(err)
+	suite.Equal([]model.Role{model.RoleAdmin, model.RoleSupport}, got.Roles)
+
+	suite.Require().NoError(suite.postgresAdapter.RevokeRole(ctx, user.ID, model.RoleAdmin, dummyTime))
+	suite.ErrorIs(suite.postgresAdapter.RevokeRole(ctx, user.ID, model.RoleAdmin, dummyTime), model.ErrFailedPrecondition)
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: user.ID})
+	suite.Require().NoError(err)
+	suite.Equal([]model.Role{model.RoleSupport}, got.Roles)
+
+	suite.Require().NoError(suite.postgresAdapter.DeleteUser(ctx, ports.DeleteUserQuery{ID: user.ID}))
+	suite.ErrorIs(suite.postgresAdapter.RevokeRole(ctx, user.ID, model.RoleSupport, dummyTime), model.ErrNotFound)
+}
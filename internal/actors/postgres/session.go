@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// SaveSession saves a new session along with its first refresh token.
+func (p *PostgresDB) SaveSession(ctx context.Context, session *model.Session, token *model.RefreshToken) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		if _, err := tx.ModelContext(ctx, toSessionDB(*session)).Insert(); err != nil {
+			return err
+		}
+		_, err := tx.ModelContext(ctx, toRefreshTokenDB(*token)).Insert()
+		return err
+	})
+}
+
+// GetSession returns the session. It returns model.ErrNotFound if the session does not exist.
+func (p *PostgresDB) GetSession(ctx context.Context, id uuid.UUID) (*model.Session, error) {
+	session := &sessionDB{ID: id}
+	if err := p.db.ModelContext(ctx, session).WherePK().Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+	ret := translateSession(*session)
+	return &ret, nil
+}
+
+// GetRefreshToken returns the refresh token by digest. It returns model.ErrNotFound if the token does not exist.
+func (p *PostgresDB) GetRefreshToken(ctx context.Context, hash string) (*model.RefreshToken, error) {
+	token := &refreshTokenDB{TokenHash: hash}
+	if err := p.db.ModelContext(ctx, token).WherePK().Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+	return &model.RefreshToken{
+		Hash:      token.TokenHash,
+		SessionID: token.SessionID,
+		CreatedAt: token.CreatedAt,
+		UsedAt:    token.UsedAt,
+	}, nil
+}
+
+// RotateRefreshToken atomically marks the refresh token as used, saves its successor and extends the session up to
+// expiresAt. It returns model.ErrNotFound if the token does not exist or was used already.
+func (p *PostgresDB) RotateRefreshToken(ctx context.Context, hash string, next *model.RefreshToken, expiresAt time.Time) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		// the condition on used_at makes concurrent rotations of the same token fail but one
+		res, err := tx.ModelContext(ctx, (*refreshTokenDB)(nil)).
+			Set("used_at = ?", next.CreatedAt).
+			Where("token_hash = ?", hash).
+			Where("used_at IS NULL").
+			Update()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return model.ErrNotFound
+		}
+		if _, err := tx.ModelContext(ctx, toRefreshTokenDB(*next)).Insert(); err != nil {
+			return err
+		}
+		_, err = tx.ModelContext(ctx, (*sessionDB)(nil)).
+			Set("refreshed_at = ?", next.CreatedAt).
+			Set("expires_at = ?", expiresAt).
+			Where("id = ?", next.SessionID).
+			Update()
+		return err
+	})
+}
+
+// ListSessions lists the sessions of the user that are active at the given time, most recently created first.
+func (p *PostgresDB) ListSessions(ctx context.Context, userID uuid.UUID, activeAt time.Time) ([]model.Session, error) {
+	var sessions []sessionDB
+	err := p.db.ModelContext(ctx, &sessions).
+		Where("user_id = ?", userID).
+		Where("revoked_at IS NULL").
+		Where("expires_at > ?", activeAt).
+		Order("created_at DESC").
+		Select()
+	if err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+	ret := make([]model.Session, len(sessions))
+	for i, session := range sessions {
+		ret[i] = translateSession(session)
+	}
+	return ret, nil
+}
+
+// RevokeSession revokes the session of the user. It returns model.ErrNotFound if the user has no such session or if
+// it was revoked already.
+func (p *PostgresDB) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	res, err := p.db.ModelContext(ctx, (*sessionDB)(nil)).
+		Set("revoked_at = ?", p.nowFunc()).
+		Where("id = ?", sessionID).
+		Where("user_id = ?", userID).
+		Where("revoked_at IS NULL").
+		Update()
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// SaveLoginChallenge saves the pending second step of a login. The expired challenges of the user are purged.
+func (p *PostgresDB) SaveLoginChallenge(ctx context.Context, challenge *model.LoginChallenge) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		_, err := tx.ModelContext(ctx, (*loginChallengeDB)(nil)).
+			Where("user_id = ?", challenge.UserID).
+			Where("expires_at <= ?", challenge.CreatedAt).
+			Delete()
+		if err != nil {
+			return err
+		}
+		_, err = tx.ModelContext(ctx, &loginChallengeDB{
+			ChallengeHash: challenge.Hash,
+			UserID:        challenge.UserID,
+			CreatedAt:     challenge.CreatedAt,
+			ExpiresAt:     challenge.ExpiresAt,
+		}).Insert()
+		return err
+	})
+}
+
+// GetLoginChallenge returns the login challenge by digest. It returns model.ErrNotFound if it does not exist.
+func (p *PostgresDB) GetLoginChallenge(ctx context.Context, hash string) (*model.LoginChallenge, error) {
+	challenge := &loginChallengeDB{ChallengeHash: hash}
+	if err := p.db.ModelContext(ctx, challenge).WherePK().Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+	return &model.LoginChallenge{
+		Hash:      challenge.ChallengeHash,
+		UserID:    challenge.UserID,
+		CreatedAt: challenge.CreatedAt,
+		ExpiresAt: challenge.ExpiresAt,
+	}, nil
+}
+
+// DeleteLoginChallenge consumes the login challenge. It returns model.ErrNotFound if it was consumed already.
+func (p *PostgresDB) DeleteLoginChallenge(ctx context.Context, hash string) error {
+	res, err := p.db.ModelContext(ctx, &loginChallengeDB{ChallengeHash: hash}).WherePK().Delete()
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+func toSessionDB(session model.Session) *sessionDB {
+	return &sessionDB{
+		ID:          session.ID,
+		UserID:      session.UserID,
+		UserAgent:   session.UserAgent,
+		SourceIP:    session.SourceIP,
+		CreatedAt:   session.CreatedAt,
+		RefreshedAt: session.RefreshedAt,
+		ExpiresAt:   session.ExpiresAt,
+		RevokedAt:   session.RevokedAt,
+	}
+}
+
+func toRefreshTokenDB(token model.RefreshToken) *refreshTokenDB {
+	return &refreshTokenDB{
+		TokenHash: token.Hash,
+		SessionID: token.SessionID,
+		CreatedAt: token.CreatedAt,
+		UsedAt:    token.UsedAt,
+	}
+}
+
+func translateSession(session sessionDB) model.Session {
+	return model.Session{
+		ID:          session.ID,
+		UserID:      session.UserID,
+		UserAgent:   session.UserAgent,
+		SourceIP:    session.SourceIP,
+		CreatedAt:   session.CreatedAt,
+		RefreshedAt: session.RefreshedAt,
+		ExpiresAt:   session.ExpiresAt,
+		RevokedAt:   session.RevokedAt,
+	}
+}
+
+type sessionDB struct {
+	tableName struct{} `pg:"faceittha.sessions"`
+
+	// ID unique identifier of the session.
+	ID uuid.UUID `pg:"id,pk,type:uuid"`
+
+	// UserID is the id of the logged in user.
+	UserID uuid.UUID `pg:"user_id,type:uuid"`
+
+	// UserAgent is the user agent of the client that logged in.
+	UserAgent string `pg:"user_agent,use_zero"`
+
+	// SourceIP is the IP address of the client that logged in.
+	SourceIP string `pg:"source_ip,use_zero"`
+
+	// CreatedAt is the time of the login.
+	CreatedAt time.Time `pg:"created_at"`
+
+	// RefreshedAt is the time of the last use of a refresh token of the session.
+	RefreshedAt time.Time `pg:"refreshed_at"`
+
+	// ExpiresAt is the time after which the refresh tokens of the session are rejected.
+	ExpiresAt time.Time `pg:"expires_at"`
+
+	// RevokedAt is the time at which the session was revoked. Zero-valued if the session was not revoked.
+	RevokedAt time.Time `pg:"revoked_at"`
+}
+
+type refreshTokenDB struct {
+	tableName struct{} `pg:"faceittha.refresh_tokens"`
+
+	// TokenHash is the SHA-256 digest of the token, hex encoded.
+	TokenHash string `pg:"token_hash,pk"`
+
+	// SessionID is the id of the session the token belongs to.
+	SessionID uuid.UUID `pg:"session_id,type:uuid"`
+
+	// CreatedAt is the time at which the token was issued.
+	CreatedAt time.Time `pg:"created_at"`
+
+	// UsedAt is the time at which the token was exchanged for its successor. Zero-valued if the token was not used.
+	UsedAt time.Time `pg:"used_at"`
+}
+
+type loginChallengeDB struct {
+	tableName struct{} `pg:"faceittha.login_challenges"`
+
+	// ChallengeHash is the SHA-256 digest of the challenge, hex encoded.
+	ChallengeHash string `pg:"challenge_hash,pk"`
+
+	// UserID is the id of the user logging in.
+	UserID uuid.UUID `pg:"user_id,type:uuid"`
+
+	// CreatedAt is the time at which the password of the user was verified.
+	CreatedAt time.Time `pg:"created_at"`
+
+	// ExpiresAt is the time after which the challenge is rejected.
+	ExpiresAt time.Time `pg:"expires_at"`
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestGetUserByEmail() {
+	ctx := context.Background()
+	user := &model.User{ID: uuid.New(), Nickname: "jd", Email: "Jane.Doe@Example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+
+	got, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Email: " jane.doe@example.com"})
+	suite.Require().NoError(err)
+	suite.Equal(user.ID, got.ID)
+
+	// the index follows the updates of the email
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: user.ID, Email: "jane@example.com"}, ports.UpdateUserQuery{}))
+	_, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Email: "jane.doe@example.com"})
+	suite.ErrorIs(err, model.ErrNotFound)
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Email: "JANE@example.com"})
+	suite.Require().NoError(err)
+	suite.Equal(user.ID, got.ID)
+
+	// erased users cannot be found by their former email
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(ctx, user.ID))
+	_, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Email: "jane@example.com", IncludeDeleted: true})
+	suite.ErrorIs(err, model.ErrNotFound)
+}
+
+func (suite *PostgresDBTestSuite) TestGetUserByCanonicalEmail() {
+	ctx := context.Background()
+	user := &model.User{ID: uuid.New(), Nickname: "jd", Email: "Jane.Doe+Games@GoogleMail.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+
+	// the raw email is kept for display, the lookups go through the canonical one
+	got, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Email: "janedoe@gmail.com"})
+	suite.Require().NoError(err)
+	suite.Equal(user.ID, got.ID)
+	suite.Equal("Jane.Doe+Games@GoogleMail.com", got.Email)
+
+	// a canonical email is held by a single user
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, john))
+	err = suite.postgresAdapter.SaveUser(ctx, &model.User{ID: uuid.New(), Nickname: "ji", Email: "JANEDOE@gmail.com", PasswordHash: "hash"})
+	suite.ErrorIs(err, model.ErrAlreadyExists)
+	err = suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: john.ID, Email: "jane.doe@gmail.com"}, ports.UpdateUserQuery{})
+	suite.ErrorIs(err, model.ErrAlreadyExists)
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: john.ID, Email: "John.Doe@example.com"}, ports.UpdateUserQuery{}))
+
+	// the users indexed by their lowercase email are found until they are re-indexed
+	_, err = suite.db.Exec("UPDATE faceittha.users SET email_index = 'jane.doe+games@googlemail.com' WHERE id = ?", user.ID)
+	suite.Require().NoError(err)
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{Email: "Jane.Doe+Games@GoogleMail.com"})
+	suite.Require().NoError(err)
+	suite.Equal(user.ID, got.ID)
+}
+
+func (suite *PostgresDBTestSuite) TestSessions() {
+	ctx := context.Background()
+	user := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+
+	session := &model.Session{
+		ID:          uuid.New(),
+		UserID:      user.ID,
+		UserAgent:   "faceit-client/1.0",
+		SourceIP:    "10.0.0.1",
+		CreatedAt:   dummyTime,
+		RefreshedAt: dummyTime,
+		ExpiresAt:   dummyTime.Add(time.Hour),
+	}
+	first := &model.RefreshToken{Hash: "first", SessionID: session.ID, CreatedAt: dummyTime}
+	suite.Require().NoError(suite.postgresAdapter.SaveSession(ctx, session, first))
+
+	got, err := suite.postgresAdapter.GetSession(ctx, session.ID)
+	suite.Require().NoError(err)
+	suite.Equal(*session, *got)
+	token, err := suite.postgresAdapter.GetRefreshToken(ctx, "first")
+	suite.Require().NoError(err)
+	suite.Equal(*first, *token)
+	_, err = suite.postgresAdapter.GetRefreshToken(ctx, "unknown")
+	suite.ErrorIs(err, model.ErrNotFound)
+
+	// a token is rotated once
+	refreshedAt := dummyTime.Add(time.Minute)
+	second := &model.RefreshToken{Hash: "second", SessionID: session.ID, CreatedAt: refreshedAt}
+	suite.Require().NoError(suite.postgresAdapter.RotateRefreshToken(ctx, "first", second, refreshedAt.Add(time.Hour)))
+	third := &model.RefreshToken{Hash: "third", SessionID: session.ID, CreatedAt: refreshedAt}
+	suite.ErrorIs(suite.postgresAdapter.RotateRefreshToken(ctx, "first", third, refreshedAt.Add(time.Hour)), model.ErrNotFound)
+	token, err = suite.postgresAdapter.GetRefreshToken(ctx, "first")
+	suite.Require().NoError(err)
+	suite.True(refreshedAt.Equal(token.UsedAt))
+	got, err = suite.postgresAdapter.GetSession(ctx, session.ID)
+	suite.Require().NoError(err)
+	suite.True(refreshedAt.Equal(got.RefreshedAt))
+	suite.True(refreshedAt.Add(time.Hour).Equal(got.ExpiresAt))
+
+	sessions, err := suite.postgresAdapter.ListSessions(ctx, user.ID, dummyTime)
+	suite.Require().NoError(err)
+	suite.Len(sessions, 1)
+	sessions, err = suite.postgresAdapter.ListSessions(ctx, user.ID, refreshedAt.Add(2*time.Hour))
+	suite.Require().NoError(err)
+	suite.Empty(sessions)
+
+	suite.ErrorIs(suite.postgresAdapter.RevokeSession(ctx, uuid.New(), session.ID), model.ErrNotFound)
+	suite.Require().NoError(suite.postgresAdapter.RevokeSession(ctx, user.ID, session.ID))
+	suite.ErrorIs(suite.postgresAdapter.RevokeSession(ctx, user.ID, session.ID), model.ErrNotFound)
+	sessions, err = suite.postgresAdapter.ListSessions(ctx, user.ID, dummyTime)
+	suite.Require().NoError(err)
+	suite.Empty(sessions)
+
+	// the sessions go with the user
+	suite.Require().NoError(suite.postgresAdapter.DeleteUser(ctx, ports.DeleteUserQuery{ID: user.ID, HardDelete: true}))
+	_, err = suite.postgresAdapter.GetSession(ctx, session.ID)
+	suite.ErrorIs(err, model.ErrNotFound)
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// VerifyUser records the verification of the email of a pending user, activating their account. It returns
+// model.ErrNotFound if the user does not exist and an error wrapping model.ErrFailedPrecondition if the user is not
+// pending verification.
+func (p *PostgresDB) VerifyUser(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		user, err := lockStatus(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		// the suspended users are verified once lifted, settling back as pending verification
+		if user.Status != string(model.UserStatusPendingVerification) {
+			return fmt.Errorf("%w: user is %s, not pending verification", model.ErrFailedPrecondition, user.Status)
+		}
+		_, err = tx.ModelContext(ctx, (*userDB)(nil)).
+			Set("verified_at = ?", verifiedAt).
+			Set("status = ?", model.UserStatusActive).
+			Set("updated_at = ?", verifiedAt).
+			Where("id = ?", id).
+			Update()
+		return err
+	})
+}
+
+// lockStatus locks the row of the user, deleted or not, until the end of the transaction and returns the columns the
+// status of the user derives from, serializing the transitions of the status. It returns model.ErrNotFound if the user
+// does not exist.
+func lockStatus(ctx context.Context, tx *pg.Tx, userID uuid.UUID) (*userDB, error) {
+	user := &userDB{}
+	err := tx.ModelContext(ctx, user).
+		Column("id", "status", "verified_at", "suspended_at", "deleted_at", "erased_at", "deletion_scheduled_for").
+		Where("id = ?", userID).
+		For("UPDATE").
+		Select()
+	if err == pg.ErrNoRows {
+		return nil, model.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
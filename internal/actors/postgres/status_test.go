@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestUserStatus() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	for _, user := range []*model.User{jane, john} {
+		suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+		suite.Equal(model.UserStatusPendingVerification, user.Status)
+	}
+	status := func(user *model.User) model.UserStatus {
+		got, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: user.ID, IncludeDeleted: true})
+		suite.Require().NoError(err)
+		return got.Status
+	}
+
+	suite.Require().NoError(suite.postgresAdapter.VerifyUser(ctx, jane.ID, dummyTime))
+	suite.ErrorIs(suite.postgresAdapter.VerifyUser(ctx, jane.ID, dummyTime), model.ErrFailedPrecondition)
+	suite.ErrorIs(suite.postgresAdapter.VerifyUser(ctx, uuid.New(), dummyTime), model.ErrNotFound)
+	got, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(model.UserStatusActive, got.Status)
+	suite.Equal(dummyTime, got.VerifiedAt)
+
+	// updates of the profile leave the status untouched
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "jd2"}, ports.UpdateUserQuery{}))
+	suite.Equal(model.UserStatusActive, status(jane))
+
+	res, err := suite.postgresAdapter.ListUsers(ctx, ports.ListUsersQuery{Statuses: []model.UserStatus{model.UserStatusActive}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Users, 1)
+	suite.Equal(jane.ID, res.Users[0].ID)
+
+	// the deleted users are only listed along with the deleted ones
+	suite.Require().NoError(suite.postgresAdapter.DeleteUser(ctx, ports.DeleteUserQuery{ID: john.ID}))
+	suite.ErrorIs(suite.postgresAdapter.DeleteUser(ctx, ports.DeleteUserQuery{ID: john.ID}), model.ErrFailedPrecondition)
+	suite.ErrorIs(suite.postgresAdapter.VerifyUser(ctx, john.ID, dummyTime), model.ErrFailedPrecondition)
+	res, err = suite.postgresAdapter.ListUsers(ctx, ports.ListUsersQuery{Statuses: []model.UserStatus{model.UserStatusDeleted}})
+	suite.Require().NoError(err)
+	suite.Empty(res.Users)
+	res, err = suite.postgresAdapter.ListUsers(ctx, ports.ListUsersQuery{Statuses: []model.UserStatus{model.UserStatusDeleted}, IncludeDeleted: true})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Users, 1)
+	suite.Equal(john.ID, res.Users[0].ID)
+
+	// restored users settle back in their status
+	restored, err := suite.postgresAdapter.RestoreUser(ctx, john.ID)
+	suite.Require().NoError(err)
+	suite.Equal(model.UserStatusPendingVerification, restored.Status)
+
+	// the suspensions are kept across the deletions, the lifts settle the users back
+	suite.Require().NoError(suite.postgresAdapter.SaveSuspension(ctx, &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    jane.ID,
+		Reason:    model.SuspensionReasonCheating,
+		IssuedBy:  "support",
+		CreatedAt: dummyTime,
+		ExpiresAt: dummyTime.Add(time.Hour),
+	}))
+	suite.Equal(model.UserStatusSuspended, status(jane))
+	suite.Require().NoError(suite.postgresAdapter.DeleteUser(ctx, ports.DeleteUserQuery{ID: jane.ID}))
+	restored, err = suite.postgresAdapter.RestoreUser(ctx, jane.ID)
+	suite.Require().NoError(err)
+	suite.Equal(model.UserStatusSuspended, restored.Status)
+	suite.Require().NoError(suite.postgresAdapter.LiftSuspension(ctx, jane.ID, "support", dummyTime))
+	suite.Equal(model.UserStatusActive, status(jane))
+
+	// the suspensions of deleted users expire without restoring them
+	suite.Require().NoError(suite.postgresAdapter.SaveSuspension(ctx, &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    jane.ID,
+		Reason:    model.SuspensionReasonFraud,
+		IssuedBy:  "support",
+		CreatedAt: dummyTime,
+		ExpiresAt: dummyTime.Add(time.Minute),
+	}))
+	suite.Require().NoError(suite.postgresAdapter.DeleteUser(ctx, ports.DeleteUserQuery{ID: jane.ID}))
+	expired, err := suite.postgresAdapter.ExpireSuspensions(ctx, dummyTime.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Equal([]uuid.UUID{jane.ID}, expired)
+	suite.Equal(model.UserStatusDeleted, status(jane))
+	restored, err = suite.postgresAdapter.RestoreUser(ctx, jane.ID)
+	suite.Require().NoError(err)
+	suite.Equal(model.UserStatusActive, restored.Status)
+
+	// erased users are deleted whatever their status
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(ctx, jane.ID))
+	suite.Equal(model.UserStatusDeleted, status(jane))
+}
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// SaveSuspension saves the suspension in force of the user. It returns model.ErrNotFound if the user does not exist
+// or is deleted, and an error wrapping model.ErrFailedPrecondition if the user is suspended already or cannot be
+// suspended in their status.
+func (p *PostgresDB) SaveSuspension(ctx context.Context, suspension *model.Suspension) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		user, err := lockStatus(ctx, tx, suspension.UserID)
+		if err != nil {
+			return err
+		}
+		if !user.DeletedAt.IsZero() {
+			return model.ErrNotFound
+		}
+		if err := model.UserStatus(user.Status).CheckTransition(model.UserStatusSuspended); err != nil {
+			return err
+		}
+		// the unique index on the suspensions in force rejects a second one
+		res, err := tx.ModelContext(ctx, toSuspensionDB(*suspension)).OnConflict("DO NOTHING").Insert()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: user already suspended", model.ErrFailedPrecondition)
+		}
+		return mirrorSuspension(ctx, tx, suspension.UserID, model.UserStatusSuspended, suspension.CreatedAt)
+	})
+}
+
+// LiftSuspension ends the suspension in force of the user, who settles back in their status. It returns
+// model.ErrNotFound if the user does not exist or is deleted, and an error wrapping model.ErrFailedPrecondition if the
+// user is not suspended.
+func (p *PostgresDB) LiftSuspension(ctx context.Context, userID uuid.UUID, liftedBy string, liftedAt time.Time) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		user, err := lockStatus(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if !user.DeletedAt.IsZero() {
+			return model.ErrNotFound
+		}
+		res, err := tx.ModelContext(ctx, (*userSuspensionDB)(nil)).
+			Set("ended_at = ?", liftedAt).
+			Set("lifted_by = ?", liftedBy).
+			Where("user_id = ?", userID).
+			Where("ended_at IS NULL").
+			Update()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return fmt.Errorf("%w: user not suspended", model.ErrFailedPrecondition)
+		}
+		return settleSuspension(ctx, tx, user, liftedAt)
+	})
+}
+
+// ListSuspensions lists the suspensions of a user, most recent first.
+func (p *PostgresDB) ListSuspensions(ctx context.Context, query ports.ListSuspensionsQuery) ([]model.Suspension, error) {
+	var suspensions []userSuspensionDB
+	q := p.db.ModelContext(ctx, &suspensions).
+		Where("user_id = ?", query.UserID).
+		Order("created_at DESC", "id ASC")
+	if query.Limit != uint32(0) {
+		q = q.Limit(int(query.Limit))
+	}
+	if query.Offset != uint32(0) {
+		q = q.Offset(int(query.Offset))
+	}
+	if err := q.Select(); err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+	ret := make([]model.Suspension, len(suspensions))
+	for i, suspension := range suspensions {
+		ret[i] = translateSuspension(suspension)
+	}
+	return ret, nil
+}
+
+// ExpireSuspensions ends the suspensions in force that expired at the given time and returns the ids of the users whose
+// suspension ended. The suspensions end at their expiry, whenever it is processed, and their users settle back in their
+// status.
+func (p *PostgresDB) ExpireSuspensions(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := p.db.ModelContext(ctx, (*userSuspensionDB)(nil)).
+		Column("user_id").
+		Where("ended_at IS NULL").
+		Where("expires_at <= ?", now).
+		Select(&userIDs)
+	if err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+	var expired []uuid.UUID
+	// each user is locked before their suspension, like for the lifts, and in a transaction of their own
+	for _, userID := range userIDs {
+		err := p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+			user, err := lockStatus(ctx, tx, userID)
+			if err == model.ErrNotFound {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			res, err := tx.ModelContext(ctx, (*userSuspensionDB)(nil)).
+				Set("ended_at = expires_at").
+				Where("user_id = ?", userID).
+				Where("ended_at IS NULL").
+				Where("expires_at <= ?", now).
+				Update()
+			if err != nil {
+				return err
+			}
+			if res.RowsAffected() == 0 {
+				return nil
+			}
+			if err := settleSuspension(ctx, tx, user, now); err != nil {
+				return err
+			}
+			expired = append(expired, userID)
+			return nil
+		})
+		if err != nil {
+			return expired, err
+		}
+	}
+	return expired, nil
+}
+
+// settleSuspension mirrors the end of the suspension of the locked user, settling them back in their status if they
+// were suspended. The deleted users stay deleted.
+func settleSuspension(ctx context.Context, tx *pg.Tx, user *userDB, updatedAt time.Time) error {
+	status := model.UserStatus(user.Status)
+	if status == model.UserStatusSuspended {
+		user.SuspendedAt = time.Time{}
+		status = translateDBToModel(*user).SettledStatus()
+	}
+	return mirrorSuspension(ctx, tx, user.ID, status, updatedAt)
+}
+
+// mirrorSuspension copies the suspension in force of the user, if any, and their resulting status to the users table,
+// where CDC captures them. Suspending a user cancels the deletion they requested, so that a ban cannot be dodged.
+func mirrorSuspension(ctx context.Context, tx *pg.Tx, userID uuid.UUID, status model.UserStatus, updatedAt time.Time) error {
+	q := tx.ModelContext(ctx, (*userDB)(nil)).
+		Set("(suspension_reason, suspended_at, suspended_until) = "+
+			"(SELECT reason, created_at, expires_at FROM faceittha.user_suspensions WHERE user_id = ? AND ended_at IS NULL)", userID).
+		Set("status = ?", status).
+		Set("updated_at = ?", updatedAt).
+		Where("id = ?", userID)
+	if status == model.UserStatusSuspended {
+		q = q.Set("deletion_scheduled_for = NULL")
+	}
+	_, err := q.Update()
+	return err
+}
+
+func toSuspensionDB(suspension model.Suspension) *userSuspensionDB {
+	return &userSuspensionDB{
+		ID:        suspension.ID,
+		UserID:    suspension.UserID,
+		Reason:    string(suspension.Reason),
+		Note:      suspension.Note,
+		IssuedBy:  suspension.IssuedBy,
+		CreatedAt: suspension.CreatedAt,
+		ExpiresAt: suspension.ExpiresAt,
+		EndedAt:   suspension.EndedAt,
+		LiftedBy:  suspension.LiftedBy,
+	}
+}
+
+func translateSuspension(suspension userSuspensionDB) model.Suspension {
+	return model.Suspension{
+		ID:        suspension.ID,
+		UserID:    suspension.UserID,
+		Reason:    model.SuspensionReason(suspension.Reason),
+		Note:      suspension.Note,
+		IssuedBy:  suspension.IssuedBy,
+		CreatedAt: suspension.CreatedAt,
+		ExpiresAt: suspension.ExpiresAt,
+		EndedAt:   suspension.EndedAt,
+		LiftedBy:  suspension.LiftedBy,
+	}
+}
+
+type userSuspensionDB struct {
+	tableName struct{} `pg:"faceittha.user_suspensions"`
+
+	// ID is the id of the suspension.
+	ID uuid.UUID `pg:"id,pk,type:uuid"`
+
+	// UserID is the id of the suspended user.
+	UserID uuid.UUID `pg:"user_id,type:uuid"`
+
+	// Reason is the reason code of the suspension.
+	Reason string `pg:"reason"`
+
+	// Note is the free-text note about the suspension.
+	Note string `pg:"note,use_zero"`
+
+	// IssuedBy is the ID of the actor that issued the suspension.
+	IssuedBy string `pg:"issued_by,use_zero"`
+
+	// CreatedAt is the time of the suspension.
+	CreatedAt time.Time `pg:"created_at"`
+
+	// ExpiresAt is the time at which the suspension expires. NULL for bans.
+	ExpiresAt time.Time `pg:"expires_at"`
+
+	// EndedAt is the time at which the suspension was lifted or expired. NULL while in force.
+	EndedAt time.Time `pg:"ended_at"`
+
+	// LiftedBy is the ID of the actor that lifted the suspension. NULL if it expired or is in force.
+	LiftedBy string `pg:"lifted_by"`
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestSuspensions() {
+	ctx := context.Background()
+	jane := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	john := &model.User{ID: uuid.New(), Nickname: "jo", Email: "john@example.com", PasswordHash: "hash"}
+	for _, user := range []*model.User{jane, john} {
+		suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+	}
+
+	cheating := &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    jane.ID,
+		Reason:    model.SuspensionReasonCheating,
+		Note:      "aimbot",
+		IssuedBy:  "anti-cheat",
+		CreatedAt: dummyTime.Add(-time.Hour),
+		ExpiresAt: dummyTime.Add(time.Hour),
+	}
+	suite.Require().NoError(suite.postgresAdapter.SaveSuspension(ctx, cheating))
+	suite.ErrorIs(suite.postgresAdapter.SaveSuspension(ctx, &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    jane.ID,
+		Reason:    model.SuspensionReasonFraud,
+		CreatedAt: dummyTime,
+	}), model.ErrFailedPrecondition)
+	suite.ErrorIs(suite.postgresAdapter.SaveSuspension(ctx, &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Reason:    model.SuspensionReasonFraud,
+		CreatedAt: dummyTime,
+	}), model.ErrNotFound)
+
+	// the suspension in force is reflected in the user
+	got, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(model.SuspensionReasonCheating, got.SuspensionReason)
+	suite.Equal(cheating.CreatedAt, got.SuspendedAt)
+	suite.Equal(cheating.ExpiresAt, got.SuspendedUntil)
+	suite.Equal(model.UserStatusSuspended, got.Status)
+
+	// updates of the profile leave the suspension untouched
+	suite.Require().NoError(suite.postgresAdapter.UpdateUser(ctx, &model.User{ID: jane.ID, Nickname: "jd2"}, ports.UpdateUserQuery{}))
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Equal(cheating.CreatedAt, got.SuspendedAt)
+
+	suite.Require().NoError(suite.postgresAdapter.LiftSuspension(ctx, jane.ID, "support", dummyTime))
+	suite.ErrorIs(suite.postgresAdapter.LiftSuspension(ctx, jane.ID, "support", dummyTime), model.ErrFailedPrecondition)
+	suite.ErrorIs(suite.postgresAdapter.LiftSuspension(ctx, uuid.New(), "support", dummyTime), model.ErrNotFound)
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Empty(got.SuspensionReason)
+	suite.True(got.SuspendedAt.IsZero())
+	suite.Equal(model.UserStatusPendingVerification, got.Status)
+
+	// the expired suspensions end at their expiry, the bans never expire
+	abuse := &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    jane.ID,
+		Reason:    model.SuspensionReasonAbusiveBehavior,
+		IssuedBy:  "support",
+		CreatedAt: dummyTime,
+		ExpiresAt: dummyTime.Add(time.Minute),
+	}
+	suite.Require().NoError(suite.postgresAdapter.SaveSuspension(ctx, abuse))
+	suite.Require().NoError(suite.postgresAdapter.SaveSuspension(ctx, &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    john.ID,
+		Reason:    model.SuspensionReasonFraud,
+		IssuedBy:  "support",
+		CreatedAt: dummyTime,
+	}))
+	expired, err := suite.postgresAdapter.ExpireSuspensions(ctx, dummyTime)
+	suite.Require().NoError(err)
+	suite.Empty(expired)
+	expired, err = suite.postgresAdapter.ExpireSuspensions(ctx, dummyTime.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Equal([]uuid.UUID{jane.ID}, expired)
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: jane.ID})
+	suite.Require().NoError(err)
+	suite.True(got.SuspendedAt.IsZero())
+	got, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: john.ID})
+	suite.Require().NoError(err)
+	suite.Equal(model.SuspensionReasonFraud, got.SuspensionReason)
+	suite.True(got.SuspendedUntil.IsZero())
+
+	suspensions, err := suite.postgresAdapter.ListSuspensions(ctx, ports.ListSuspensionsQuery{UserID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Require().Len(suspensions, 2)
+	suite.Equal(abuse.ID, suspensions[0].ID)
+	suite.Equal(abuse.ExpiresAt, suspensions[0].EndedAt)
+	suite.Empty(suspensions[0].LiftedBy)
+	suite.Equal(cheating.ID, suspensions[1].ID)
+	suite.Equal("aimbot", suspensions[1].Note)
+	suite.Equal(dummyTime, suspensions[1].EndedAt)
+	suite.Equal("support", suspensions[1].LiftedBy)
+	suspensions, err = suite.postgresAdapter.ListSuspensions(ctx, ports.ListSuspensionsQuery{UserID: jane.ID, Limit: 1, Offset: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(suspensions, 1)
+	suite.Equal(cheating.ID, suspensions[0].ID)
+
+	// the erasure keeps the history of the suspensions but not their notes
+	suite.Require().NoError(suite.postgresAdapter.EraseUser(ctx, jane.ID))
+	suspensions, err = suite.postgresAdapter.ListSuspensions(ctx, ports.ListSuspensionsQuery{UserID: jane.ID})
+	suite.Require().NoError(err)
+	suite.Require().Len(suspensions, 2)
+	suite.Empty(suspensions[1].Note)
+}
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// SaveTOTPCredential saves a pending enrollment, replacing the previous pending one. It returns
+// model.ErrFailedPrecondition if the user has a confirmed credential.
+func (p *PostgresDB) SaveTOTPCredential(ctx context.Context, credential *model.TOTPCredential) error {
+	credentialDB := toTOTPCredentialDB(*credential)
+	if p.vault != nil {
+		if err := p.vault.Seal(ctx, credential.UserID, &credentialDB.Secret); err != nil {
+			return err
+		}
+	}
+	res, err := p.db.ModelContext(ctx, credentialDB).
+		OnConflict("(user_id) DO UPDATE").
+		Set("secret = EXCLUDED.secret").
+		Set("created_at = EXCLUDED.created_at").
+		Set("last_used_step = EXCLUDED.last_used_step").
+		Where("totp_credential_db.confirmed_at IS NULL").
+		Insert()
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("%w: totp already enabled", model.ErrFailedPrecondition)
+	}
+	return nil
+}
+
+// GetTOTPCredential returns the credential of the user. It returns model.ErrNotFound if the user has none.
+func (p *PostgresDB) GetTOTPCredential(ctx context.Context, userID uuid.UUID) (*model.TOTPCredential, error) {
+	credential := &totpCredentialDB{UserID: userID}
+	if err := p.db.ModelContext(ctx, credential).WherePK().Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+	if p.vault != nil {
+		if err := p.vault.Open(ctx, userID, &credential.Secret); err != nil {
+			return nil, err
+		}
+	}
+	return &model.TOTPCredential{
+		UserID:       credential.UserID,
+		Secret:       credential.Secret,
+		CreatedAt:    credential.CreatedAt,
+		ConfirmedAt:  credential.ConfirmedAt,
+		LastUsedStep: credential.LastUsedStep,
+	}, nil
+}
+
+// ConfirmTOTPCredential confirms the pending credential of the user, accepting the code of the given step, saves its
+// recovery codes by digest and enables TOTP on the user. It returns model.ErrNotFound if the user has no pending
+// credential.
+func (p *PostgresDB) ConfirmTOTPCredential(ctx context.Context, userID uuid.UUID, step int64, recoveryCodeHashes []string, confirmedAt time.Time) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		res, err := tx.ModelContext(ctx, (*totpCredentialDB)(nil)).
+			Set("confirmed_at = ?", confirmedAt).
+			Set("last_used_step = ?", step).
+			Where("user_id = ?", userID).
+			Where("confirmed_at IS NULL").
+			Update()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return model.ErrNotFound
+		}
+		if _, err := tx.ModelContext(ctx, (*recoveryCodeDB)(nil)).Where("user_id = ?", userID).Delete(); err != nil {
+			return err
+		}
+		codes := make([]recoveryCodeDB, len(recoveryCodeHashes))
+		for i, hash := range recoveryCodeHashes {
+			codes[i] = recoveryCodeDB{UserID: userID, CodeHash: hash}
+		}
+		if len(codes) > 0 {
+			if _, err := tx.ModelContext(ctx, &codes).Insert(); err != nil {
+				return err
+			}
+		}
+		_, err = tx.ModelContext(ctx, (*userDB)(nil)).
+			Set("totp_enabled_at = ?", confirmedAt).
+			Set("updated_at = ?", confirmedAt).
+			Where("id = ?", userID).
+			Update()
+		return err
+	})
+}
+
+// UseTOTPStep accepts the code of the given step. It returns model.ErrNotFound if the credential is not confirmed or
+// if a code of the same or of a later step was accepted already.
+func (p *PostgresDB) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	// the condition on last_used_step makes concurrent uses of the same code fail but one
+	res, err := p.db.ModelContext(ctx, (*totpCredentialDB)(nil)).
+		Set("last_used_step = ?", step).
+		Where("user_id = ?", userID).
+		Where("confirmed_at IS NOT NULL").
+		Where("last_used_step < ?", step).
+		Update()
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// UseRecoveryCode consumes the recovery code by digest. It returns model.ErrNotFound if the user has no such unused
+// code.
+func (p *PostgresDB) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) error {
+	res, err := p.db.ModelContext(ctx, (*recoveryCodeDB)(nil)).
+		Set("used_at = ?", p.nowFunc()).
+		Where("user_id = ?", userID).
+		Where("code_hash = ?", hash).
+		Where("used_at IS NULL").
+		Update()
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteTOTPCredential removes the credential of the user along with its recovery codes and disables TOTP on the
+// user. It returns model.ErrNotFound if the user has no credential.
+func (p *PostgresDB) DeleteTOTPCredential(ctx context.Context, userID uuid.UUID) error {
+	return p.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		res, err := tx.ModelContext(ctx, (*totpCredentialDB)(nil)).Where("user_id = ?", userID).Delete()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return model.ErrNotFound
+		}
+		if _, err := tx.ModelContext(ctx, (*recoveryCodeDB)(nil)).Where("user_id = ?", userID).Delete(); err != nil {
+			return err
+		}
+		_, err = tx.ModelContext(ctx, (*userDB)(nil)).
+			Set("totp_enabled_at = NULL").
+			Set("updated_at = ?", p.nowFunc()).
+			Where("id = ?", userID).
+			Where("totp_enabled_at IS NOT NULL").
+			Update()
+		return err
+	})
+}
+
+func toTOTPCredentialDB(credential model.TOTPCredential) *totpCredentialDB {
+	return &totpCredentialDB{
+		UserID:       credential.UserID,
+		Secret:       credential.Secret,
+		CreatedAt:    credential.CreatedAt,
+		ConfirmedAt:  credential.ConfirmedAt,
+		LastUsedStep: credential.LastUsedStep,
+	}
+}
+
+type totpCredentialDB struct {
+	tableName struct{} `pg:"faceittha.totp_credentials"`
+
+	// UserID is the id of the user.
+	UserID uuid.UUID `pg:"user_id,pk,type:uuid"`
+
+	// Secret is the shared secret, sealed with the data key of the user if a vault is configured.
+	Secret string `pg:"secret"`
+
+	// CreatedAt is the time of the enrollment.
+	CreatedAt time.Time `pg:"created_at"`
+
+	// ConfirmedAt is the time at which the enrollment was confirmed. Zero-valued while pending.
+	ConfirmedAt time.Time `pg:"confirmed_at"`
+
+	// LastUsedStep is the time step of the last accepted code.
+	LastUsedStep int64 `pg:"last_used_step,use_zero"`
+}
+
+type recoveryCodeDB struct {
+	tableName struct{} `pg:"faceittha.recovery_codes"`
+
+	// UserID is the id of the user.
+	UserID uuid.UUID `pg:"user_id,pk,type:uuid"`
+
+	// CodeHash is the SHA-256 digest of the normalized code, hex encoded.
+	CodeHash string `pg:"code_hash,pk"`
+
+	// UsedAt is the time at which the code was used. Zero-valued if the code was not used.
+	UsedAt time.Time `pg:"used_at"`
+}
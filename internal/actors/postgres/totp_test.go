@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+func (suite *PostgresDBTestSuite) TestTOTPCredentials() {
+	ctx := context.Background()
+	user := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+
+	_, err := suite.postgresAdapter.GetTOTPCredential(ctx, user.ID)
+	suite.ErrorIs(err, model.ErrNotFound)
+	suite.ErrorIs(suite.postgresAdapter.ConfirmTOTPCredential(ctx, user.ID, 1, nil, dummyTime), model.ErrNotFound)
+
+	// pending enrollments are replaced
+	suite.Require().NoError(suite.postgresAdapter.SaveTOTPCredential(ctx, &model.TOTPCredential{UserID: user.ID, Secret: "FIRST", CreatedAt: dummyTime}))
+	credential := &model.TOTPCredential{UserID: user.ID, Secret: "SECOND", CreatedAt: dummyTime.Add(time.Minute)}
+	suite.Require().NoError(suite.postgresAdapter.SaveTOTPCredential(ctx, credential))
+	got, err := suite.postgresAdapter.GetTOTPCredential(ctx, user.ID)
+	suite.Require().NoError(err)
+	suite.Equal(*credential, *got)
+
+	suite.Require().NoError(suite.postgresAdapter.ConfirmTOTPCredential(ctx, user.ID, 10, []string{"code1", "code2"}, dummyTime))
+	suite.ErrorIs(suite.postgresAdapter.ConfirmTOTPCredential(ctx, user.ID, 11, nil, dummyTime), model.ErrNotFound)
+	suite.ErrorIs(suite.postgresAdapter.SaveTOTPCredential(ctx, credential), model.ErrFailedPrecondition)
+	updated, err := suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: user.ID})
+	suite.Require().NoError(err)
+	suite.True(dummyTime.Equal(updated.TOTPEnabledAt))
+
+	// the steps and the recovery codes are used once
+	suite.ErrorIs(suite.postgresAdapter.UseTOTPStep(ctx, user.ID, 10), model.ErrNotFound)
+	suite.Require().NoError(suite.postgresAdapter.UseTOTPStep(ctx, user.ID, 11))
+	suite.ErrorIs(suite.postgresAdapter.UseTOTPStep(ctx, user.ID, 11), model.ErrNotFound)
+	suite.Require().NoError(suite.postgresAdapter.UseRecoveryCode(ctx, user.ID, "code1"))
+	suite.ErrorIs(suite.postgresAdapter.UseRecoveryCode(ctx, user.ID, "code1"), model.ErrNotFound)
+	suite.ErrorIs(suite.postgresAdapter.UseRecoveryCode(ctx, user.ID, "unknown"), model.ErrNotFound)
+	suite.ErrorIs(suite.postgresAdapter.UseRecoveryCode(ctx, uuid.New(), "code2"), model.ErrNotFound)
+
+	suite.Require().NoError(suite.postgresAdapter.DeleteTOTPCredential(ctx, user.ID))
+	suite.ErrorIs(suite.postgresAdapter.DeleteTOTPCredential(ctx, user.ID), model.ErrNotFound)
+	suite.ErrorIs(suite.postgresAdapter.UseRecoveryCode(ctx, user.ID, "code2"), model.ErrNotFound)
+	updated, err = suite.postgresAdapter.GetUser(ctx, ports.GetUserQuery{ID: user.ID})
+	suite.Require().NoError(err)
+	suite.True(updated.TOTPEnabledAt.IsZero())
+}
+
+func (suite *PostgresDBTestSuite) TestLoginChallenges() {
+	ctx := context.Background()
+	user := &model.User{ID: uuid.New(), Nickname: "jd", Email: "jane@example.com", PasswordHash: "hash"}
+	suite.Require().NoError(suite.postgresAdapter.SaveUser(ctx, user))
+
+	expired := &model.LoginChallenge{Hash: "expired", UserID: user.ID, CreatedAt: dummyTime, ExpiresAt: dummyTime.Add(time.Minute)}
+	suite.Require().NoError(suite.postgresAdapter.SaveLoginChallenge(ctx, expired))
+	challenge := &model.LoginChallenge{Hash: "challenge", UserID: user.ID, CreatedAt: dummyTime.Add(time.Hour), ExpiresAt: dummyTime.Add(2 * time.Hour)}
+	suite.Require().NoError(suite.postgresAdapter.SaveLoginChallenge(ctx, challenge))
+
+	// the expired challenges of the user are purged
+	_, err := suite.postgresAdapter.GetLoginChallenge(ctx, "expired")
+	suite.ErrorIs(err, model.ErrNotFound)
+	got, err := suite.postgresAdapter.GetLoginChallenge(ctx, "challenge")
+	suite.Require().NoError(err)
+	suite.Equal(*challenge, *got)
+
+	suite.Require().NoError(suite.postgresAdapter.DeleteLoginChallenge(ctx, "challenge"))
+	suite.ErrorIs(suite.postgresAdapter.DeleteLoginChallenge(ctx, "challenge"), model.ErrNotFound)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/rbroggi/faceittha/internal/core/model"
 	v1 "github.com/rbroggi/faceittha/pkg/sdk/v1"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -47,6 +48,57 @@ func toProtoEvent(event model.UserEvent) *v1.UserEvent {
 	return &v1.UserEvent{
 		Before: toProtoUser(event.Before),
 		After: toProtoUser(event.After),
+		Erasure: toProtoErasure(event.Erasure),
+		Security: toProtoSecurityEvent(event.Security),
+		Friendship: toProtoFriendshipEvent(event.Friendship),
+		AccountDeletion: toProtoAccountDeletionEvent(event.AccountDeletion),
+	}
+}
+
+func toProtoAccountDeletionEvent(e *model.AccountDeletionEvent) *v1.AccountDeletionEvent {
+	if e == nil {
+		return nil
+	}
+
+	return &v1.AccountDeletionEvent{
+		UserId:       e.UserID.String(),
+		Type:         string(e.Type),
+		ScheduledFor: timestamppb.New(e.ScheduledFor),
+	}
+}
+
+func toProtoFriendshipEvent(e *model.FriendshipEvent) *v1.FriendshipEvent {
+	if e == nil {
+		return nil
+	}
+
+	return &v1.FriendshipEvent{
+		Type:        string(e.Type),
+		RequesterId: e.RequesterID.String(),
+		AddresseeId: e.AddresseeID.String(),
+	}
+}
+
+func toProtoSecurityEvent(e *model.SecurityEvent) *v1.SecurityEvent {
+	if e == nil {
+		return nil
+	}
+
+	return &v1.SecurityEvent{
+		UserId:      e.UserID.String(),
+		Type:        string(e.Type),
+		LockedUntil: timestamppb.New(e.LockedUntil),
+	}
+}
+
+func toProtoErasure(e *model.UserErasure) *v1.UserErasure {
+	if e == nil {
+		return nil
+	}
+
+	return &v1.UserErasure{
+		UserId:   e.UserID.String(),
+		ErasedAt: timestamppb.New(e.ErasedAt),
 	}
 }
 
@@ -55,14 +107,50 @@ func toProtoUser(u *model.User) *v1.User {
 		return nil
 	}
 
-	return &v1.User{
-		Id:       u.ID.String(),
-		FirstName: u.FirstName,
-		LastName:  u.LastName,
-		Nickname:  u.Nickname,
-		Email:     u.Email,
-		Country:   u.Country,
-		CreatedAt: timestamppb.New(u.CreatedAt),
-		UpdatedAt: timestamppb.New(u.UpdatedAt),
+	ret := &v1.User{
+		Id:                u.ID.String(),
+		FirstName:         u.FirstName,
+		LastName:          u.LastName,
+		Nickname:          u.Nickname,
+		Email:             u.Email,
+		Country:           u.Country,
+		CreatedAt:         timestamppb.New(u.CreatedAt),
+		UpdatedAt:         timestamppb.New(u.UpdatedAt),
+		TotpEnabled:       !u.TOTPEnabledAt.IsZero(),
+		Roles:             toProtoRoles(u.Roles),
+		Attributes:        toProtoAttributes(u.Attributes),
+		Status:            string(u.Status),
+		SuspensionReason:  string(u.SuspensionReason),
+		ProfileVisibility: string(u.ProfileVisibility.OrDefault()),
+	}
+	if !u.SuspendedAt.IsZero() {
+		ret.SuspendedAt = timestamppb.New(u.SuspendedAt)
+	}
+	if !u.SuspendedUntil.IsZero() {
+		ret.SuspendedUntil = timestamppb.New(u.SuspendedUntil)
+	}
+	if !u.DeletionScheduledFor.IsZero() {
+		ret.DeletionScheduledFor = timestamppb.New(u.DeletionScheduledFor)
+	}
+	return ret
+}
+
+func toProtoAttributes(attributes map[string]interface{}) *structpb.Struct {
+	if len(attributes) == 0 {
+		return nil
+	}
+	// the attributes are decoded from JSON, they are always representable
+	ret, _ := structpb.NewStruct(attributes)
+	return ret
+}
+
+func toProtoRoles(roles []model.Role) []string {
+	if len(roles) == 0 {
+		return nil
+	}
+	ret := make([]string, len(roles))
+	for i, role := range roles {
+		ret[i] = string(role)
 	}
+	return ret
 }
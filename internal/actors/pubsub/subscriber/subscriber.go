@@ -23,19 +23,29 @@ type SubscriberArgs struct {
 
 	// UserEventHandler is a event handler
 	UserEventHandler ports.UserEventHandler
+
+	// FriendshipEventHandler handles the events of the friendships
+	FriendshipEventHandler ports.FriendshipEventHandler
+
+	// Vault opens the personal data sealed in the CDC payloads
+	Vault ports.Vault
 }
 
 // Subscriber is a pubsub async subscriber
 type Subscriber struct {
-	subscription     *pubsub.Subscription
-	userEventHandler ports.UserEventHandler
+	subscription           *pubsub.Subscription
+	userEventHandler       ports.UserEventHandler
+	friendshipEventHandler ports.FriendshipEventHandler
+	vault                  ports.Vault
 }
 
 // NewSubscriber creates a subscriber
 func NewSubscriber(args SubscriberArgs) *Subscriber {
 	return &Subscriber{
-		subscription:     args.Subscription,
-		userEventHandler: args.UserEventHandler,
+		subscription:           args.Subscription,
+		userEventHandler:       args.UserEventHandler,
+		friendshipEventHandler: args.FriendshipEventHandler,
+		vault:                  args.Vault,
 	}
 }
 
@@ -44,14 +54,18 @@ func NewSubscriber(args SubscriberArgs) *Subscriber {
 func (s *Subscriber) Consume(ctx context.Context) error {
 	if err := s.subscription.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
 
-		userEvent, err := decodeMsgIntoUserEvent(ctx, msg)
+		userEvent, err := s.decodeMsgIntoUserEvent(ctx, msg)
+		if errors.Is(err, ErrIgnoreEvent) {
+			msg.Ack()
+			return
+		}
 		if err != nil {
 			log.WithError(err).Error("error decoding message into user-event")
 			msg.Nack()
 			return
 		}
 
-		if err := s.userEventHandler.Handle(ctx, *userEvent); err != nil {
+		if err := s.handle(ctx, *userEvent); err != nil {
 			log.WithError(err).Error("error in user event handler")
 			msg.Nack()
 		} else {
@@ -63,11 +77,26 @@ func (s *Subscriber) Consume(ctx context.Context) error {
 	return nil
 }
 
+// handle passes the friendship events to the friendship event handler and the other events to the user event handler.
+// The events without a handler are not of interest to the subscription and are ignored.
+func (s *Subscriber) handle(ctx context.Context, userEvent model.UserEvent) error {
+	if userEvent.Friendship != nil {
+		if s.friendshipEventHandler == nil {
+			return nil
+		}
+		return s.friendshipEventHandler.Handle(ctx, userEvent.ID, *userEvent.Friendship)
+	}
+	if s.userEventHandler == nil {
+		return nil
+	}
+	return s.userEventHandler.Handle(ctx, userEvent)
+}
+
 var (
 	ErrIgnoreEvent = errors.New("event should be ignored")
 )
 
-func decodeMsgIntoUserEvent(ctx context.Context, msg *pubsub.Message) (*model.UserEvent, error) {
+func (s *Subscriber) decodeMsgIntoUserEvent(ctx context.Context, msg *pubsub.Message) (*model.UserEvent, error) {
 	if msg == nil {
 		return nil, errors.New("cannot decode nil pubsub msg")
 	}
@@ -76,6 +105,9 @@ func decodeMsgIntoUserEvent(ctx context.Context, msg *pubsub.Message) (*model.Us
 		return nil, fmt.Errorf("json unmarshal error: %w", err)
 	}
 
+	if debeziumMsg.Payload.Source.Table == "friendships" {
+		return decodeFriendshipEvent(msg)
+	}
 	if debeziumMsg.Payload.Source.Table != "users" {
 		return nil, ErrIgnoreEvent
 	}
@@ -93,9 +125,41 @@ func decodeMsgIntoUserEvent(ctx context.Context, msg *pubsub.Message) (*model.Us
 	}
 	userEvent.After = userAfter
 
+	// the data key is already destroyed when the erasure is captured, the erasure is announced without personal data.
+	if userAfter != nil && !userAfter.ErasedAt.IsZero() {
+		if userBefore != nil {
+			userBefore.FirstName, userBefore.LastName, userBefore.Email = "", "", ""
+		}
+		return userEvent, nil
+	}
+
+	if err := s.openUser(ctx, userEvent.Before); err != nil {
+		return nil, err
+	}
+	if err := s.openUser(ctx, userEvent.After); err != nil {
+		return nil, err
+	}
+
 	return userEvent, nil
 }
 
+// openUser decrypts the personal data of the user sealed in the CDC payload. The data key of erased users was destroyed:
+// the events of an erased user that were still in flight when the erasure happened are ignored, as consumers were asked
+// to purge the user.
+func (s *Subscriber) openUser(ctx context.Context, user *model.User) error {
+	if user == nil {
+		return nil
+	}
+	err := s.vault.Open(ctx, user.ID, &user.FirstName, &user.LastName, &user.Email)
+	if errors.Is(err, model.ErrDataKeyDestroyed) {
+		return ErrIgnoreEvent
+	}
+	if err != nil {
+		return fmt.Errorf("error opening user personal data: %w", err)
+	}
+	return nil
+}
+
 func translateUserToModel(dbzUser *debeziumUser) (*model.User, error) {
 	if dbzUser == nil {
 		return nil, nil
@@ -109,24 +173,124 @@ func translateUserToModel(dbzUser *debeziumUser) (*model.User, error) {
 	if dbzUser.DeletedAt != nil {
 		deletedAt = dbzUser.DeletedAt.Time
 	}
+	erasedAt := time.Time{}
+	if dbzUser.ErasedAt != nil {
+		erasedAt = dbzUser.ErasedAt.Time
+	}
+	lockedUntil := time.Time{}
+	if dbzUser.LockedUntil != nil {
+		lockedUntil = dbzUser.LockedUntil.Time
+	}
+	totpEnabledAt := time.Time{}
+	if dbzUser.TOTPEnabledAt != nil {
+		totpEnabledAt = dbzUser.TOTPEnabledAt.Time
+	}
+	suspendedAt := time.Time{}
+	if dbzUser.SuspendedAt != nil {
+		suspendedAt = dbzUser.SuspendedAt.Time
+	}
+	suspendedUntil := time.Time{}
+	if dbzUser.SuspendedUntil != nil {
+		suspendedUntil = dbzUser.SuspendedUntil.Time
+	}
+	verifiedAt := time.Time{}
+	if dbzUser.VerifiedAt != nil {
+		verifiedAt = dbzUser.VerifiedAt.Time
+	}
+	deletionScheduledFor := time.Time{}
+	if dbzUser.DeletionScheduledFor != nil {
+		deletionScheduledFor = dbzUser.DeletionScheduledFor.Time
+	}
+	var suspensionReason model.SuspensionReason
+	if dbzUser.SuspensionReason != nil {
+		suspensionReason = model.SuspensionReason(*dbzUser.SuspensionReason)
+	}
+	var roles []model.Role
+	for _, role := range dbzUser.Roles {
+		roles = append(roles, model.Role(role))
+	}
+	// debezium encodes jsonb columns as JSON text
+	var attributes map[string]interface{}
+	if dbzUser.Attributes != nil {
+		if err := json.Unmarshal([]byte(*dbzUser.Attributes), &attributes); err != nil {
+			return nil, fmt.Errorf("error decoding user attributes: %w", err)
+		}
+		if len(attributes) == 0 {
+			attributes = nil
+		}
+	}
 
 	return &model.User{
-		ID:           id,
-		FirstName:    dbzUser.FirstName,
-		LastName:     dbzUser.LastName,
-		Nickname:     dbzUser.Nickname,
-		Email:        dbzUser.Email,
-		PasswordHash: dbzUser.PasswordHash,
-		Country:      dbzUser.Country,
-		CreatedAt:    dbzUser.CreatedAt.Time,
-		UpdatedAt:    dbzUser.UpdatedAt.Time,
-		DeletedAt:    deletedAt,
+		ID:                   id,
+		FirstName:            dbzUser.FirstName,
+		LastName:             dbzUser.LastName,
+		Nickname:             dbzUser.Nickname,
+		Email:                dbzUser.Email,
+		PasswordHash:         dbzUser.PasswordHash,
+		Country:              dbzUser.Country,
+		CreatedAt:            dbzUser.CreatedAt.Time,
+		UpdatedAt:            dbzUser.UpdatedAt.Time,
+		DeletedAt:            deletedAt,
+		ErasedAt:             erasedAt,
+		LockedUntil:          lockedUntil,
+		TOTPEnabledAt:        totpEnabledAt,
+		Roles:                roles,
+		Attributes:           attributes,
+		SuspensionReason:     suspensionReason,
+		SuspendedAt:          suspendedAt,
+		SuspendedUntil:       suspendedUntil,
+		Status:               model.UserStatus(dbzUser.Status),
+		VerifiedAt:           verifiedAt,
+		DeletionScheduledFor: deletionScheduledFor,
+		ProfileVisibility:    model.ProfileVisibility(dbzUser.ProfileVisibility),
+	}, nil
+}
+
+// decodeFriendshipEvent translates the CDC event of a friendship into the change of the relationship between the
+// users. The updates that are not the acceptance of a friend request are ignored.
+func decodeFriendshipEvent(msg *pubsub.Message) (*model.UserEvent, error) {
+	debeziumMsg := new(debeziumFriendshipMessage)
+	if err := json.Unmarshal(msg.Data, debeziumMsg); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %w", err)
+	}
+	before, after := debeziumMsg.Payload.Before, debeziumMsg.Payload.After
+
+	var eventType model.FriendshipEventType
+	friendship := after
+	switch {
+	case before == nil && after != nil:
+		eventType = model.FriendshipEventRequested
+	case before != nil && after != nil && before.Status == string(model.FriendshipStatusPending) && after.Status == string(model.FriendshipStatusAccepted):
+		eventType = model.FriendshipEventAccepted
+	case before != nil && after == nil && before.Status == string(model.FriendshipStatusPending):
+		eventType, friendship = model.FriendshipEventDeclined, before
+	case before != nil && after == nil:
+		eventType, friendship = model.FriendshipEventRemoved, before
+	default:
+		return nil, ErrIgnoreEvent
+	}
+
+	requesterID, err := uuid.Parse(friendship.RequesterID)
+	if err != nil {
+		return nil, ErrIgnoreEvent
+	}
+	addresseeID, err := uuid.Parse(friendship.AddresseeID)
+	if err != nil {
+		return nil, ErrIgnoreEvent
+	}
+	return &model.UserEvent{
+		ID: msg.ID,
+		Friendship: &model.FriendshipEvent{
+			Type:        eventType,
+			RequesterID: requesterID,
+			AddresseeID: addresseeID,
+		},
 	}, nil
 }
 
 type debeziumMessage struct {
 	// payload is the debezium segment containing the payload.
-	Payload payload `json:"payload`
+	Payload payload `json:"payload"`
 }
 
 type payload struct {
@@ -136,22 +300,47 @@ type payload struct {
 	After  *debeziumUser `json:"after"`
 }
 
+type debeziumFriendshipMessage struct {
+	Payload struct {
+		Before *debeziumFriendship `json:"before"`
+		After  *debeziumFriendship `json:"after"`
+	} `json:"payload"`
+}
+
+type debeziumFriendship struct {
+	RequesterID string `json:"requester_id"`
+	AddresseeID string `json:"addressee_id"`
+	Status      string `json:"status"`
+}
+
 type source struct {
 	Schema string `json:"schema"`
 	Table  string `json:"table"`
 }
 
 type debeziumUser struct {
-	ID           string    `json:"id"`
-	FirstName    string    `json:"first_name"`
-	LastName     string    `json:"last_name"`
-	Nickname     string    `json:"nickname"`
-	Email        string    `json:"email"`
-	PasswordHash string    `json:"password_hash"`
-	Country      string    `json:"country"`
-	CreatedAt    UnixTime `json:"created_at"`
-	UpdatedAt    UnixTime `json:"updated_at"`
-	DeletedAt    *UnixTime `json:"deleted_at"`
+	ID                   string    `json:"id"`
+	FirstName            string    `json:"first_name"`
+	LastName             string    `json:"last_name"`
+	Nickname             string    `json:"nickname"`
+	Email                string    `json:"email"`
+	PasswordHash         string    `json:"password_hash"`
+	Country              string    `json:"country"`
+	CreatedAt            UnixTime  `json:"created_at"`
+	UpdatedAt            UnixTime  `json:"updated_at"`
+	DeletedAt            *UnixTime `json:"deleted_at"`
+	ErasedAt             *UnixTime `json:"erased_at"`
+	LockedUntil          *UnixTime `json:"locked_until"`
+	TOTPEnabledAt        *UnixTime `json:"totp_enabled_at"`
+	Roles                []string  `json:"roles"`
+	Attributes           *string   `json:"attributes"`
+	SuspensionReason     *string   `json:"suspension_reason"`
+	SuspendedAt          *UnixTime `json:"suspended_at"`
+	SuspendedUntil       *UnixTime `json:"suspended_until"`
+	Status               string    `json:"status"`
+	VerifiedAt           *UnixTime `json:"verified_at"`
+	DeletionScheduledFor *UnixTime `json:"deletion_scheduled_for"`
+	ProfileVisibility    string    `json:"profile_visibility"`
 }
 
 // UnixTime is a custom type to allow us to redefine how to unmarshal from microseconds from epoch to time.Time
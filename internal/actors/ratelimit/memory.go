@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// sweepInterval is how often the full buckets are removed from memory.
+const sweepInterval = time.Minute
+
+// NewMemoryStore creates a new MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tats: map[string]time.Time{}, now: time.Now}
+}
+
+// MemoryStore keeps the token buckets in memory, the limits are therefore enforced per replica.
+// A bucket is stored as its theoretical arrival time (see GCRA): the time at which it is full again.
+type MemoryStore struct {
+	mu      sync.Mutex
+	tats    map[string]time.Time
+	sweptAt time.Time
+	now     func() time.Time
+}
+
+// TakeToken takes a token from the bucket identified by key. If the bucket is empty no token is taken and the time to
+// wait until a token is available is returned.
+func (s *MemoryStore) TakeToken(_ context.Context, key string, limit model.RateLimit) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.sweptAt) >= sweepInterval {
+		for k, tat := range s.tats {
+			if tat.Before(now) {
+				delete(s.tats, k)
+			}
+		}
+		s.sweptAt = now
+	}
+
+	tat := s.tats[key]
+	if tat.Before(now) {
+		tat = now
+	}
+	interval := limit.Interval()
+	tat = tat.Add(interval)
+	if allowedAt := tat.Add(-interval * time.Duration(limit.Burst)); allowedAt.After(now) {
+		return allowedAt.Sub(now), nil
+	}
+	s.tats[key] = tat
+	return 0, nil
+}
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore_TakeToken(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limit := model.RateLimit{Rate: 2, Burst: 3}
+
+	// the burst is allowed at once
+	for i := 0; i < limit.Burst; i++ {
+		wait, err := store.TakeToken(ctx, "a", limit)
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	}
+	wait, err := store.TakeToken(ctx, "a", limit)
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Millisecond, wait)
+
+	// rejected calls do not take tokens
+	now = now.Add(250 * time.Millisecond)
+	wait, err = store.TakeToken(ctx, "a", limit)
+	require.NoError(t, err)
+	assert.Equal(t, 250*time.Millisecond, wait)
+
+	// the bucket is refilled at the rate
+	now = now.Add(250 * time.Millisecond)
+	wait, err = store.TakeToken(ctx, "a", limit)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+	wait, err = store.TakeToken(ctx, "a", limit)
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Millisecond, wait)
+
+	// the buckets are independent
+	wait, err = store.TakeToken(ctx, "b", limit)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestMemoryStore_SweepsFullBuckets(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limit := model.RateLimit{Rate: 1, Burst: 1}
+
+	_, err := store.TakeToken(ctx, "a", limit)
+	require.NoError(t, err)
+	now = now.Add(sweepInterval)
+	_, err = store.TakeToken(ctx, "b", limit)
+	require.NoError(t, err)
+
+	assert.NotContains(t, store.tats, "a")
+	assert.Contains(t, store.tats, "b")
+}
@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// Ed25519Algorithm is the name of the algorithm used by the Ed25519Signer.
+const Ed25519Algorithm = "Ed25519"
+
+// Ed25519SignerArgs are the mandatory arguments for the creation of an Ed25519Signer.
+type Ed25519SignerArgs struct {
+	// PrivateKey is the key used to sign payloads.
+	PrivateKey ed25519.PrivateKey
+}
+
+// NewEd25519Signer creates a new Ed25519Signer. The key id is derived from the public key fingerprint.
+func NewEd25519Signer(args Ed25519SignerArgs) (*Ed25519Signer, error) {
+	if len(args.PrivateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid ed25519 private key")
+	}
+	fingerprint := sha256.Sum256(args.PrivateKey.Public().(ed25519.PublicKey))
+	return &Ed25519Signer{
+		privateKey: args.PrivateKey,
+		keyID:      hex.EncodeToString(fingerprint[:8]),
+	}, nil
+}
+
+// Ed25519Signer produces Ed25519 detached signatures.
+type Ed25519Signer struct {
+	privateKey ed25519.PrivateKey
+	keyID      string
+}
+
+// Sign signs the payload.
+func (s *Ed25519Signer) Sign(_ context.Context, payload []byte) (*model.Signature, error) {
+	return &model.Signature{
+		Algorithm: Ed25519Algorithm,
+		KeyID:     s.keyID,
+		Value:     ed25519.Sign(s.privateKey, payload),
+	}, nil
+}
+
+// LoadEd25519PrivateKey reads a PEM encoded PKCS #8 ed25519 private key from a file.
+// Such a key can be generated with `openssl genpkey -algorithm ed25519`.
+func LoadEd25519PrivateKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading private key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found in private key file")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private key: %w", err)
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an ed25519 key")
+	}
+	return edKey, nil
+}
@@ -0,0 +1,205 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultReloadInterval is how often, at most, the key pair files are checked for changes.
+const defaultReloadInterval = 10 * time.Second
+
+// KeyPairOption is an optional setting of the KeyPair.
+type KeyPairOption func(*KeyPair)
+
+// WithReloadInterval sets how often, at most, the key pair files are checked for changes.
+func WithReloadInterval(interval time.Duration) KeyPairOption {
+	return func(k *KeyPair) {
+		k.reloadInterval = interval
+	}
+}
+
+// NewKeyPair loads the PEM encoded certificate chain and private key from the files. The files are checked for
+// changes during the handshakes, so that renewed certificates are served without restarting the process.
+func NewKeyPair(certFile, keyFile string, opts ...KeyPairOption) (*KeyPair, error) {
+	k := &KeyPair{certFile: certFile, keyFile: keyFile, reloadInterval: defaultReloadInterval}
+	for _, opt := range opts {
+		opt(k)
+	}
+	modTime, err := k.modTime()
+	if err != nil {
+		return nil, err
+	}
+	if err := k.load(modTime); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// KeyPair is a certificate and its private key kept in sync with the files they are read from.
+type KeyPair struct {
+	certFile       string
+	keyFile        string
+	reloadInterval time.Duration
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	loadedAt  time.Time
+	checkedAt time.Time
+}
+
+// GetCertificate serves the key pair to the clients, see tls.Config.GetCertificate.
+func (k *KeyPair) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return k.Certificate(), nil
+}
+
+// GetClientCertificate serves the key pair to the servers, see tls.Config.GetClientCertificate.
+func (k *KeyPair) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return k.Certificate(), nil
+}
+
+// Certificate returns the current key pair, reloading it if the files changed. If the new files cannot be loaded, for
+// instance because only one of them was written so far, the previous key pair is kept.
+func (k *KeyPair) Certificate() *tls.Certificate {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if time.Since(k.checkedAt) < k.reloadInterval {
+		return k.cert
+	}
+	k.checkedAt = time.Now()
+	modTime, err := k.modTime()
+	if err == nil && !modTime.Equal(k.loadedAt) {
+		err = k.load(modTime)
+	}
+	if err != nil {
+		log.WithError(err).WithField("cert-file", k.certFile).Warn("error reloading key pair, keeping the previous one")
+	}
+	return k.cert
+}
+
+// load reads the key pair, k.mu must be held by the caller.
+func (k *KeyPair) load(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(k.certFile, k.keyFile)
+	if err != nil {
+		return fmt.Errorf("error loading key pair: %w", err)
+	}
+	k.cert = &cert
+	k.loadedAt = modTime
+	k.checkedAt = time.Now()
+	return nil
+}
+
+// modTime is the latest modification time of the key pair files.
+func (k *KeyPair) modTime() (time.Time, error) {
+	var latest time.Time
+	for _, file := range []string{k.certFile, k.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("error reading key pair file: %w", err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// LoadCertPool reads a bundle of PEM encoded CA certificates.
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificate found in CA file %s", file)
+	}
+	return pool, nil
+}
+
+// ServerConfigArgs are the args to build the TLS configuration of a listener.
+type ServerConfigArgs struct {
+	// CertFile and KeyFile hold the certificate of the server. Mandatory.
+	CertFile string
+	KeyFile  string
+
+	// ClientCAFile holds the CAs the client certificates are verified against. Optional, client certificates are not
+	// requested when empty.
+	ClientCAFile string
+
+	// RequireClientCert rejects the clients without a certificate when ClientCAFile is set. Otherwise, the
+	// certificates are only verified when presented.
+	RequireClientCert bool
+}
+
+// NewServerConfig builds the TLS configuration of a listener. The server certificate is reloaded from disk on change,
+// the client CAs are only read once.
+func NewServerConfig(args ServerConfigArgs) (*tls.Config, error) {
+	if args.CertFile == "" || args.KeyFile == "" {
+		return nil, errors.New("both the certificate and the key files are mandatory")
+	}
+	keyPair, err := NewKeyPair(args.CertFile, args.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: keyPair.GetCertificate,
+	}
+	if args.ClientCAFile != "" {
+		config.ClientCAs, err = LoadCertPool(args.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+		if args.RequireClientCert {
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return config, nil
+}
+
+// ClientConfigArgs are the args to build the TLS configuration of a client.
+type ClientConfigArgs struct {
+	// CertFile and KeyFile hold the client certificate presented to the servers requesting one. Optional.
+	CertFile string
+	KeyFile  string
+
+	// RootCAFile holds the CAs the server certificates are verified against. Optional, the system roots are used
+	// when empty.
+	RootCAFile string
+
+	// ServerName is the name the server certificate is verified against. Optional, it is derived from the dialed
+	// address when empty.
+	ServerName string
+}
+
+// NewClientConfig builds the TLS configuration of a client. The client certificate is reloaded from disk on change,
+// the root CAs are only read once.
+func NewClientConfig(args ClientConfigArgs) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: args.ServerName,
+	}
+	if args.CertFile != "" || args.KeyFile != "" {
+		keyPair, err := NewKeyPair(args.CertFile, args.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = keyPair.GetClientCertificate
+	}
+	if args.RootCAFile != "" {
+		pool, err := LoadCertPool(args.RootCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
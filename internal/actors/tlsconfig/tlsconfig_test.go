@@ -0,0 +1,218 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCA issues the certificates of the tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "faceittha test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a key pair for the common name valid for localhost in dir and returns the paths of the files.
+func (ca *testCA) issue(t *testing.T, dir, commonName string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, commonName+".crt"), filepath.Join(dir, commonName+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+func commonName(t *testing.T, cert *tls.Certificate) string {
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestKeyPair_ReloadsOnChange(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	certFile, keyFile := ca.issue(t, dir, "first")
+
+	keyPair, err := NewKeyPair(certFile, keyFile, WithReloadInterval(0))
+	require.NoError(t, err)
+	assert.Equal(t, "first", commonName(t, keyPair.Certificate()))
+
+	// a renewed key pair is written over the previous one
+	renewedCert, renewedKey := ca.issue(t, dir, "renewed")
+	require.NoError(t, os.Rename(renewedCert, certFile))
+	require.NoError(t, os.Rename(renewedKey, keyFile))
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, future, future))
+	assert.Equal(t, "renewed", commonName(t, keyPair.Certificate()))
+
+	// a broken key pair is ignored
+	require.NoError(t, os.WriteFile(keyFile, []byte("garbage"), 0o600))
+	future = future.Add(time.Minute)
+	require.NoError(t, os.Chtimes(keyFile, future, future))
+	assert.Equal(t, "renewed", commonName(t, keyPair.Certificate()))
+}
+
+func TestKeyPair_ThrottlesChecks(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	certFile, keyFile := ca.issue(t, dir, "first")
+
+	keyPair, err := NewKeyPair(certFile, keyFile, WithReloadInterval(time.Hour))
+	require.NoError(t, err)
+
+	renewedCert, renewedKey := ca.issue(t, dir, "renewed")
+	require.NoError(t, os.Rename(renewedCert, certFile))
+	require.NoError(t, os.Rename(renewedKey, keyFile))
+	assert.Equal(t, "first", commonName(t, keyPair.Certificate()))
+}
+
+func TestNewKeyPair_MissingFiles(t *testing.T) {
+	_, err := NewKeyPair(filepath.Join(t.TempDir(), "missing.crt"), filepath.Join(t.TempDir(), "missing.key"))
+	assert.Error(t, err)
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, ca.pem, 0o600))
+	serverCert, serverKey := ca.issue(t, dir, "server")
+	clientCert, clientKey := ca.issue(t, dir, "client")
+	otherCA := newTestCA(t)
+	strangerCert, strangerKey := otherCA.issue(t, dir, "stranger")
+
+	tests := []struct {
+		name              string
+		requireClientCert bool
+		client            ClientConfigArgs
+		wantErr           bool
+		wantPeer          string
+	}{
+		{
+			name:              "verified client certificate",
+			requireClientCert: true,
+			client:            ClientConfigArgs{CertFile: clientCert, KeyFile: clientKey, RootCAFile: caFile},
+			wantPeer:          "client",
+		},
+		{
+			name:              "missing client certificate",
+			requireClientCert: true,
+			client:            ClientConfigArgs{RootCAFile: caFile},
+			wantErr:           true,
+		},
+		{
+			name:   "optional client certificate",
+			client: ClientConfigArgs{RootCAFile: caFile},
+		},
+		{
+			name:    "client certificate of an unknown CA",
+			client:  ClientConfigArgs{CertFile: strangerCert, KeyFile: strangerKey, RootCAFile: caFile},
+			wantErr: true,
+		},
+		{
+			name:    "server of an unknown CA",
+			client:  ClientConfigArgs{CertFile: clientCert, KeyFile: clientKey},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverConfig, err := NewServerConfig(ServerConfigArgs{
+				CertFile:          serverCert,
+				KeyFile:           serverKey,
+				ClientCAFile:      caFile,
+				RequireClientCert: tt.requireClientCert,
+			})
+			require.NoError(t, err)
+			tt.client.ServerName = "localhost"
+			clientConfig, err := NewClientConfig(tt.client)
+			require.NoError(t, err)
+
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer lis.Close()
+			serverErr := make(chan error, 1)
+			var server *tls.Conn
+			go func() {
+				conn, err := lis.Accept()
+				if err != nil {
+					serverErr <- err
+					return
+				}
+				server = tls.Server(conn, serverConfig)
+				serverErr <- server.Handshake()
+			}()
+			conn, err := net.Dial("tcp", lis.Addr().String())
+			require.NoError(t, err)
+			defer conn.Close()
+			client := tls.Client(conn, clientConfig)
+			clientErr := client.Handshake()
+			if clientErr != nil {
+				// unblock the server waiting for the client
+				conn.Close()
+			}
+			err = <-serverErr
+
+			if tt.wantErr {
+				assert.True(t, err != nil || clientErr != nil)
+				return
+			}
+			require.NoError(t, clientErr)
+			require.NoError(t, err)
+			if tt.wantPeer != "" {
+				require.NotEmpty(t, server.ConnectionState().VerifiedChains)
+				assert.Equal(t, tt.wantPeer, server.ConnectionState().VerifiedChains[0][0].Subject.CommonName)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package vault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// sealedPrefix marks the values sealed by the vault. Values without the prefix were stored before encryption was
+// introduced and are returned as they are.
+const sealedPrefix = "enc:v1:"
+
+// keySize is the size of both the master key and the data keys (AES-256).
+const keySize = 32
+
+// VaultArgs are the mandatory arguments for the creation of a Vault.
+type VaultArgs struct {
+	// Repository stores the wrapped data keys.
+	Repository ports.DataKeyRepository
+
+	// MasterKey is the 32 bytes AES key used to wrap the data keys.
+	MasterKey []byte
+}
+
+// NewVault creates a new Vault.
+func NewVault(args VaultArgs) (*Vault, error) {
+	if args.Repository == nil {
+		return nil, errors.New("data key repository is nil")
+	}
+	if len(args.MasterKey) != keySize {
+		return nil, fmt.Errorf("master key must be %d bytes long", keySize)
+	}
+	master, err := newAEAD(args.MasterKey)
+	if err != nil {
+		return nil, err
+	}
+	// the index key is derived from the master key so that the blind indexes are not rotated with the data keys.
+	indexKey := hmac.New(sha256.New, args.MasterKey)
+	indexKey.Write([]byte("faceittha blind index"))
+	return &Vault{repository: args.Repository, master: master, indexKey: indexKey.Sum(nil)}, nil
+}
+
+// Vault implements envelope encryption: every user has its own AES-256-GCM data key, stored wrapped by the master key.
+type Vault struct {
+	repository ports.DataKeyRepository
+	master     cipher.AEAD
+	indexKey   []byte
+}
+
+// Seal encrypts in place the values with the data key of the user, creating the key if needed.
+func (v *Vault) Seal(ctx context.Context, userID uuid.UUID, values ...*string) error {
+	if !anyValue(values, func(s string) bool { return s != "" && !strings.HasPrefix(s, sealedPrefix) }) {
+		return nil
+	}
+	aead, err := v.dataKey(ctx, userID, true)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		if *value == "" || strings.HasPrefix(*value, sealedPrefix) {
+			continue
+		}
+		nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(*value)+aead.Overhead())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return fmt.Errorf("error generating nonce: %w", err)
+		}
+		// the user id is authenticated with the value so that values cannot be swapped between users.
+		sealed := aead.Seal(nonce, nonce, []byte(*value), userID[:])
+		*value = sealedPrefix + base64.RawStdEncoding.EncodeToString(sealed)
+	}
+	return nil
+}
+
+// Open decrypts in place the values sealed with the data key of the user.
+func (v *Vault) Open(ctx context.Context, userID uuid.UUID, values ...*string) error {
+	if !anyValue(values, func(s string) bool { return strings.HasPrefix(s, sealedPrefix) }) {
+		return nil
+	}
+	aead, err := v.dataKey(ctx, userID, false)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		if !strings.HasPrefix(*value, sealedPrefix) {
+			continue
+		}
+		sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(*value, sealedPrefix))
+		if err != nil {
+			return fmt.Errorf("error decoding sealed value: %w", err)
+		}
+		if len(sealed) < aead.NonceSize() {
+			return errors.New("sealed value is too short")
+		}
+		plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], userID[:])
+		if err != nil {
+			return fmt.Errorf("error decrypting sealed value: %w", err)
+		}
+		*value = string(plain)
+	}
+	return nil
+}
+
+// BlindIndex returns the HMAC-SHA256 of the value, hex encoded.
+func (v *Vault) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, v.indexKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// DestroyKey irreversibly destroys the data key of the user.
+func (v *Vault) DestroyKey(ctx context.Context, userID uuid.UUID) error {
+	if err := v.repository.DestroyDataKey(ctx, userID); err != nil {
+		return fmt.Errorf("error destroying data key: %w", err)
+	}
+	return nil
+}
+
+// dataKey returns the cipher of the user data key. If create is set, the key is generated when the user has none.
+// A user without a key is treated as having a destroyed one otherwise, since nothing could have been sealed for them.
+func (v *Vault) dataKey(ctx context.Context, userID uuid.UUID, create bool) (cipher.AEAD, error) {
+	key, err := v.repository.GetDataKey(ctx, userID)
+	if errors.Is(err, model.ErrNotFound) && create {
+		if err := v.createDataKey(ctx, userID); err != nil {
+			return nil, err
+		}
+		// read the key back as a concurrent creation may have won the race.
+		key, err = v.repository.GetDataKey(ctx, userID)
+	}
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, model.ErrDataKeyDestroyed
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting data key: %w", err)
+	}
+	if !key.DestroyedAt.IsZero() {
+		return nil, model.ErrDataKeyDestroyed
+	}
+
+	if len(key.WrappedKey) < v.master.NonceSize() {
+		return nil, errors.New("wrapped data key is too short")
+	}
+	nonce, wrapped := key.WrappedKey[:v.master.NonceSize()], key.WrappedKey[v.master.NonceSize():]
+	plain, err := v.master.Open(nil, nonce, wrapped, userID[:])
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping data key: %w", err)
+	}
+	return newAEAD(plain)
+}
+
+func (v *Vault) createDataKey(ctx context.Context, userID uuid.UUID) error {
+	plain := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, plain); err != nil {
+		return fmt.Errorf("error generating data key: %w", err)
+	}
+	nonce := make([]byte, v.master.NonceSize(), v.master.NonceSize()+keySize+v.master.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return fmt.Errorf("error generating nonce: %w", err)
+	}
+	if err := v.repository.SaveDataKey(ctx, &model.DataKey{
+		UserID:     userID,
+		WrappedKey: v.master.Seal(nonce, nonce, plain, userID[:]),
+	}); err != nil {
+		return fmt.Errorf("error saving data key: %w", err)
+	}
+	return nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating block cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func anyValue(values []*string, predicate func(string) bool) bool {
+	for _, value := range values {
+		if predicate(*value) {
+			return true
+		}
+	}
+	return false
+}
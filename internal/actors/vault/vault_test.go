@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDataKeyRepository is an in-memory implementation of the DataKeyRepository interface.
+type MockDataKeyRepository struct {
+	keys map[uuid.UUID]model.DataKey
+}
+
+func (m *MockDataKeyRepository) GetDataKey(ctx context.Context, userID uuid.UUID) (*model.DataKey, error) {
+	key, ok := m.keys[userID]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return &key, nil
+}
+
+func (m *MockDataKeyRepository) SaveDataKey(ctx context.Context, key *model.DataKey) error {
+	if _, ok := m.keys[key.UserID]; !ok {
+		m.keys[key.UserID] = *key
+	}
+	return nil
+}
+
+func (m *MockDataKeyRepository) DestroyDataKey(ctx context.Context, userID uuid.UUID) error {
+	m.keys[userID] = model.DataKey{UserID: userID, DestroyedAt: time.Now()}
+	return nil
+}
+
+func newTestVault(t *testing.T) *Vault {
+	v, err := NewVault(VaultArgs{
+		Repository: &MockDataKeyRepository{keys: map[uuid.UUID]model.DataKey{}},
+		MasterKey:  []byte(strings.Repeat("k", keySize)),
+	})
+	require.NoError(t, err)
+	return v
+}
+
+func TestVault_SealOpen(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+	userID := uuid.New()
+
+	firstName, email, empty := "Jane", "jane@example.com", ""
+	require.NoError(t, v.Seal(ctx, userID, &firstName, &email, &empty))
+	assert.True(t, strings.HasPrefix(firstName, sealedPrefix))
+	assert.True(t, strings.HasPrefix(email, sealedPrefix))
+	assert.Empty(t, empty)
+
+	// sealing twice is a no-op
+	sealedFirstName := firstName
+	require.NoError(t, v.Seal(ctx, userID, &firstName))
+	assert.Equal(t, sealedFirstName, firstName)
+
+	legacy := "stored before encryption"
+	require.NoError(t, v.Open(ctx, userID, &firstName, &email, &empty, &legacy))
+	assert.Equal(t, "Jane", firstName)
+	assert.Equal(t, "jane@example.com", email)
+	assert.Empty(t, empty)
+	assert.Equal(t, "stored before encryption", legacy)
+}
+
+func TestVault_ValuesAreBoundToTheUser(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+	userID, otherUserID := uuid.New(), uuid.New()
+
+	value, other := "Jane", "John"
+	require.NoError(t, v.Seal(ctx, userID, &value))
+	require.NoError(t, v.Seal(ctx, otherUserID, &other))
+	assert.Error(t, v.Open(ctx, otherUserID, &value))
+}
+
+func TestVault_DestroyKey(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+	userID := uuid.New()
+
+	value := "Jane"
+	require.NoError(t, v.Seal(ctx, userID, &value))
+	require.NoError(t, v.DestroyKey(ctx, userID))
+
+	sealed := value
+	assert.ErrorIs(t, v.Open(ctx, userID, &value), model.ErrDataKeyDestroyed)
+	assert.Equal(t, sealed, value)
+
+	another := "Doe"
+	assert.ErrorIs(t, v.Seal(ctx, userID, &another), model.ErrDataKeyDestroyed)
+}
+
+func TestVault_BlindIndex(t *testing.T) {
+	v := newTestVault(t)
+	index := v.BlindIndex("jane@example.com")
+	assert.Equal(t, index, v.BlindIndex("jane@example.com"))
+	assert.NotEqual(t, index, v.BlindIndex("john@example.com"))
+	assert.NotContains(t, index, "jane")
+
+	// the indexes depend on the master key
+	other, err := NewVault(VaultArgs{
+		Repository: &MockDataKeyRepository{keys: map[uuid.UUID]model.DataKey{}},
+		MasterKey:  []byte(strings.Repeat("o", keySize)),
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, index, other.BlindIndex("jane@example.com"))
+}
@@ -0,0 +1,154 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// Operation identifies a user operation subject to authorization.
+type Operation string
+
+// The operations of the user service. Some RPCs map to different operations depending on their arguments, e.g. a
+// hard-deletion is a distinct operation from a soft-deletion.
+const (
+	OperationCreateUser        Operation = "CreateUser"
+	OperationGetUser           Operation = "GetUser"
+	OperationGetDeletedUser    Operation = "GetDeletedUser"
+	OperationGetUserHistory    Operation = "GetUserHistory"
+	OperationListUsers         Operation = "ListUsers"
+	OperationListDeletedUsers  Operation = "ListDeletedUsers"
+	OperationUpdateUser        Operation = "UpdateUser"
+	OperationChangePassword    Operation = "ChangePassword"
+	OperationSoftDeleteUser    Operation = "SoftDeleteUser"
+	OperationHardDeleteUser    Operation = "HardDeleteUser"
+	OperationRestoreUser       Operation = "RestoreUser"
+	OperationExportUserData    Operation = "ExportUserData"
+	OperationEraseUser         Operation = "EraseUser"
+	OperationListAuditEntries  Operation = "ListAuditEntries"
+	OperationUnlockUser        Operation = "UnlockUser"
+	OperationListSessions      Operation = "ListSessions"
+	OperationRevokeSession     Operation = "RevokeSession"
+	OperationEnrollTOTP        Operation = "EnrollTOTP"
+	OperationConfirmTOTP       Operation = "ConfirmTOTP"
+	OperationDisableTOTP       Operation = "DisableTOTP"
+	OperationCreateAPIKey      Operation = "CreateAPIKey"
+	OperationListAPIKeys       Operation = "ListAPIKeys"
+	OperationRevokeAPIKey      Operation = "RevokeAPIKey"
+	OperationGrantRole         Operation = "GrantRole"
+	OperationRevokeRole        Operation = "RevokeRole"
+	OperationRequestFriendship Operation = "RequestFriendship"
+	OperationAcceptFriendship  Operation = "AcceptFriendship"
+	OperationDeclineFriendship Operation = "DeclineFriendship"
+	OperationRemoveFriend      Operation = "RemoveFriend"
+	OperationListFriends       Operation = "ListFriends"
+	OperationBlockUser         Operation = "BlockUser"
+	OperationUnblockUser       Operation = "UnblockUser"
+	OperationListBlockedUsers  Operation = "ListBlockedUsers"
+	OperationIsBlocked         Operation = "IsBlocked"
+	OperationSuspendUser       Operation = "SuspendUser"
+	OperationLiftSuspension    Operation = "LiftSuspension"
+	OperationListSuspensions   Operation = "ListSuspensions"
+	OperationVerifyUser        Operation = "VerifyUser"
+	OperationRequestDeletion   Operation = "RequestAccountDeletion"
+	OperationCancelDeletion    Operation = "CancelAccountDeletion"
+	OperationGetNicknames      Operation = "GetNicknameHistory"
+	OperationCheckNickname     Operation = "CheckNicknameAvailability"
+	OperationGetPublicProfile  Operation = "GetPublicProfile"
+)
+
+// Policy declares which actors are allowed to perform an operation.
+type Policy struct {
+	// Authenticated allows every authenticated actor.
+	Authenticated bool
+
+	// Self allows the actor to perform the operation on their own user.
+	Self bool
+
+	// Roles are the roles allowed to perform the operation on any user.
+	Roles []model.Role
+}
+
+// policies are the policies of the user operations. Operations without a policy are denied to everyone.
+var policies = map[Operation]Policy{
+	// the accounts are created by the signup flow, the users do not create accounts for others.
+	OperationCreateUser:       {Roles: []model.Role{model.RoleSignup, model.RoleAdmin}},
+	OperationGetUser:          {Self: true, Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	OperationGetDeletedUser:   {Roles: []model.Role{model.RoleAdmin}},
+	OperationGetUserHistory:   {Self: true, Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	OperationListUsers:        {Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	OperationListDeletedUsers: {Roles: []model.Role{model.RoleAdmin}},
+	OperationUpdateUser:       {Self: true, Roles: []model.Role{model.RoleAdmin}},
+	OperationChangePassword:   {Self: true, Roles: []model.Role{model.RoleAdmin}},
+	// the users delete their own account through RequestAccountDeletion, which enforces the grace period.
+	OperationSoftDeleteUser: {Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	OperationHardDeleteUser: {Roles: []model.Role{model.RoleAdmin}},
+	OperationRestoreUser:    {Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	// the usecase restricts the exports to the user themselves and admins, auditing the denied attempts.
+	OperationExportUserData:    {Authenticated: true},
+	OperationEraseUser:         {Roles: []model.Role{model.RoleAdmin}},
+	OperationListAuditEntries:  {Self: true, Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	OperationUnlockUser:        {Roles: []model.Role{model.RoleAdmin}},
+	OperationListSessions:      {Self: true, Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	OperationRevokeSession:     {Self: true, Roles: []model.Role{model.RoleAdmin}},
+	OperationEnrollTOTP:        {Self: true},
+	OperationConfirmTOTP:       {Self: true},
+	OperationDisableTOTP:       {Self: true, Roles: []model.Role{model.RoleAdmin}},
+	OperationCreateAPIKey:      {Roles: []model.Role{model.RoleAdmin}},
+	OperationListAPIKeys:       {Roles: []model.Role{model.RoleAdmin}},
+	OperationRevokeAPIKey:      {Roles: []model.Role{model.RoleAdmin}},
+	OperationGrantRole:         {Roles: []model.Role{model.RoleAdmin}},
+	OperationRevokeRole:        {Roles: []model.Role{model.RoleAdmin}},
+	OperationRequestFriendship: {Self: true},
+	OperationAcceptFriendship:  {Self: true},
+	OperationDeclineFriendship: {Self: true},
+	OperationRemoveFriend:      {Self: true, Roles: []model.Role{model.RoleAdmin}},
+	OperationListFriends:       {Self: true, Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	OperationBlockUser:         {Self: true},
+	OperationUnblockUser:       {Self: true, Roles: []model.Role{model.RoleAdmin}},
+	OperationListBlockedUsers:  {Self: true, Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	// users do not check the blocks of others, the chat and matchmaking services do with the block_check role.
+	OperationIsBlocked: {Roles: []model.Role{model.RoleBlockCheck, model.RoleSupport, model.RoleAdmin}},
+	// the anti-cheat suspends the users with the suspension role, the support staff moderates them.
+	OperationSuspendUser:     {Roles: []model.Role{model.RoleSuspension, model.RoleSupport, model.RoleAdmin}},
+	OperationLiftSuspension:  {Roles: []model.Role{model.RoleSuspension, model.RoleSupport, model.RoleAdmin}},
+	OperationListSuspensions: {Roles: []model.Role{model.RoleSuspension, model.RoleSupport, model.RoleAdmin}},
+	// the service sending the verification emails confirms them with the verification role.
+	OperationVerifyUser: {Roles: []model.Role{model.RoleVerification, model.RoleSupport, model.RoleAdmin}},
+	// the deletion is the decision of the user alone, the support can help them back within the grace period.
+	OperationRequestDeletion: {Self: true},
+	OperationCancelDeletion:  {Self: true, Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	// the nickname history serves the moderators.
+	OperationGetNicknames: {Roles: []model.Role{model.RoleSupport, model.RoleAdmin}},
+	// the nicknames are checked on signup, by the callers creating the users.
+	OperationCheckNickname: {Authenticated: true},
+	// the usecase hides the profiles according to the blocks and the privacy settings of the users.
+	OperationGetPublicProfile: {Authenticated: true},
+}
+
+// Authorize checks that the actor carried by ctx is allowed to perform the operation on the user identified by target.
+// target is uuid.Nil for operations that do not refer to a single user. It returns model.ErrUnauthenticated if ctx
+// carries no actor and model.ErrPermissionDenied if the actor is not allowed.
+func Authorize(ctx context.Context, operation Operation, target uuid.UUID) error {
+	actor, ok := model.ActorFromContext(ctx)
+	if !ok {
+		return model.ErrUnauthenticated
+	}
+	policy, ok := policies[operation]
+	if !ok {
+		return model.ErrPermissionDenied
+	}
+	if policy.Authenticated {
+		return nil
+	}
+	if policy.Self && target != uuid.Nil && actor.IsUser(target) {
+		return nil
+	}
+	for _, role := range policy.Roles {
+		if actor.HasRole(role) {
+			return nil
+		}
+	}
+	return model.ErrPermissionDenied
+}
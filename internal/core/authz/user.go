@@ -0,0 +1,486 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// UserServiceArgs are the mandatory args to instantiate the UserService.
+type UserServiceArgs struct {
+	// Usecase is the usecase the authorized calls are delegated to.
+	Usecase userService
+
+	// FieldPolicy lists the roles granted the personal fields of the users returned by the calls and exported.
+	// model.DefaultFieldPolicy if nil.
+	FieldPolicy model.FieldPolicy
+}
+
+// NewUserService creates a new UserService.
+func NewUserService(args UserServiceArgs) *UserService {
+	fieldPolicy := args.FieldPolicy
+	if fieldPolicy == nil {
+		fieldPolicy = model.DefaultFieldPolicy
+	}
+	return &UserService{usecase: args.Usecase, fieldPolicy: fieldPolicy}
+}
+
+// UserService enforces the authorization policies in front of the user usecase. It shapes every user it returns for
+// the caller, see model.FieldPolicy.Redact.
+type UserService struct {
+	usecase     userService
+	fieldPolicy model.FieldPolicy
+}
+
+// CreateUser creates a user.
+func (s *UserService) CreateUser(ctx context.Context, args model.CreateUserArgs) (*model.CreateUserResponse, error) {
+	if err := Authorize(ctx, OperationCreateUser, uuid.Nil); err != nil {
+		return nil, err
+	}
+	resp, err := s.usecase.CreateUser(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	s.redactUser(ctx, &resp.User)
+	return resp, nil
+}
+
+// UpdateUser updates a user.
+func (s *UserService) UpdateUser(ctx context.Context, args model.UpdateUserArgs) (*model.UpdateUserResponse, error) {
+	if err := Authorize(ctx, OperationUpdateUser, args.ID); err != nil {
+		return nil, err
+	}
+	resp, err := s.usecase.UpdateUser(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	s.redactUser(ctx, &resp.User)
+	return resp, nil
+}
+
+// ChangePassword changes the password of a user.
+func (s *UserService) ChangePassword(ctx context.Context, args model.ChangePasswordArgs) error {
+	if err := Authorize(ctx, OperationChangePassword, args.ID); err != nil {
+		return err
+	}
+	return s.usecase.ChangePassword(ctx, args)
+}
+
+// ListUsers lists users.
+func (s *UserService) ListUsers(ctx context.Context, args model.ListUsersArgs) (*model.ListUsersResponse, error) {
+	operation := OperationListUsers
+	if args.IncludeDeleted {
+		operation = OperationListDeletedUsers
+	}
+	if err := Authorize(ctx, operation, uuid.Nil); err != nil {
+		return nil, err
+	}
+	resp, err := s.usecase.ListUsers(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp.Users {
+		s.redactUser(ctx, &resp.Users[i])
+	}
+	return resp, nil
+}
+
+// GetUser gets a user.
+func (s *UserService) GetUser(ctx context.Context, args model.GetUserArgs) (*model.GetUserResponse, error) {
+	operation := OperationGetUser
+	if args.IncludeDeleted {
+		operation = OperationGetDeletedUser
+	}
+	if err := Authorize(ctx, operation, args.ID); err != nil {
+		return nil, err
+	}
+	resp, err := s.usecase.GetUser(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	s.redactUser(ctx, &resp.User)
+	return resp, nil
+}
+
+// GetUserHistory lists the versions of a user.
+func (s *UserService) GetUserHistory(ctx context.Context, args model.GetUserHistoryArgs) (*model.GetUserHistoryResponse, error) {
+	if err := Authorize(ctx, OperationGetUserHistory, args.UserID); err != nil {
+		return nil, err
+	}
+	resp, err := s.usecase.GetUserHistory(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp.Versions {
+		s.redactUser(ctx, &resp.Versions[i].User)
+	}
+	return resp, nil
+}
+
+// DeleteUser deletes a user.
+func (s *UserService) DeleteUser(ctx context.Context, args model.DeleteUserArgs) error {
+	operation := OperationSoftDeleteUser
+	if args.HardDelete {
+		operation = OperationHardDeleteUser
+	}
+	if err := Authorize(ctx, operation, args.ID); err != nil {
+		return err
+	}
+	return s.usecase.DeleteUser(ctx, args)
+}
+
+// RestoreUser restores a soft-deleted user.
+func (s *UserService) RestoreUser(ctx context.Context, args model.RestoreUserArgs) (*model.RestoreUserResponse, error) {
+	if err := Authorize(ctx, OperationRestoreUser, args.ID); err != nil {
+		return nil, err
+	}
+	resp, err := s.usecase.RestoreUser(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	s.redactUser(ctx, &resp.User)
+	return resp, nil
+}
+
+// UnlockUser lifts the lock of an account.
+func (s *UserService) UnlockUser(ctx context.Context, args model.UnlockUserArgs) error {
+	if err := Authorize(ctx, OperationUnlockUser, args.ID); err != nil {
+		return err
+	}
+	return s.usecase.UnlockUser(ctx, args)
+}
+
+// VerifyUser records the verification of the email of a user.
+func (s *UserService) VerifyUser(ctx context.Context, args model.VerifyUserArgs) error {
+	if err := Authorize(ctx, OperationVerifyUser, args.ID); err != nil {
+		return err
+	}
+	return s.usecase.VerifyUser(ctx, args)
+}
+
+// ExportUserData exports the data held about a user.
+func (s *UserService) ExportUserData(ctx context.Context, args model.ExportUserDataArgs) (*model.ExportUserDataResponse, error) {
+	if err := Authorize(ctx, OperationExportUserData, args.UserID); err != nil {
+		return nil, err
+	}
+	// the archive is signed by the usecase, which redacts the user first
+	args.FieldPolicy = s.fieldPolicy
+	return s.usecase.ExportUserData(ctx, args)
+}
+
+// EraseUser erases the personal data of a user.
+func (s *UserService) EraseUser(ctx context.Context, args model.EraseUserArgs) error {
+	if err := Authorize(ctx, OperationEraseUser, args.ID); err != nil {
+		return err
+	}
+	return s.usecase.EraseUser(ctx, args)
+}
+
+// ListAuditEntries lists the audit trail of a user. The entries are redacted for the users reading their own trail,
+// who cannot filter them by actor either, see model.AuditEntry.Redact.
+func (s *UserService) ListAuditEntries(ctx context.Context, args model.ListAuditEntriesArgs) (*model.ListAuditEntriesResponse, error) {
+	if err := Authorize(ctx, OperationListAuditEntries, args.UserID); err != nil {
+		return nil, err
+	}
+	actor, _ := model.ActorFromContext(ctx)
+	if args.ActorID != "" && args.ActorID != actor.ID && !actor.HasRole(model.RoleSupport) && !actor.HasRole(model.RoleAdmin) {
+		return nil, fmt.Errorf("%w: only the staff filter the audit entries by actor", model.ErrPermissionDenied)
+	}
+	res, err := s.usecase.ListAuditEntries(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range res.Entries {
+		res.Entries[i] = entry.Redact(actor)
+	}
+	return res, nil
+}
+
+// Login opens a session. It is not subject to authorization, the caller is authenticated by the credentials.
+func (s *UserService) Login(ctx context.Context, args model.LoginArgs) (*model.LoginResponse, error) {
+	return s.usecase.Login(ctx, args)
+}
+
+// CompleteLogin completes a login with a second factor. It is not subject to authorization, the caller is
+// authenticated by the login challenge and the code.
+func (s *UserService) CompleteLogin(ctx context.Context, args model.CompleteLoginArgs) (*model.SessionTokens, error) {
+	return s.usecase.CompleteLogin(ctx, args)
+}
+
+// RefreshSession refreshes a session. It is not subject to authorization, the caller is authenticated by the
+// refresh token.
+func (s *UserService) RefreshSession(ctx context.Context, args model.RefreshSessionArgs) (*model.SessionTokens, error) {
+	return s.usecase.RefreshSession(ctx, args)
+}
+
+// ListSessions lists the active sessions of a user.
+func (s *UserService) ListSessions(ctx context.Context, args model.ListSessionsArgs) (*model.ListSessionsResponse, error) {
+	if err := Authorize(ctx, OperationListSessions, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.ListSessions(ctx, args)
+}
+
+// RevokeSession revokes a session of a user.
+func (s *UserService) RevokeSession(ctx context.Context, args model.RevokeSessionArgs) error {
+	if err := Authorize(ctx, OperationRevokeSession, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.RevokeSession(ctx, args)
+}
+
+// EnrollTOTP enrolls TOTP as second factor of a user.
+func (s *UserService) EnrollTOTP(ctx context.Context, args model.EnrollTOTPArgs) (*model.TOTPEnrollment, error) {
+	if err := Authorize(ctx, OperationEnrollTOTP, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.EnrollTOTP(ctx, args)
+}
+
+// ConfirmTOTP confirms the TOTP enrollment of a user.
+func (s *UserService) ConfirmTOTP(ctx context.Context, args model.ConfirmTOTPArgs) (*model.ConfirmTOTPResponse, error) {
+	if err := Authorize(ctx, OperationConfirmTOTP, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.ConfirmTOTP(ctx, args)
+}
+
+// DisableTOTP disables the TOTP second factor of a user.
+func (s *UserService) DisableTOTP(ctx context.Context, args model.DisableTOTPArgs) error {
+	if err := Authorize(ctx, OperationDisableTOTP, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.DisableTOTP(ctx, args)
+}
+
+// CreateAPIKey creates an API key.
+func (s *UserService) CreateAPIKey(ctx context.Context, args model.CreateAPIKeyArgs) (*model.CreateAPIKeyResponse, error) {
+	if err := Authorize(ctx, OperationCreateAPIKey, uuid.Nil); err != nil {
+		return nil, err
+	}
+	return s.usecase.CreateAPIKey(ctx, args)
+}
+
+// ListAPIKeys lists the API keys.
+func (s *UserService) ListAPIKeys(ctx context.Context) (*model.ListAPIKeysResponse, error) {
+	if err := Authorize(ctx, OperationListAPIKeys, uuid.Nil); err != nil {
+		return nil, err
+	}
+	return s.usecase.ListAPIKeys(ctx)
+}
+
+// RevokeAPIKey revokes an API key.
+func (s *UserService) RevokeAPIKey(ctx context.Context, args model.RevokeAPIKeyArgs) error {
+	if err := Authorize(ctx, OperationRevokeAPIKey, uuid.Nil); err != nil {
+		return err
+	}
+	return s.usecase.RevokeAPIKey(ctx, args)
+}
+
+// GrantRole grants a role to a user.
+func (s *UserService) GrantRole(ctx context.Context, args model.GrantRoleArgs) error {
+	if err := Authorize(ctx, OperationGrantRole, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.GrantRole(ctx, args)
+}
+
+// RevokeRole revokes a role of a user.
+func (s *UserService) RevokeRole(ctx context.Context, args model.RevokeRoleArgs) error {
+	if err := Authorize(ctx, OperationRevokeRole, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.RevokeRole(ctx, args)
+}
+
+// RequestFriendship sends a friend request on behalf of a user.
+func (s *UserService) RequestFriendship(ctx context.Context, args model.RequestFriendshipArgs) (*model.RequestFriendshipResponse, error) {
+	if err := Authorize(ctx, OperationRequestFriendship, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.RequestFriendship(ctx, args)
+}
+
+// AcceptFriendship accepts a friend request sent to a user.
+func (s *UserService) AcceptFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error {
+	if err := Authorize(ctx, OperationAcceptFriendship, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.AcceptFriendship(ctx, args)
+}
+
+// DeclineFriendship declines a friend request sent to a user.
+func (s *UserService) DeclineFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error {
+	if err := Authorize(ctx, OperationDeclineFriendship, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.DeclineFriendship(ctx, args)
+}
+
+// RemoveFriend removes a friendship of a user.
+func (s *UserService) RemoveFriend(ctx context.Context, args model.RemoveFriendArgs) error {
+	if err := Authorize(ctx, OperationRemoveFriend, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.RemoveFriend(ctx, args)
+}
+
+// ListFriends lists the friendships of a user.
+func (s *UserService) ListFriends(ctx context.Context, args model.ListFriendsArgs) (*model.ListFriendsResponse, error) {
+	if err := Authorize(ctx, OperationListFriends, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.ListFriends(ctx, args)
+}
+
+// BlockUser blocks a user on behalf of another one.
+func (s *UserService) BlockUser(ctx context.Context, args model.BlockUserArgs) (*model.BlockUserResponse, error) {
+	if err := Authorize(ctx, OperationBlockUser, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.BlockUser(ctx, args)
+}
+
+// UnblockUser lifts a block of a user.
+func (s *UserService) UnblockUser(ctx context.Context, args model.UnblockUserArgs) error {
+	if err := Authorize(ctx, OperationUnblockUser, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.UnblockUser(ctx, args)
+}
+
+// ListBlockedUsers lists the users blocked by a user.
+func (s *UserService) ListBlockedUsers(ctx context.Context, args model.ListBlockedUsersArgs) (*model.ListBlockedUsersResponse, error) {
+	if err := Authorize(ctx, OperationListBlockedUsers, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.ListBlockedUsers(ctx, args)
+}
+
+// IsBlocked reports whether either user blocked the other.
+func (s *UserService) IsBlocked(ctx context.Context, args model.IsBlockedArgs) (bool, error) {
+	if err := Authorize(ctx, OperationIsBlocked, args.UserID); err != nil {
+		return false, err
+	}
+	return s.usecase.IsBlocked(ctx, args)
+}
+
+// SuspendUser suspends a user.
+func (s *UserService) SuspendUser(ctx context.Context, args model.SuspendUserArgs) (*model.SuspendUserResponse, error) {
+	if err := Authorize(ctx, OperationSuspendUser, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.SuspendUser(ctx, args)
+}
+
+// LiftSuspension lifts the suspension of a user.
+func (s *UserService) LiftSuspension(ctx context.Context, args model.LiftSuspensionArgs) error {
+	if err := Authorize(ctx, OperationLiftSuspension, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.LiftSuspension(ctx, args)
+}
+
+// ListSuspensions lists the suspensions of a user.
+func (s *UserService) ListSuspensions(ctx context.Context, args model.ListSuspensionsArgs) (*model.ListSuspensionsResponse, error) {
+	if err := Authorize(ctx, OperationListSuspensions, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.ListSuspensions(ctx, args)
+}
+
+// RequestAccountDeletion schedules the deletion of the account of a user.
+func (s *UserService) RequestAccountDeletion(ctx context.Context, args model.RequestAccountDeletionArgs) (*model.RequestAccountDeletionResponse, error) {
+	if err := Authorize(ctx, OperationRequestDeletion, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.RequestAccountDeletion(ctx, args)
+}
+
+// CancelAccountDeletion cancels the scheduled deletion of the account of a user.
+func (s *UserService) CancelAccountDeletion(ctx context.Context, args model.CancelAccountDeletionArgs) error {
+	if err := Authorize(ctx, OperationCancelDeletion, args.UserID); err != nil {
+		return err
+	}
+	return s.usecase.CancelAccountDeletion(ctx, args)
+}
+
+// GetNicknameHistory lists the nickname changes of a user.
+func (s *UserService) GetNicknameHistory(ctx context.Context, args model.GetNicknameHistoryArgs) (*model.GetNicknameHistoryResponse, error) {
+	if err := Authorize(ctx, OperationGetNicknames, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.GetNicknameHistory(ctx, args)
+}
+
+// CheckNicknameAvailability tells whether a nickname can be taken.
+func (s *UserService) CheckNicknameAvailability(ctx context.Context, args model.CheckNicknameAvailabilityArgs) (*model.CheckNicknameAvailabilityResponse, error) {
+	if err := Authorize(ctx, OperationCheckNickname, uuid.Nil); err != nil {
+		return nil, err
+	}
+	return s.usecase.CheckNicknameAvailability(ctx, args)
+}
+
+// GetPublicProfile returns the public profile of a user.
+func (s *UserService) GetPublicProfile(ctx context.Context, args model.GetPublicProfileArgs) (*model.GetPublicProfileResponse, error) {
+	if err := Authorize(ctx, OperationGetPublicProfile, args.UserID); err != nil {
+		return nil, err
+	}
+	return s.usecase.GetPublicProfile(ctx, args)
+}
+
+// redactUser masks the personal fields the actor carried by ctx is not granted and hides the roles from the actors
+// that cannot manage them, see model.FieldPolicy.Redact.
+func (s *UserService) redactUser(ctx context.Context, user *model.User) {
+	actor, _ := model.ActorFromContext(ctx)
+	s.fieldPolicy.Redact(actor, user)
+}
+
+// userService is the user usecase.
+type userService interface {
+	CreateUser(ctx context.Context, args model.CreateUserArgs) (*model.CreateUserResponse, error)
+	UpdateUser(ctx context.Context, args model.UpdateUserArgs) (*model.UpdateUserResponse, error)
+	ChangePassword(ctx context.Context, args model.ChangePasswordArgs) error
+	ListUsers(ctx context.Context, args model.ListUsersArgs) (*model.ListUsersResponse, error)
+	GetUser(ctx context.Context, args model.GetUserArgs) (*model.GetUserResponse, error)
+	GetUserHistory(ctx context.Context, args model.GetUserHistoryArgs) (*model.GetUserHistoryResponse, error)
+	DeleteUser(ctx context.Context, args model.DeleteUserArgs) error
+	RestoreUser(ctx context.Context, args model.RestoreUserArgs) (*model.RestoreUserResponse, error)
+	UnlockUser(ctx context.Context, args model.UnlockUserArgs) error
+	VerifyUser(ctx context.Context, args model.VerifyUserArgs) error
+	ExportUserData(ctx context.Context, args model.ExportUserDataArgs) (*model.ExportUserDataResponse, error)
+	EraseUser(ctx context.Context, args model.EraseUserArgs) error
+	ListAuditEntries(ctx context.Context, args model.ListAuditEntriesArgs) (*model.ListAuditEntriesResponse, error)
+	Login(ctx context.Context, args model.LoginArgs) (*model.LoginResponse, error)
+	CompleteLogin(ctx context.Context, args model.CompleteLoginArgs) (*model.SessionTokens, error)
+	RefreshSession(ctx context.Context, args model.RefreshSessionArgs) (*model.SessionTokens, error)
+	ListSessions(ctx context.Context, args model.ListSessionsArgs) (*model.ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, args model.RevokeSessionArgs) error
+	EnrollTOTP(ctx context.Context, args model.EnrollTOTPArgs) (*model.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, args model.ConfirmTOTPArgs) (*model.ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, args model.DisableTOTPArgs) error
+	CreateAPIKey(ctx context.Context, args model.CreateAPIKeyArgs) (*model.CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context) (*model.ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, args model.RevokeAPIKeyArgs) error
+	GrantRole(ctx context.Context, args model.GrantRoleArgs) error
+	RevokeRole(ctx context.Context, args model.RevokeRoleArgs) error
+	RequestFriendship(ctx context.Context, args model.RequestFriendshipArgs) (*model.RequestFriendshipResponse, error)
+	AcceptFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error
+	DeclineFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error
+	RemoveFriend(ctx context.Context, args model.RemoveFriendArgs) error
+	ListFriends(ctx context.Context, args model.ListFriendsArgs) (*model.ListFriendsResponse, error)
+	BlockUser(ctx context.Context, args model.BlockUserArgs) (*model.BlockUserResponse, error)
+	UnblockUser(ctx context.Context, args model.UnblockUserArgs) error
+	ListBlockedUsers(ctx context.Context, args model.ListBlockedUsersArgs) (*model.ListBlockedUsersResponse, error)
+	IsBlocked(ctx context.Context, args model.IsBlockedArgs) (bool, error)
+	SuspendUser(ctx context.Context, args model.SuspendUserArgs) (*model.SuspendUserResponse, error)
+	LiftSuspension(ctx context.Context, args model.LiftSuspensionArgs) error
+	ListSuspensions(ctx context.Context, args model.ListSuspensionsArgs) (*model.ListSuspensionsResponse, error)
+	RequestAccountDeletion(ctx context.Context, args model.RequestAccountDeletionArgs) (*model.RequestAccountDeletionResponse, error)
+	CancelAccountDeletion(ctx context.Context, args model.CancelAccountDeletionArgs) error
+	GetNicknameHistory(ctx context.Context, args model.GetNicknameHistoryArgs) (*model.GetNicknameHistoryResponse, error)
+	CheckNicknameAvailability(ctx context.Context, args model.CheckNicknameAvailabilityArgs) (*model.CheckNicknameAvailabilityResponse, error)
+	GetPublicProfile(ctx context.Context, args model.GetPublicProfileArgs) (*model.GetPublicProfileResponse, error)
+}
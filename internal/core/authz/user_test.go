@@ -0,0 +1,821 @@
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockUsecase is a mock implementation of the userService interface recording the delegated calls.
+type MockUsecase struct {
+	called bool
+
+	// exportArgs are the arguments of the last export.
+	exportArgs model.ExportUserDataArgs
+}
+
+// targetUser is the target user as returned by the usecase, before redaction.
+func targetUser() model.User {
+	return model.User{ID: target, FirstName: "Jane", LastName: "Doe", Nickname: "jd", Email: "jane@x.com", Country: "BR",
+		Roles: []model.Role{model.RoleSupport}}
+}
+
+func (m *MockUsecase) CreateUser(ctx context.Context, args model.CreateUserArgs) (*model.CreateUserResponse, error) {
+	m.called = true
+	return &model.CreateUserResponse{User: targetUser()}, nil
+}
+
+func (m *MockUsecase) UpdateUser(ctx context.Context, args model.UpdateUserArgs) (*model.UpdateUserResponse, error) {
+	m.called = true
+	return &model.UpdateUserResponse{User: targetUser()}, nil
+}
+
+func (m *MockUsecase) ChangePassword(ctx context.Context, args model.ChangePasswordArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) ListUsers(ctx context.Context, args model.ListUsersArgs) (*model.ListUsersResponse, error) {
+	m.called = true
+	return &model.ListUsersResponse{Users: []model.User{targetUser()}}, nil
+}
+
+func (m *MockUsecase) GetUser(ctx context.Context, args model.GetUserArgs) (*model.GetUserResponse, error) {
+	m.called = true
+	return &model.GetUserResponse{User: targetUser()}, nil
+}
+
+func (m *MockUsecase) GetUserHistory(ctx context.Context, args model.GetUserHistoryArgs) (*model.GetUserHistoryResponse, error) {
+	m.called = true
+	return &model.GetUserHistoryResponse{Versions: []model.UserVersion{{User: targetUser()}}}, nil
+}
+
+func (m *MockUsecase) DeleteUser(ctx context.Context, args model.DeleteUserArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) RestoreUser(ctx context.Context, args model.RestoreUserArgs) (*model.RestoreUserResponse, error) {
+	m.called = true
+	return &model.RestoreUserResponse{User: targetUser()}, nil
+}
+
+func (m *MockUsecase) UnlockUser(ctx context.Context, args model.UnlockUserArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) VerifyUser(ctx context.Context, args model.VerifyUserArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) ExportUserData(ctx context.Context, args model.ExportUserDataArgs) (*model.ExportUserDataResponse, error) {
+	m.called = true
+	m.exportArgs = args
+	return &model.ExportUserDataResponse{}, nil
+}
+
+func (m *MockUsecase) EraseUser(ctx context.Context, args model.EraseUserArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) ListAuditEntries(ctx context.Context, args model.ListAuditEntriesArgs) (*model.ListAuditEntriesResponse, error) {
+	m.called = true
+	return &model.ListAuditEntriesResponse{Entries: []model.AuditEntry{
+		{UserID: target, ActorID: target.String(), Action: model.AuditActionUpdate, RequestID: "r1", SourceIP: "10.0.0.1"},
+		{UserID: target, ActorID: "admin", Action: model.AuditActionSuspend, RequestID: "r2", SourceIP: "10.0.0.2"},
+	}}, nil
+}
+
+func (m *MockUsecase) Login(ctx context.Context, args model.LoginArgs) (*model.LoginResponse, error) {
+	m.called = true
+	return &model.LoginResponse{Tokens: &model.SessionTokens{}}, nil
+}
+
+func (m *MockUsecase) CompleteLogin(ctx context.Context, args model.CompleteLoginArgs) (*model.SessionTokens, error) {
+	m.called = true
+	return &model.SessionTokens{}, nil
+}
+
+func (m *MockUsecase) RefreshSession(ctx context.Context, args model.RefreshSessionArgs) (*model.SessionTokens, error) {
+	m.called = true
+	return &model.SessionTokens{}, nil
+}
+
+func (m *MockUsecase) ListSessions(ctx context.Context, args model.ListSessionsArgs) (*model.ListSessionsResponse, error) {
+	m.called = true
+	return &model.ListSessionsResponse{}, nil
+}
+
+func (m *MockUsecase) RevokeSession(ctx context.Context, args model.RevokeSessionArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) EnrollTOTP(ctx context.Context, args model.EnrollTOTPArgs) (*model.TOTPEnrollment, error) {
+	m.called = true
+	return &model.TOTPEnrollment{}, nil
+}
+
+func (m *MockUsecase) ConfirmTOTP(ctx context.Context, args model.ConfirmTOTPArgs) (*model.ConfirmTOTPResponse, error) {
+	m.called = true
+	return &model.ConfirmTOTPResponse{}, nil
+}
+
+func (m *MockUsecase) DisableTOTP(ctx context.Context, args model.DisableTOTPArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) CreateAPIKey(ctx context.Context, args model.CreateAPIKeyArgs) (*model.CreateAPIKeyResponse, error) {
+	m.called = true
+	return &model.CreateAPIKeyResponse{}, nil
+}
+
+func (m *MockUsecase) ListAPIKeys(ctx context.Context) (*model.ListAPIKeysResponse, error) {
+	m.called = true
+	return &model.ListAPIKeysResponse{}, nil
+}
+
+func (m *MockUsecase) RevokeAPIKey(ctx context.Context, args model.RevokeAPIKeyArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) GrantRole(ctx context.Context, args model.GrantRoleArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) RevokeRole(ctx context.Context, args model.RevokeRoleArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) RequestFriendship(ctx context.Context, args model.RequestFriendshipArgs) (*model.RequestFriendshipResponse, error) {
+	m.called = true
+	return &model.RequestFriendshipResponse{}, nil
+}
+
+func (m *MockUsecase) AcceptFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) DeclineFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) RemoveFriend(ctx context.Context, args model.RemoveFriendArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) ListFriends(ctx context.Context, args model.ListFriendsArgs) (*model.ListFriendsResponse, error) {
+	m.called = true
+	return &model.ListFriendsResponse{}, nil
+}
+
+func (m *MockUsecase) BlockUser(ctx context.Context, args model.BlockUserArgs) (*model.BlockUserResponse, error) {
+	m.called = true
+	return &model.BlockUserResponse{}, nil
+}
+
+func (m *MockUsecase) UnblockUser(ctx context.Context, args model.UnblockUserArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) ListBlockedUsers(ctx context.Context, args model.ListBlockedUsersArgs) (*model.ListBlockedUsersResponse, error) {
+	m.called = true
+	return &model.ListBlockedUsersResponse{}, nil
+}
+
+func (m *MockUsecase) IsBlocked(ctx context.Context, args model.IsBlockedArgs) (bool, error) {
+	m.called = true
+	return false, nil
+}
+
+func (m *MockUsecase) SuspendUser(ctx context.Context, args model.SuspendUserArgs) (*model.SuspendUserResponse, error) {
+	m.called = true
+	return &model.SuspendUserResponse{}, nil
+}
+
+func (m *MockUsecase) LiftSuspension(ctx context.Context, args model.LiftSuspensionArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) ListSuspensions(ctx context.Context, args model.ListSuspensionsArgs) (*model.ListSuspensionsResponse, error) {
+	m.called = true
+	return &model.ListSuspensionsResponse{}, nil
+}
+
+func (m *MockUsecase) RequestAccountDeletion(ctx context.Context, args model.RequestAccountDeletionArgs) (*model.RequestAccountDeletionResponse, error) {
+	m.called = true
+	return &model.RequestAccountDeletionResponse{}, nil
+}
+
+func (m *MockUsecase) CancelAccountDeletion(ctx context.Context, args model.CancelAccountDeletionArgs) error {
+	m.called = true
+	return nil
+}
+
+func (m *MockUsecase) GetNicknameHistory(ctx context.Context, args model.GetNicknameHistoryArgs) (*model.GetNicknameHistoryResponse, error) {
+	m.called = true
+	return &model.GetNicknameHistoryResponse{}, nil
+}
+
+func (m *MockUsecase) CheckNicknameAvailability(ctx context.Context, args model.CheckNicknameAvailabilityArgs) (*model.CheckNicknameAvailabilityResponse, error) {
+	m.called = true
+	return &model.CheckNicknameAvailabilityResponse{}, nil
+}
+
+func (m *MockUsecase) GetPublicProfile(ctx context.Context, args model.GetPublicProfileArgs) (*model.GetPublicProfileResponse, error) {
+	m.called = true
+	return &model.GetPublicProfileResponse{}, nil
+}
+
+// caller is the kind of actor invoking an operation on the target user.
+type caller string
+
+const (
+	anonymous caller = "anonymous"
+	self      caller = "self"
+	otherUser caller = "other user"
+	support   caller = "support"
+	admin     caller = "admin"
+	signup    caller = "signup"
+
+	blockCheck   caller = "block check"
+	suspension   caller = "suspension"
+	verification caller = "verification"
+)
+
+// callers are every kind of actor, authenticated are the ones carrying an identity.
+var (
+	callers       = []caller{anonymous, self, otherUser, support, admin, signup, blockCheck, suspension, verification}
+	authenticated = callers[1:]
+)
+
+var target = uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+
+func (c caller) context() context.Context {
+	ctx := context.Background()
+	switch c {
+	case self:
+		return model.ContextWithActor(ctx, model.Actor{ID: target.String()})
+	case otherUser:
+		return model.ContextWithActor(ctx, model.Actor{ID: uuid.NewString()})
+	case support:
+		return model.ContextWithActor(ctx, model.Actor{ID: "support", Roles: []model.Role{model.RoleSupport}})
+	case admin:
+		return model.ContextWithActor(ctx, model.Actor{ID: "admin", Roles: []model.Role{model.RoleAdmin}})
+	case signup:
+		return model.ContextWithActor(ctx, model.Actor{ID: "signup", Roles: []model.Role{model.RoleSignup}})
+	case blockCheck:
+		return model.ContextWithActor(ctx, model.Actor{ID: "chat", Roles: []model.Role{model.RoleBlockCheck}})
+	case suspension:
+		return model.ContextWithActor(ctx, model.Actor{ID: "anti-cheat", Roles: []model.Role{model.RoleSuspension}})
+	case verification:
+		return model.ContextWithActor(ctx, model.Actor{ID: "mailer", Roles: []model.Role{model.RoleVerification}})
+	}
+	return ctx
+}
+
+func TestUserService_Policies(t *testing.T) {
+	tests := []struct {
+		operation Operation
+		call      func(ctx context.Context, svc *UserService) error
+		allowed   []caller
+	}{
+		{
+			operation: OperationCreateUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.CreateUser(ctx, model.CreateUserArgs{})
+				return err
+			},
+			allowed: []caller{signup, admin},
+		},
+		{
+			operation: OperationGetUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.GetUser(ctx, model.GetUserArgs{ID: target})
+				return err
+			},
+			allowed: []caller{self, support, admin},
+		},
+		{
+			operation: OperationGetUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.GetUser(ctx, model.GetUserArgs{ID: target, AsOf: time.Now()})
+				return err
+			},
+			allowed: []caller{self, support, admin},
+		},
+		{
+			operation: OperationGetDeletedUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.GetUser(ctx, model.GetUserArgs{ID: target, IncludeDeleted: true})
+				return err
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationGetUserHistory,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.GetUserHistory(ctx, model.GetUserHistoryArgs{UserID: target})
+				return err
+			},
+			allowed: []caller{self, support, admin},
+		},
+		{
+			operation: OperationListUsers,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ListUsers(ctx, model.ListUsersArgs{})
+				return err
+			},
+			allowed: []caller{support, admin},
+		},
+		{
+			operation: OperationListDeletedUsers,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ListUsers(ctx, model.ListUsersArgs{IncludeDeleted: true})
+				return err
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationUpdateUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.UpdateUser(ctx, model.UpdateUserArgs{ID: target})
+				return err
+			},
+			allowed: []caller{self, admin},
+		},
+		{
+			operation: OperationChangePassword,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.ChangePassword(ctx, model.ChangePasswordArgs{ID: target})
+			},
+			allowed: []caller{self, admin},
+		},
+		{
+			operation: OperationSoftDeleteUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.DeleteUser(ctx, model.DeleteUserArgs{ID: target})
+			},
+			allowed: []caller{support, admin},
+		},
+		{
+			operation: OperationHardDeleteUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.DeleteUser(ctx, model.DeleteUserArgs{ID: target, HardDelete: true})
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationRestoreUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.RestoreUser(ctx, model.RestoreUserArgs{ID: target})
+				return err
+			},
+			allowed: []caller{support, admin},
+		},
+		{
+			operation: OperationUnlockUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.UnlockUser(ctx, model.UnlockUserArgs{ID: target})
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationVerifyUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.VerifyUser(ctx, model.VerifyUserArgs{ID: target})
+			},
+			allowed: []caller{verification, support, admin},
+		},
+		{
+			operation: OperationExportUserData,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ExportUserData(ctx, model.ExportUserDataArgs{UserID: target})
+				return err
+			},
+			// further restricted by the usecase.
+			allowed: authenticated,
+		},
+		{
+			operation: OperationEraseUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.EraseUser(ctx, model.EraseUserArgs{ID: target})
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationListAuditEntries,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ListAuditEntries(ctx, model.ListAuditEntriesArgs{UserID: target})
+				return err
+			},
+			allowed: []caller{self, support, admin},
+		},
+		{
+			operation: OperationListSessions,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ListSessions(ctx, model.ListSessionsArgs{UserID: target})
+				return err
+			},
+			allowed: []caller{self, support, admin},
+		},
+		{
+			operation: OperationRevokeSession,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.RevokeSession(ctx, model.RevokeSessionArgs{UserID: target, SessionID: uuid.New()})
+			},
+			allowed: []caller{self, admin},
+		},
+		{
+			operation: OperationEnrollTOTP,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.EnrollTOTP(ctx, model.EnrollTOTPArgs{UserID: target})
+				return err
+			},
+			allowed: []caller{self},
+		},
+		{
+			operation: OperationConfirmTOTP,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ConfirmTOTP(ctx, model.ConfirmTOTPArgs{UserID: target, Code: "123456"})
+				return err
+			},
+			allowed: []caller{self},
+		},
+		{
+			operation: OperationDisableTOTP,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.DisableTOTP(ctx, model.DisableTOTPArgs{UserID: target})
+			},
+			allowed: []caller{self, admin},
+		},
+		{
+			operation: OperationCreateAPIKey,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.CreateAPIKey(ctx, model.CreateAPIKeyArgs{Name: "matchmaking", Scopes: []model.Role{model.RoleSupport}})
+				return err
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationListAPIKeys,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ListAPIKeys(ctx)
+				return err
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationRevokeAPIKey,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.RevokeAPIKey(ctx, model.RevokeAPIKeyArgs{ID: uuid.New()})
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationGrantRole,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.GrantRole(ctx, model.GrantRoleArgs{UserID: target, Role: model.RoleSupport})
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationRevokeRole,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.RevokeRole(ctx, model.RevokeRoleArgs{UserID: target, Role: model.RoleSupport})
+			},
+			allowed: []caller{admin},
+		},
+		{
+			operation: OperationRequestFriendship,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.RequestFriendship(ctx, model.RequestFriendshipArgs{UserID: target, FriendID: uuid.New()})
+				return err
+			},
+			allowed: []caller{self},
+		},
+		{
+			operation: OperationAcceptFriendship,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.AcceptFriendship(ctx, model.AnswerFriendshipArgs{UserID: target, FriendID: uuid.New()})
+			},
+			allowed: []caller{self},
+		},
+		{
+			operation: OperationDeclineFriendship,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.DeclineFriendship(ctx, model.AnswerFriendshipArgs{UserID: target, FriendID: uuid.New()})
+			},
+			allowed: []caller{self},
+		},
+		{
+			operation: OperationRemoveFriend,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.RemoveFriend(ctx, model.RemoveFriendArgs{UserID: target, FriendID: uuid.New()})
+			},
+			allowed: []caller{self, admin},
+		},
+		{
+			operation: OperationListFriends,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ListFriends(ctx, model.ListFriendsArgs{UserID: target})
+				return err
+			},
+			allowed: []caller{self, support, admin},
+		},
+		{
+			operation: OperationBlockUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.BlockUser(ctx, model.BlockUserArgs{UserID: target, BlockedID: uuid.New()})
+				return err
+			},
+			allowed: []caller{self},
+		},
+		{
+			operation: OperationUnblockUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.UnblockUser(ctx, model.UnblockUserArgs{UserID: target, BlockedID: uuid.New()})
+			},
+			allowed: []caller{self, admin},
+		},
+		{
+			operation: OperationListBlockedUsers,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ListBlockedUsers(ctx, model.ListBlockedUsersArgs{UserID: target})
+				return err
+			},
+			allowed: []caller{self, support, admin},
+		},
+		{
+			operation: OperationIsBlocked,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.IsBlocked(ctx, model.IsBlockedArgs{UserID: target, OtherUserID: uuid.New()})
+				return err
+			},
+			allowed: []caller{blockCheck, support, admin},
+		},
+		{
+			operation: OperationSuspendUser,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.SuspendUser(ctx, model.SuspendUserArgs{UserID: target, Reason: model.SuspensionReasonCheating})
+				return err
+			},
+			allowed: []caller{suspension, support, admin},
+		},
+		{
+			operation: OperationLiftSuspension,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.LiftSuspension(ctx, model.LiftSuspensionArgs{UserID: target})
+			},
+			allowed: []caller{suspension, support, admin},
+		},
+		{
+			operation: OperationListSuspensions,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.ListSuspensions(ctx, model.ListSuspensionsArgs{UserID: target})
+				return err
+			},
+			allowed: []caller{suspension, support, admin},
+		},
+		{
+			operation: OperationRequestDeletion,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.RequestAccountDeletion(ctx, model.RequestAccountDeletionArgs{UserID: target})
+				return err
+			},
+			allowed: []caller{self},
+		},
+		{
+			operation: OperationCancelDeletion,
+			call: func(ctx context.Context, svc *UserService) error {
+				return svc.CancelAccountDeletion(ctx, model.CancelAccountDeletionArgs{UserID: target})
+			},
+			allowed: []caller{self, support, admin},
+		},
+		{
+			operation: OperationGetNicknames,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.GetNicknameHistory(ctx, model.GetNicknameHistoryArgs{UserID: target})
+				return err
+			},
+			allowed: []caller{support, admin},
+		},
+		{
+			operation: OperationCheckNickname,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.CheckNicknameAvailability(ctx, model.CheckNicknameAvailabilityArgs{Nickname: "ace"})
+				return err
+			},
+			allowed: authenticated,
+		},
+		{
+			operation: OperationGetPublicProfile,
+			call: func(ctx context.Context, svc *UserService) error {
+				_, err := svc.GetPublicProfile(ctx, model.GetPublicProfileArgs{UserID: target})
+				return err
+			},
+			allowed: authenticated,
+		},
+	}
+
+	tested := map[Operation]bool{}
+	for _, test := range tests {
+		tested[test.operation] = true
+		for _, c := range callers {
+			t.Run(string(test.operation)+"/"+string(c), func(t *testing.T) {
+				usecase := &MockUsecase{}
+				svc := NewUserService(UserServiceArgs{Usecase: usecase})
+
+				err := test.call(c.context(), svc)
+
+				switch {
+				case c == anonymous:
+					require.ErrorIs(t, err, model.ErrUnauthenticated)
+				case contains(test.allowed, c):
+					require.NoError(t, err)
+				default:
+					require.ErrorIs(t, err, model.ErrPermissionDenied)
+				}
+				assert.Equal(t, err == nil, usecase.called)
+			})
+		}
+	}
+
+	for operation := range policies {
+		assert.True(t, tested[operation], "policy of %s is not tested", operation)
+	}
+}
+
+func TestUserService_SessionCredentialsAreNotAuthorized(t *testing.T) {
+	usecase := &MockUsecase{}
+	svc := NewUserService(UserServiceArgs{Usecase: usecase})
+
+	_, err := svc.Login(anonymous.context(), model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	require.NoError(t, err)
+	assert.True(t, usecase.called)
+
+	usecase.called = false
+	_, err = svc.RefreshSession(anonymous.context(), model.RefreshSessionArgs{RefreshToken: "token"})
+	require.NoError(t, err)
+	assert.True(t, usecase.called)
+
+	usecase.called = false
+	_, err = svc.CompleteLogin(anonymous.context(), model.CompleteLoginArgs{MFAChallenge: "challenge", Code: "123456"})
+	require.NoError(t, err)
+	assert.True(t, usecase.called)
+}
+
+func TestUserService_RolesAreShownToAdmins(t *testing.T) {
+	svc := NewUserService(UserServiceArgs{Usecase: &MockUsecase{}})
+
+	for _, c := range []caller{self, support, admin} {
+		t.Run(string(c), func(t *testing.T) {
+			got, err := svc.GetUser(c.context(), model.GetUserArgs{ID: target})
+			require.NoError(t, err)
+			if c == admin {
+				assert.Equal(t, []model.Role{model.RoleSupport}, got.User.Roles)
+			} else {
+				assert.Empty(t, got.User.Roles)
+			}
+		})
+	}
+
+	listed, err := svc.ListUsers(support.context(), model.ListUsersArgs{})
+	require.NoError(t, err)
+	assert.Empty(t, listed.Users[0].Roles)
+	listed, err = svc.ListUsers(admin.context(), model.ListUsersArgs{})
+	require.NoError(t, err)
+	assert.Equal(t, []model.Role{model.RoleSupport}, listed.Users[0].Roles)
+}
+
+func TestUserService_EveryUserIsRedacted(t *testing.T) {
+	usecase := &MockUsecase{}
+	policy := model.FieldPolicy{"email": {model.RoleAdmin}, "country": {model.RoleSupport}}
+	svc := NewUserService(UserServiceArgs{Usecase: usecase, FieldPolicy: policy})
+
+	// every call returning users shapes them for the caller, the writes included
+	returnedUsers := func(c caller) []model.User {
+		var users []model.User
+		if c == admin || c == signup {
+			created, err := svc.CreateUser(c.context(), model.CreateUserArgs{})
+			require.NoError(t, err)
+			users = append(users, created.User)
+		}
+		if c == admin || c == self {
+			updated, err := svc.UpdateUser(c.context(), model.UpdateUserArgs{ID: target})
+			require.NoError(t, err)
+			users = append(users, updated.User)
+		}
+		if c == admin || c == support {
+			restored, err := svc.RestoreUser(c.context(), model.RestoreUserArgs{ID: target})
+			require.NoError(t, err)
+			listed, err := svc.ListUsers(c.context(), model.ListUsersArgs{})
+			require.NoError(t, err)
+			users = append(users, restored.User, listed.Users[0])
+		}
+		got, err := svc.GetUser(c.context(), model.GetUserArgs{ID: target})
+		if c == signup {
+			require.ErrorIs(t, err, model.ErrPermissionDenied)
+			return users
+		}
+		require.NoError(t, err)
+		history, err := svc.GetUserHistory(c.context(), model.GetUserHistoryArgs{UserID: target})
+		require.NoError(t, err)
+		return append(users, got.User, history.Versions[0].User)
+	}
+	tests := []struct {
+		caller caller
+		calls  int
+		want   model.User
+	}{
+		{caller: self, calls: 3, want: model.User{ID: target, FirstName: "Jane", LastName: "Doe", Nickname: "jd", Email: "jane@x.com", Country: "BR"}},
+		{caller: signup, calls: 1, want: model.User{ID: target, FirstName: "Jane", LastName: "Doe", Nickname: "jd",
+			MaskedFields: []string{"email", "country"}}},
+		{caller: support, calls: 4, want: model.User{ID: target, FirstName: "Jane", LastName: "Doe", Nickname: "jd", Country: "BR",
+			MaskedFields: []string{"email"}}},
+		{caller: admin, calls: 6, want: model.User{ID: target, FirstName: "Jane", LastName: "Doe", Nickname: "jd", Email: "jane@x.com",
+			Roles: []model.Role{model.RoleSupport}, MaskedFields: []string{"country"}}},
+	}
+	for _, test := range tests {
+		t.Run(string(test.caller), func(t *testing.T) {
+			users := returnedUsers(test.caller)
+			require.Len(t, users, test.calls)
+			for _, user := range users {
+				assert.Equal(t, test.want, user)
+			}
+		})
+	}
+
+	// the exports are redacted by the usecase with the same policy, before they are signed
+	_, err := svc.ExportUserData(self.context(), model.ExportUserDataArgs{UserID: target, FieldPolicy: model.FieldPolicy{}})
+	require.NoError(t, err)
+	assert.Equal(t, policy, usecase.exportArgs.FieldPolicy)
+}
+
+func TestUserService_DefaultFieldPolicy(t *testing.T) {
+	svc := NewUserService(UserServiceArgs{Usecase: &MockUsecase{}})
+
+	// the support reads the email and the real name, the signup services do not
+	got, err := svc.GetUser(support.context(), model.GetUserArgs{ID: target})
+	require.NoError(t, err)
+	assert.Equal(t, "jane@x.com", got.User.Email)
+	assert.Empty(t, got.User.MaskedFields)
+	created, err := svc.CreateUser(signup.context(), model.CreateUserArgs{})
+	require.NoError(t, err)
+	assert.Empty(t, created.User.Email)
+	assert.Equal(t, "BR", created.User.Country)
+	assert.Equal(t, []string{"email", "first_name", "last_name"}, created.User.MaskedFields)
+}
+
+func TestUserService_AuditEntriesAreRedacted(t *testing.T) {
+	svc := NewUserService(UserServiceArgs{Usecase: &MockUsecase{}})
+
+	// the users do not see who else acted on their account nor from where
+	got, err := svc.ListAuditEntries(self.context(), model.ListAuditEntriesArgs{UserID: target})
+	require.NoError(t, err)
+	require.Len(t, got.Entries, 2)
+	assert.Equal(t, target.String(), got.Entries[0].ActorID)
+	assert.Equal(t, "10.0.0.1", got.Entries[0].SourceIP)
+	assert.Equal(t, model.AuditEntry{UserID: target, Action: model.AuditActionSuspend}, got.Entries[1])
+	_, err = svc.ListAuditEntries(self.context(), model.ListAuditEntriesArgs{UserID: target, ActorID: "admin"})
+	require.ErrorIs(t, err, model.ErrPermissionDenied)
+	_, err = svc.ListAuditEntries(self.context(), model.ListAuditEntriesArgs{UserID: target, ActorID: target.String()})
+	require.NoError(t, err)
+
+	// the staff see the entries whole
+	got, err = svc.ListAuditEntries(support.context(), model.ListAuditEntriesArgs{UserID: target, ActorID: "admin"})
+	require.NoError(t, err)
+	assert.Equal(t, "admin", got.Entries[1].ActorID)
+	assert.Equal(t, "10.0.0.2", got.Entries[1].SourceIP)
+}
+
+func TestAuthorize_OperationWithoutPolicyIsDenied(t *testing.T) {
+	err := Authorize(admin.context(), Operation("Unknown"), target)
+	require.ErrorIs(t, err, model.ErrPermissionDenied)
+}
+
+func contains(callers []caller, c caller) bool {
+	for _, allowed := range callers {
+		if allowed == c {
+			return true
+		}
+	}
+	return false
+}
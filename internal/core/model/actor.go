@@ -0,0 +1,69 @@
+package model
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Role is a named set of permissions that can be granted to an actor.
+type Role string
+
+const (
+	// RoleAdmin grants access to every user operation.
+	RoleAdmin Role = "admin"
+
+	// RoleSupport grants read access to every user and the soft-deletion of users.
+	RoleSupport Role = "support"
+
+	// RoleSignup grants the creation of users, to the services running the signup flow.
+	RoleSignup Role = "signup"
+
+	// RoleBlockCheck grants checking whether a user blocked another, to the services pairing the users, e.g. the chat
+	// and the matchmaking.
+	RoleBlockCheck Role = "block_check"
+
+	// RoleSuspension grants the suspension of users and the reading of their suspensions, to the services sanctioning
+	// the users, e.g. the anti-cheat.
+	RoleSuspension Role = "suspension"
+
+	// RoleVerification grants recording the verification of the users, to the service sending the verification emails.
+	RoleVerification Role = "verification"
+)
+
+// Actor is the identity on whose behalf an operation is performed.
+type Actor struct {
+	// ID identifies the actor. For end-users it is the user ID.
+	ID string
+
+	// Roles are the roles granted to the actor.
+	Roles []Role
+}
+
+// HasRole reports whether the role was granted to the actor.
+func (a Actor) HasRole(role Role) bool {
+	for _, r := range a.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUser reports whether the actor is the user identified by id.
+func (a Actor) IsUser(id uuid.UUID) bool {
+	return a.ID == id.String()
+}
+
+type actorContextKey struct{}
+
+// ContextWithActor returns a copy of ctx carrying the actor.
+func ContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx. The boolean is false if ctx carries no actor.
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorContextKey{}).(Actor)
+	return actor, ok
+}
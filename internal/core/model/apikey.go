@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey is a credential of a service calling the API on its own behalf.
+type APIKey struct {
+	// ID is the unique identifier of the key.
+	ID uuid.UUID
+
+	// Name describes the holder of the key, e.g. the name of the calling service.
+	Name string
+
+	// Hash is the SHA-256 digest of the key, hex encoded. The key itself is not stored.
+	Hash string
+
+	// Scopes are the roles granted to the holder of the key.
+	Scopes []Role
+
+	// CreatedBy is the ID of the actor that created the key.
+	CreatedBy string
+
+	// CreatedAt is the time of the creation of the key.
+	CreatedAt time.Time
+
+	// ExpiresAt is the time after which the key is rejected. Zero-valued if the key does not expire.
+	ExpiresAt time.Time
+
+	// RevokedAt is the time at which the key was revoked. Zero-valued if the key was not revoked.
+	RevokedAt time.Time
+}
+
+// Active reports whether the key is accepted at the given time.
+func (k APIKey) Active(at time.Time) bool {
+	return k.RevokedAt.IsZero() && (k.ExpiresAt.IsZero() || k.ExpiresAt.After(at))
+}
+
+// Actor returns the actor the calls authenticated by the key are performed on behalf of.
+func (k APIKey) Actor() Actor {
+	return Actor{ID: "apikey:" + k.ID.String(), Roles: k.Scopes}
+}
@@ -0,0 +1,32 @@
+package model
+
+// AttributeSchema is the JSON schema the values of a custom profile attribute must conform to. Only a subset of the
+// JSON schema keywords is supported.
+type AttributeSchema struct {
+	// Type is the JSON type of the values: "string", "number", "integer", "boolean" or "array".
+	Type string `json:"type"`
+
+	// Enum lists the allowed values. Optional.
+	Enum []interface{} `json:"enum,omitempty"`
+
+	// MinLength is the minimum number of characters of string values. Optional.
+	MinLength *int `json:"minLength,omitempty"`
+
+	// MaxLength is the maximum number of characters of string values. Optional.
+	MaxLength *int `json:"maxLength,omitempty"`
+
+	// Pattern is the regular expression string values must match. Optional.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Minimum is the minimum of number and integer values. Optional.
+	Minimum *float64 `json:"minimum,omitempty"`
+
+	// Maximum is the maximum of number and integer values. Optional.
+	Maximum *float64 `json:"maximum,omitempty"`
+
+	// Items is the schema of the items of array values. Optional.
+	Items *AttributeSchema `json:"items,omitempty"`
+
+	// MaxItems is the maximum number of items of array values. Optional.
+	MaxItems *int `json:"maxItems,omitempty"`
+}
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction is the kind of operation recorded in the audit trail.
+type AuditAction string
+
+const (
+	// AuditActionCreate records the creation of a user.
+	AuditActionCreate AuditAction = "create"
+
+	// AuditActionUpdate records an update of the user profile.
+	AuditActionUpdate AuditAction = "update"
+
+	// AuditActionDelete records a soft-deletion of a user.
+	AuditActionDelete AuditAction = "delete"
+
+	// AuditActionHardDelete records a hard-deletion of a user.
+	AuditActionHardDelete AuditAction = "hard_delete"
+
+	// AuditActionRestore records the restoration of a soft-deleted user.
+	AuditActionRestore AuditAction = "restore"
+
+	// AuditActionPasswordChange records a change of the user password.
+	AuditActionPasswordChange AuditAction = "password_change"
+
+	// AuditActionExport records an export of the user data.
+	AuditActionExport AuditAction = "export"
+
+	// AuditActionExportDenied records an export attempt that was not authorized.
+	AuditActionExportDenied AuditAction = "export_denied"
+
+	// AuditActionErase records an erasure of the user personal data.
+	AuditActionErase AuditAction = "erase"
+
+	// AuditActionLock records the lock of the account after too many failed credential checks.
+	AuditActionLock AuditAction = "lock"
+
+	// AuditActionUnlock records the lift of the lock of the account.
+	AuditActionUnlock AuditAction = "unlock"
+
+	// AuditActionRevokeSession records the revocation of a session of the user.
+	AuditActionRevokeSession AuditAction = "revoke_session"
+
+	// AuditActionEnableTOTP records the enablement of TOTP as second factor.
+	AuditActionEnableTOTP AuditAction = "enable_totp"
+
+	// AuditActionDisableTOTP records the disablement of TOTP as second factor.
+	AuditActionDisableTOTP AuditAction = "disable_totp"
+
+	// AuditActionGrantRole records the grant of a role to the user.
+	AuditActionGrantRole AuditAction = "grant_role"
+
+	// AuditActionRevokeRole records the revocation of a role of the user.
+	AuditActionRevokeRole AuditAction = "revoke_role"
+
+	// AuditActionSuspend records the suspension of the user.
+	AuditActionSuspend AuditAction = "suspend"
+
+	// AuditActionLiftSuspension records the lift of the suspension of the user.
+	AuditActionLiftSuspension AuditAction = "lift_suspension"
+
+	// AuditActionVerify records the verification of the email of the user.
+	AuditActionVerify AuditAction = "verify"
+
+	// AuditActionRequestDeletion records the request of the user for the deletion of their account.
+	AuditActionRequestDeletion AuditAction = "request_deletion"
+
+	// AuditActionCancelDeletion records the cancellation of the scheduled deletion of the account.
+	AuditActionCancelDeletion AuditAction = "cancel_deletion"
+
+	// AuditActionExpireSuspension records the end of the suspension of the user at its expiry.
+	AuditActionExpireSuspension AuditAction = "expire_suspension"
+
+	// AuditActionBlock records the block of another user by the user.
+	AuditActionBlock AuditAction = "block"
+
+	// AuditActionUnblock records the lift of a block of the user.
+	AuditActionUnblock AuditAction = "unblock"
+)
+
+// AuditEntry is an append-only record of an operation performed on a user.
+type AuditEntry struct {
+	// ID is the unique identifier of the entry.
+	ID uuid.UUID `json:"id"`
+
+	// UserID is the id of the user the operation was performed on.
+	UserID uuid.UUID `json:"user_id"`
+
+	// ActorID is the id of the actor that performed the operation. Empty if the actor is unknown or redacted.
+	ActorID string `json:"actor_id"`
+
+	// Action is the operation that was performed.
+	Action AuditAction `json:"action"`
+
+	// RequestID is the id of the request that performed the operation. Empty if unknown or redacted.
+	RequestID string `json:"request_id,omitempty"`
+
+	// SourceIP is the IP address of the client that originated the request. Empty if unknown or redacted.
+	SourceIP string `json:"source_ip,omitempty"`
+
+	// ChangedFields are the names of the user fields modified by the operation. Values are never recorded.
+	ChangedFields []string `json:"changed_fields,omitempty"`
+
+	// OccurredAt is the time at which the operation was performed.
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Redact returns the entry as seen by the actor. The staff, with the support or admin role, see the entries whole. The
+// other actors, i.e. the users reading their own trail, see what was done to their account but not who did it nor from
+// where, unless they did it themselves.
+func (e AuditEntry) Redact(actor Actor) AuditEntry {
+	if actor.HasRole(RoleSupport) || actor.HasRole(RoleAdmin) || e.ActorID == actor.ID {
+		return e
+	}
+	e.ActorID, e.RequestID, e.SourceIP = "", "", ""
+	return e
+}
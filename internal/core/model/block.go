@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Block is the blocking of a user by another user, e.g. of a harasser. Blocks are private to the blocker.
+type Block struct {
+	// BlockerID is the id of the user who blocked the other one.
+	BlockerID uuid.UUID
+
+	// BlockedID is the id of the blocked user.
+	BlockedID uuid.UUID
+
+	// CreatedAt is the time of the block.
+	CreatedAt time.Time
+}
@@ -0,0 +1,67 @@
+package model
+
+import "strings"
+
+// emailProvider holds the addressing rules of a mail provider.
+type emailProvider struct {
+	// domain is the domain the aliases of the provider resolve to, e.g. googlemail.com to gmail.com.
+	domain string
+
+	// ignoresDots tells whether the dots of the local part are ignored, e.g. j.doe@gmail.com reaches jdoe@gmail.com.
+	ignoresDots bool
+
+	// subaddressing tells whether a plus-tag can be appended to the local part, e.g. jdoe+games@outlook.com reaches
+	// jdoe@outlook.com.
+	subaddressing bool
+}
+
+// emailProviders are the rules of the common mail providers, by domain.
+var emailProviders = map[string]emailProvider{
+	"gmail.com":      {domain: "gmail.com", ignoresDots: true, subaddressing: true},
+	"googlemail.com": {domain: "gmail.com", ignoresDots: true, subaddressing: true},
+	"outlook.com":    {domain: "outlook.com", subaddressing: true},
+	"hotmail.com":    {domain: "hotmail.com", subaddressing: true},
+	"live.com":       {domain: "live.com", subaddressing: true},
+	"icloud.com":     {domain: "icloud.com", subaddressing: true},
+	"me.com":         {domain: "icloud.com", subaddressing: true},
+	"mac.com":        {domain: "icloud.com", subaddressing: true},
+	"protonmail.com": {domain: "protonmail.com", subaddressing: true},
+	"proton.me":      {domain: "proton.me", subaddressing: true},
+	"fastmail.com":   {domain: "fastmail.com", subaddressing: true},
+}
+
+// CanonicalEmail returns the key the emails are compared by: two emails with the same key reach the same mailbox, e.g.
+// "John.Doe+games@GoogleMail.com" and "johndoe@gmail.com". The local part is folded to lowercase, as done by virtually
+// all the providers, and the rules of the provider of the domain apply. The key only tells the users apart, the emails
+// are kept as typed for display.
+func CanonicalEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return email
+	}
+	local, domain := email[:at], strings.TrimSuffix(email[at+1:], ".")
+	provider, ok := emailProviders[domain]
+	if !ok {
+		return local + "@" + domain
+	}
+	if provider.subaddressing {
+		if plus := strings.Index(local, "+"); plus > 0 {
+			local = local[:plus]
+		}
+	}
+	if provider.ignoresDots {
+		local = strings.ReplaceAll(local, ".", "")
+	}
+	return local + "@" + provider.domain
+}
+
+// EmailDomain returns the lowercase domain of the email, empty if it has none.
+func EmailDomain(email string) string {
+	email = strings.TrimSpace(email)
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.TrimSuffix(strings.ToLower(email[at+1:]), ".")
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{email: "john@example.com", want: "john@example.com"},
+		{email: " John@X.com ", want: "john@x.com"},
+		{email: "john.doe+news@example.com", want: "john.doe+news@example.com"},
+		{email: "John.Doe+News@Example.com", want: "john.doe+news@example.com"},
+		{email: "John.Doe@gmail.com", want: "johndoe@gmail.com"},
+		{email: "j.o.h.n.doe+games@GoogleMail.com", want: "johndoe@gmail.com"},
+		{email: "john.doe+games@outlook.com", want: "john.doe@outlook.com"},
+		{email: "john+games@me.com", want: "john@icloud.com"},
+		{email: "+john@gmail.com", want: "+john@gmail.com"},
+		{email: "john@gmail.com.", want: "john@gmail.com"},
+		{email: "\"john@doe\"@example.com", want: "\"john@doe\"@example.com"},
+		{email: "john", want: "john"},
+	}
+	for _, test := range tests {
+		t.Run(test.email, func(t *testing.T) {
+			assert.Equal(t, test.want, CanonicalEmail(test.email))
+		})
+	}
+}
+
+func TestEmailDomain(t *testing.T) {
+	assert.Equal(t, "x.com", EmailDomain("John@X.com"))
+	assert.Equal(t, "mail.example.com", EmailDomain("john@Mail.Example.com."))
+	assert.Equal(t, "", EmailDomain("john"))
+}
@@ -5,4 +5,31 @@ import "errors"
 var (
 	// ErrNotFound is returned when an entity is required to exist and does not. 
 	ErrNotFound = errors.New("entity was not found")
+
+	// ErrUnauthenticated is returned when an operation requires an actor and none is present.
+	ErrUnauthenticated = errors.New("actor is not authenticated")
+
+	// ErrPermissionDenied is returned when the actor is not allowed to perform an operation.
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrInvalidCredentials is returned when the credentials supplied by the actor do not match the stored ones.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+
+	// ErrSuspended is returned when a suspended user logs in or refreshes their session.
+	ErrSuspended = errors.New("account is suspended")
+
+	// ErrInvalidArgument is returned when an argument is rejected by a validation that only the usecase can perform,
+	// e.g. against a configuration. It is wrapped along with the reason.
+	ErrInvalidArgument = errors.New("invalid argument")
+
+	// ErrFailedPrecondition is returned when an operation is not allowed in the current state of the entity. It is
+	// wrapped along with the reason.
+	ErrFailedPrecondition = errors.New("failed precondition")
+
+	// ErrAlreadyExists is returned when a value that must be unique, e.g. a reserved nickname, is taken already. It is
+	// wrapped along with the reason.
+	ErrAlreadyExists = errors.New("already exists")
+
+	// ErrDataKeyDestroyed is returned when the data key of a user is needed after it was destroyed by an erasure.
+	ErrDataKeyDestroyed = errors.New("data key was destroyed")
 )
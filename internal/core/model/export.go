@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+)
+
+// DataExport is the archive of all the data held about a user. It is serialised as JSON.
+//
+// The archive holds no consents: the service processes the users data to perform the contract they agreed to at
+// signup and records no consent of its own. Any data about a user held in the future must be added here.
+type DataExport struct {
+	// GeneratedAt is the time at which the archive was generated.
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// User is the user profile, including the deletion timestamp. The password hash is never exported.
+	User User `json:"user"`
+
+	// AuditTrail contains the audit entries recorded for the user.
+	AuditTrail []AuditEntry `json:"audit_trail"`
+}
+
+// Signature is a detached signature over a payload.
+type Signature struct {
+	// Algorithm is the signing algorithm (e.g. Ed25519).
+	Algorithm string
+
+	// KeyID identifies the key used to sign the payload.
+	KeyID string
+
+	// Value is the signature itself.
+	Value []byte
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FriendshipStatus is the state of a Friendship.
+type FriendshipStatus string
+
+const (
+	// FriendshipStatusPending is the status of a friend request awaiting the answer of the addressee.
+	FriendshipStatusPending FriendshipStatus = "pending"
+
+	// FriendshipStatusAccepted is the status of a friend request accepted by the addressee.
+	FriendshipStatusAccepted FriendshipStatus = "accepted"
+)
+
+// Friendship is the relationship between two users, started by a friend request of the requester to the addressee.
+// Declined requests and removed friendships are not kept.
+type Friendship struct {
+	// RequesterID is the id of the user who sent the friend request.
+	RequesterID uuid.UUID
+
+	// AddresseeID is the id of the user the friend request was sent to.
+	AddresseeID uuid.UUID
+
+	// Status is the state of the friendship.
+	Status FriendshipStatus
+
+	// CreatedAt is the time of the friend request.
+	CreatedAt time.Time
+
+	// AcceptedAt is the time at which the friend request was accepted. Zero-valued while pending.
+	AcceptedAt time.Time
+}
+
+// FriendID returns the id of the other user of the friendship, from the point of view of the given user.
+func (f Friendship) FriendID(userID uuid.UUID) uuid.UUID {
+	if f.RequesterID == userID {
+		return f.AddresseeID
+	}
+	return f.RequesterID
+}
+
+// FriendshipEventType is the kind of a FriendshipEvent.
+type FriendshipEventType string
+
+const (
+	// FriendshipEventRequested is published when a user sends a friend request.
+	FriendshipEventRequested FriendshipEventType = "requested"
+
+	// FriendshipEventAccepted is published when a friend request is accepted.
+	FriendshipEventAccepted FriendshipEventType = "accepted"
+
+	// FriendshipEventDeclined is published when a pending friend request is declined by the addressee or withdrawn by
+	// the requester.
+	FriendshipEventDeclined FriendshipEventType = "declined"
+
+	// FriendshipEventRemoved is published when a friendship is removed by either user.
+	FriendshipEventRemoved FriendshipEventType = "removed"
+)
+
+// FriendshipEvent describes a change of the relationship between two users.
+type FriendshipEvent struct {
+	// Type is the kind of event.
+	Type FriendshipEventType
+
+	// RequesterID is the id of the user who sent the friend request.
+	RequesterID uuid.UUID
+
+	// AddresseeID is the id of the user the friend request was sent to.
+	AddresseeID uuid.UUID
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// CredentialScope is what the failed credential checks are counted against.
+type CredentialScope string
+
+const (
+	// CredentialScopeEmail counts the failures per account, keyed by the email the credentials are checked for, whether
+	// or not an account holds it, so that the locks do not tell the existing accounts apart.
+	CredentialScopeEmail CredentialScope = "email"
+
+	// CredentialScopeIP counts the failures per source IP, whatever the account.
+	CredentialScopeIP CredentialScope = "ip"
+)
+
+// CredentialFailures counts the consecutive failed credential checks of an account or of a source IP.
+type CredentialFailures struct {
+	// Scope is what the failures are counted against.
+	Scope CredentialScope
+
+	// Key identifies the account or the source IP within the scope.
+	Key string
+
+	// Failures is the number of consecutive failures.
+	Failures int
+
+	// LastFailureAt is the time of the last failure.
+	LastFailureAt time.Time
+
+	// LockedUntil is the time until which the credential checks are rejected. Zero-valued if never locked.
+	LockedUntil time.Time
+}
@@ -0,0 +1,71 @@
+package model
+
+import "fmt"
+
+// MaskableFields are the personal fields of the users that a FieldPolicy can mask, by name.
+var MaskableFields = []string{"email", "first_name", "last_name", "country"}
+
+// maskFields are the functions clearing the maskable fields of a user, by name.
+var maskFields = map[string]func(user *User){
+	"email":      func(user *User) { user.Email = "" },
+	"first_name": func(user *User) { user.FirstName = "" },
+	"last_name":  func(user *User) { user.LastName = "" },
+	"country":    func(user *User) { user.Country = "" },
+}
+
+// FieldPolicy lists the roles granted each personal field of the users, by field name. The fields are masked from the
+// actors that are neither the user themselves nor hold a granted role. The fields not listed are never masked, an empty
+// policy disables the masking.
+type FieldPolicy map[string][]Role
+
+// DefaultFieldPolicy grants the email and the real name of the users to the support and the admins.
+var DefaultFieldPolicy = FieldPolicy{
+	"email":      {RoleSupport, RoleAdmin},
+	"first_name": {RoleSupport, RoleAdmin},
+	"last_name":  {RoleSupport, RoleAdmin},
+}
+
+// Validate returns an error wrapping ErrInvalidArgument if the policy lists a field that cannot be masked.
+func (p FieldPolicy) Validate() error {
+	for field := range p {
+		if _, ok := maskFields[field]; !ok {
+			return fmt.Errorf("%w: field %q cannot be masked", ErrInvalidArgument, field)
+		}
+	}
+	return nil
+}
+
+// Mask clears the fields of the user that the actor is not granted and lists them in user.MaskedFields, in the order
+// of MaskableFields. The users are granted all their own fields.
+func (p FieldPolicy) Mask(actor Actor, user *User) {
+	user.MaskedFields = nil
+	if actor.IsUser(user.ID) {
+		return
+	}
+	for _, field := range MaskableFields {
+		roles, ok := p[field]
+		if !ok || actor.hasAnyRole(roles) {
+			continue
+		}
+		maskFields[field](user)
+		user.MaskedFields = append(user.MaskedFields, field)
+	}
+}
+
+// Redact shapes the user returned to the actor: the fields the actor is not granted are masked, see Mask, and the roles
+// are hidden from the actors that cannot manage them, i.e. all but the admins.
+func (p FieldPolicy) Redact(actor Actor, user *User) {
+	p.Mask(actor, user)
+	if !actor.HasRole(RoleAdmin) {
+		user.Roles = nil
+	}
+}
+
+func (a Actor) hasAnyRole(roles []Role) bool {
+	for _, role := range roles {
+		if a.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldPolicy_Mask(t *testing.T) {
+	id := uuid.New()
+	user := User{ID: id, FirstName: "Jane", LastName: "Doe", Nickname: "jd", Email: "jane@x.com", Country: "BR"}
+	tests := []struct {
+		name   string
+		policy FieldPolicy
+		actor  Actor
+		want   User
+	}{
+		{
+			name:   "the user sees their own fields",
+			policy: DefaultFieldPolicy,
+			actor:  Actor{ID: id.String()},
+			want:   user,
+		},
+		{
+			name:   "the granted roles see the fields",
+			policy: DefaultFieldPolicy,
+			actor:  Actor{ID: "backoffice", Roles: []Role{RoleSupport}},
+			want:   user,
+		},
+		{
+			name:   "the other users do not",
+			policy: DefaultFieldPolicy,
+			actor:  Actor{ID: uuid.NewString()},
+			want:   User{ID: id, Nickname: "jd", Country: "BR", MaskedFields: []string{"email", "first_name", "last_name"}},
+		},
+		{
+			name:   "the actors without identity do not",
+			policy: DefaultFieldPolicy,
+			want:   User{ID: id, Nickname: "jd", Country: "BR", MaskedFields: []string{"email", "first_name", "last_name"}},
+		},
+		{
+			name:   "each field has its own roles",
+			policy: FieldPolicy{"email": {RoleAdmin}, "country": {RoleSupport, RoleAdmin}},
+			actor:  Actor{ID: "backoffice", Roles: []Role{RoleSupport}},
+			want:   User{ID: id, FirstName: "Jane", LastName: "Doe", Nickname: "jd", Country: "BR", MaskedFields: []string{"email"}},
+		},
+		{
+			name:   "a field granted to no role is masked from everyone but the user",
+			policy: FieldPolicy{"last_name": nil},
+			actor:  Actor{ID: "admin", Roles: []Role{RoleAdmin}},
+			want:   User{ID: id, FirstName: "Jane", Nickname: "jd", Email: "jane@x.com", Country: "BR", MaskedFields: []string{"last_name"}},
+		},
+		{
+			name:   "an empty policy masks nothing",
+			policy: FieldPolicy{},
+			actor:  Actor{ID: uuid.NewString()},
+			want:   user,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := user
+			test.policy.Mask(test.actor, &got)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestFieldPolicy_Validate(t *testing.T) {
+	assert.NoError(t, DefaultFieldPolicy.Validate())
+	assert.NoError(t, FieldPolicy{"country": {RoleAdmin}}.Validate())
+	assert.ErrorIs(t, FieldPolicy{"nickname": {RoleAdmin}}.Validate(), ErrInvalidArgument)
+}
@@ -37,6 +37,74 @@ type User struct {
 
 	// DeletedAt is the time at which the user was deleted. Zero-valued if user not deleted
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
+
+	// ErasedAt is the time at which the user personal data was erased. Zero-valued if user not erased
+	ErasedAt time.Time `json:"erased_at,omitempty"`
+
+	// LockedUntil is the time until which the credential checks of the user are rejected after too many failures.
+	// Zero-valued if the user was never locked.
+	LockedUntil time.Time `json:"locked_until,omitempty"`
+
+	// TOTPEnabledAt is the time at which the user enabled TOTP as second factor. Zero-valued if TOTP is not enabled.
+	TOTPEnabledAt time.Time `json:"totp_enabled_at,omitempty"`
+
+	// Roles are the roles granted to the user, sorted by name.
+	Roles []Role `json:"roles,omitempty"`
+
+	// Attributes are the custom profile attributes of the user, by key. Their values are decoded JSON values
+	// conforming to the schema of the key in the attribute registry.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	// SuspensionReason is the reason code of the suspension of the user. Empty if the user is not suspended.
+	SuspensionReason SuspensionReason `json:"suspension_reason,omitempty"`
+
+	// SuspendedAt is the time at which the user was suspended. Zero-valued if the user is not suspended.
+	SuspendedAt time.Time `json:"suspended_at,omitempty"`
+
+	// SuspendedUntil is the time at which the suspension of the user expires. Zero-valued for bans and if the user is
+	// not suspended.
+	SuspendedUntil time.Time `json:"suspended_until,omitempty"`
+
+	// Status is the state of the account of the user. It only changes through the transitions allowed by
+	// UserStatus.CanTransitionTo.
+	Status UserStatus `json:"status"`
+
+	// VerifiedAt is the time at which the email of the user was verified. Zero-valued if the email is not verified.
+	VerifiedAt time.Time `json:"verified_at,omitempty"`
+
+	// DeletionScheduledFor is the time at which the deletion requested by the user is carried out. Zero-valued unless
+	// the user is scheduled for deletion.
+	DeletionScheduledFor time.Time `json:"deletion_scheduled_for,omitempty"`
+
+	// ProfileVisibility decides who can see the public profile of the user. Empty means ProfileVisibilityPublic.
+	ProfileVisibility ProfileVisibility `json:"profile_visibility,omitempty"`
+
+	// MaskedFields are the personal fields cleared from the user because the actor reading it is not granted them, see
+	// FieldPolicy. Never stored.
+	MaskedFields []string `json:"masked_fields,omitempty"`
+}
+
+// Actor returns the actor acting on behalf of the user, holding their roles.
+func (u User) Actor() Actor {
+	return Actor{ID: u.ID.String(), Roles: u.Roles}
+}
+
+// Suspended reports whether the user is suspended at the given time. The suspensions stop applying as soon as they
+// expire, before the worker processes their expiry.
+func (u User) Suspended(at time.Time) bool {
+	return !u.SuspendedAt.IsZero() && (u.SuspendedUntil.IsZero() || u.SuspendedUntil.After(at))
+}
+
+// UserVersion is the state of a user during a period of time.
+type UserVersion struct {
+	// User is the state of the user during the period. The password hash is never versioned.
+	User User
+
+	// ValidFrom is the time from which the version was the current state of the user.
+	ValidFrom time.Time
+
+	// ValidTo is the time at which the version was superseded. Zero-valued for the current version.
+	ValidTo time.Time
 }
 
 // UserEvent collects a user change. It can represent creation, update and deletion of a user.
@@ -49,4 +117,88 @@ type UserEvent struct {
 
 	// After is the user state after the event. It will be nil in case of hard-deletions.
 	After *User
+
+	// Erasure is set when the user personal data was erased. Before and After are then nil.
+	Erasure *UserErasure
+
+	// Security is set for events relevant to the security of the account. Before and After are then nil.
+	Security *SecurityEvent
+
+	// Friendship is set for changes of the relationship between two users. Before and After are then nil.
+	Friendship *FriendshipEvent
+
+	// AccountDeletion is set for the steps of the deletion requested by a user. Before and After are then nil.
+	AccountDeletion *AccountDeletionEvent
+}
+
+// UserErasure describes the erasure of the personal data of a user.
+type UserErasure struct {
+	// UserID is the id of the erased user.
+	UserID uuid.UUID
+
+	// ErasedAt is the time at which the user data was erased.
+	ErasedAt time.Time
+}
+
+// SecurityEventType is the kind of a SecurityEvent.
+type SecurityEventType string
+
+const (
+	// SecurityEventAccountLocked is published when an account is locked after too many failed credential checks.
+	SecurityEventAccountLocked SecurityEventType = "account_locked"
+)
+
+// SecurityEvent describes an event relevant to the security of an account, e.g. for the trust and safety team.
+type SecurityEvent struct {
+	// UserID is the id of the user concerned by the event.
+	UserID uuid.UUID
+
+	// Type is the kind of event.
+	Type SecurityEventType
+
+	// LockedUntil is the time until which the account is locked, for SecurityEventAccountLocked.
+	LockedUntil time.Time
+}
+
+// AccountDeletionEventType is the kind of an AccountDeletionEvent.
+type AccountDeletionEventType string
+
+const (
+	// AccountDeletionScheduled is published when a user requests the deletion of their account.
+	AccountDeletionScheduled AccountDeletionEventType = "scheduled"
+
+	// AccountDeletionCancelled is published when the scheduled deletion is cancelled before the end of the grace
+	// period, by the user or by a suspension.
+	AccountDeletionCancelled AccountDeletionEventType = "cancelled"
+
+	// AccountDeletionCompleted is published when the account scheduled for deletion is deleted, usually by the worker
+	// at the end of the grace period.
+	AccountDeletionCompleted AccountDeletionEventType = "completed"
+)
+
+// AccountDeletionEvent describes a step of the deletion of an account requested by its user.
+type AccountDeletionEvent struct {
+	// UserID is the id of the user leaving.
+	UserID uuid.UUID
+
+	// Type is the kind of event.
+	Type AccountDeletionEventType
+
+	// ScheduledFor is the time at which the deletion is, or was, to be carried out.
+	ScheduledFor time.Time
+}
+
+// DataKey is the per-user key protecting the personal data of a user. It is stored wrapped by a master key.
+type DataKey struct {
+	// UserID is the id of the user the key belongs to.
+	UserID uuid.UUID
+
+	// WrappedKey is the data key encrypted with the master key. Empty if the key was destroyed.
+	WrappedKey []byte
+
+	// CreatedAt is the time at which the key was created.
+	CreatedAt time.Time
+
+	// DestroyedAt is the time at which the key was destroyed. Zero-valued if the key was not destroyed.
+	DestroyedAt time.Time
 }
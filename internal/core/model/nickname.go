@@ -0,0 +1,183 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NicknameChange is a change of the nickname of a user, recorded in the nickname history.
+type NicknameChange struct {
+	// ID is the unique identifier of the change.
+	ID uuid.UUID `json:"id"`
+
+	// UserID is the id of the user who changed their nickname.
+	UserID uuid.UUID `json:"user_id"`
+
+	// OldNickname is the nickname released by the change.
+	OldNickname string `json:"old_nickname"`
+
+	// NewNickname is the nickname taken by the change.
+	NewNickname string `json:"new_nickname"`
+
+	// ChangedAt is the time of the change.
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// nicknameIgnorables are the invisible characters dropped from the nicknames: zero-width spaces and joiners, direction
+// marks, word joiners, the soft hyphen and the byte order mark.
+var nicknameIgnorables = []string{
+	"\u00ad", "\u034f", "\u180e", "\u200b", "\u200c", "\u200d", "\u200e", "\u200f",
+	"\u2060", "\u2061", "\u2062", "\u2063", "\u2064", "\ufeff",
+}
+
+// nicknameConfusables are the pairs of a character and the letter it passes for in a lowercase nickname: the Cyrillic
+// and Greek homoglyphs of the Latin letters, then the digits and symbols commonly used in place of a letter. The
+// separators and the invisible characters fold to nothing. A change of the pairs comes with a migration clearing the
+// stored keys, which the service recomputes on startup.
+var nicknameConfusables = []string{
+	// Cyrillic
+	"а", "a", "в", "b", "е", "e", "ё", "e", "һ", "h", "н", "h", "і", "l", "ї", "l", "ј", "j", "к", "k", "м", "m",
+	"о", "o", "р", "p", "ԛ", "q", "с", "c", "ѕ", "s", "т", "t", "у", "y", "ѵ", "v", "ԝ", "w", "х", "x", "ԁ", "d",
+	// Greek
+	"α", "a", "β", "b", "γ", "y", "ε", "e", "η", "n", "ι", "l", "κ", "k", "ν", "v", "ο", "o", "ρ", "p", "τ", "t",
+	"υ", "u", "χ", "x", "ω", "w",
+	// digits and symbols
+	"0", "o", "1", "l", "i", "l", "|", "l", "3", "e", "4", "a", "@", "a", "5", "s", "$", "s", "7", "t", "8", "b",
+	// separators
+	"-", "", "_", "", ".", "", " ", "",
+}
+
+var nicknameConfusablesReplacer = strings.NewReplacer(append(foldToNothing(nicknameIgnorables), nicknameConfusables...)...)
+
+// NormalizeNickname returns the form the nicknames are stored in: NFKC normalized, e.g. full-width or ligature
+// characters are replaced by their plain counterpart, and with single spaces. The invisible characters are kept, e.g.
+// the zero-width joiners of the emoji sequences and of the scripts that need them, only the keys ignore them.
+func NormalizeNickname(nickname string) string {
+	return strings.Join(strings.Fields(norm.NFKC.String(nickname)), " ")
+}
+
+// NicknameKey returns the skeleton the nicknames are compared by: two nicknames with the same key are deemed the same
+// nickname, e.g. "J0hn_Doe", "johndoe" and "јоhndое" with Cyrillic letters. The invisible characters are ignored.
+func NicknameKey(nickname string) string {
+	return nicknameConfusablesReplacer.Replace(strings.ToLower(norm.NFKC.String(nickname)))
+}
+
+// NicknameLength returns the length of the nickname in user-perceived characters, i.e. its extended grapheme clusters
+// as segmented by UAX #29: the combining marks, the variation selectors and the emoji modifiers count with the character
+// they apply to, a pair of regional indicators is a single flag and an emoji sequence joined by zero-width joiners is a
+// single emoji. The clusters made of invisible characters only do not count. It also returns the largest number of
+// combining marks applied to a single character.
+func NicknameLength(nickname string) (length, maxMarks int) {
+	graphemes := uniseg.NewGraphemes(nickname)
+	for graphemes.Next() {
+		visible, marks := false, 0
+		for _, r := range graphemes.Runes() {
+			switch {
+			case IsNicknameIgnorable(r):
+				continue
+			case unicode.Is(unicode.M, r) && !unicode.Is(unicode.Variation_Selector, r):
+				marks++
+			}
+			visible = true
+		}
+		if !visible {
+			continue
+		}
+		length++
+		if marks > maxMarks {
+			maxMarks = marks
+		}
+	}
+	return length, maxMarks
+}
+
+// IsNicknameIgnorable reports whether the character is one of the invisible characters that the nickname keys ignore,
+// see NicknameKey.
+func IsNicknameIgnorable(r rune) bool {
+	for _, ignorable := range nicknameIgnorables {
+		if string(r) == ignorable {
+			return true
+		}
+	}
+	return false
+}
+
+// NicknameRejection is the reason a nickname is rejected for.
+type NicknameRejection string
+
+const (
+	// NicknameRejectionTooShort rejects the nicknames shorter than the minimum length.
+	NicknameRejectionTooShort NicknameRejection = "too_short"
+
+	// NicknameRejectionTooLong rejects the nicknames longer than the maximum length.
+	NicknameRejectionTooLong NicknameRejection = "too_long"
+
+	// NicknameRejectionInvalidCharacters rejects the nicknames with control, private use or unassigned characters, or
+	// with characters stacking combining marks.
+	NicknameRejectionInvalidCharacters NicknameRejection = "invalid_characters"
+
+	// NicknameRejectionReserved rejects the reserved words, e.g. "admin", given by the admins only.
+	NicknameRejectionReserved NicknameRejection = "reserved"
+
+	// NicknameRejectionProfane rejects the nicknames containing a profane word.
+	NicknameRejectionProfane NicknameRejection = "profane"
+
+	// NicknameRejectionTaken rejects the nicknames confusable with the nickname of another user, see NicknameKey.
+	NicknameRejectionTaken NicknameRejection = "taken"
+)
+
+var nicknameRejectionDescriptions = map[NicknameRejection]string{
+	NicknameRejectionTooShort:          "is too short",
+	NicknameRejectionTooLong:           "is too long",
+	NicknameRejectionInvalidCharacters: "contains invalid characters",
+	NicknameRejectionReserved:          "is reserved",
+	NicknameRejectionProfane:           "contains a forbidden word",
+	NicknameRejectionTaken:             "is taken",
+}
+
+// NicknameError is the error a nickname is rejected with. It wraps ErrAlreadyExists for the taken nicknames and
+// ErrInvalidArgument otherwise.
+type NicknameError struct {
+	// Nickname is the rejected nickname.
+	Nickname string
+
+	// Rejection is the reason of the rejection.
+	Rejection NicknameRejection
+}
+
+func (e *NicknameError) Error() string {
+	return fmt.Sprintf("%s: nickname %q %s", e.Unwrap(), e.Nickname, nicknameRejectionDescriptions[e.Rejection])
+}
+
+func (e *NicknameError) Unwrap() error {
+	if e.Rejection == NicknameRejectionTaken {
+		return ErrAlreadyExists
+	}
+	return ErrInvalidArgument
+}
+
+func foldToNothing(characters []string) []string {
+	pairs := make([]string, 0, 2*len(characters))
+	for _, c := range characters {
+		pairs = append(pairs, c, "")
+	}
+	return pairs
+}
+
+// NicknameReservation is a short-lived hold on a nickname, claimed by the user created with its token.
+type NicknameReservation struct {
+	// Nickname is the reserved nickname.
+	Nickname string `json:"nickname"`
+
+	// TokenHash is the hash of the token the reservation is claimed with.
+	TokenHash string `json:"-"`
+
+	// ExpiresAt is the time the nickname is released if the reservation is not claimed.
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNicknameKey(t *testing.T) {
+	tests := []struct {
+		nickname string
+		want     string
+	}{
+		{nickname: "johndoe", want: "johndoe"},
+		{nickname: "JohnDoe", want: "johndoe"},
+		{nickname: "J0hn_Doe", want: "johndoe"},
+		{nickname: "john.doe-", want: "johndoe"},
+		{nickname: "Ace", want: "ace"},
+		{nickname: "4c3", want: "ace"},
+		{nickname: "@ce", want: "ace"},
+		{nickname: "Bill", want: "blll"},
+		{nickname: "b1|I", want: "blll"},
+		{nickname: "$7eve8", want: "steveb"},
+		{nickname: "5", want: "s"},
+		{nickname: "_ .", want: ""},
+		{nickname: "ЈОНN", want: "john"},
+		{nickname: "ѕtеvе", want: "steve"},
+		{nickname: "αce", want: "ace"},
+		{nickname: "ＡＣＥ", want: "ace"},
+		{nickname: "a\u200bc\u00ade", want: "ace"},
+	}
+	for _, test := range tests {
+		t.Run(test.nickname, func(t *testing.T) {
+			assert.Equal(t, test.want, NicknameKey(test.nickname))
+		})
+	}
+}
+
+func TestNormalizeNickname(t *testing.T) {
+	assert.Equal(t, "John Doe", NormalizeNickname("  Ｊｏｈｎ   Doe "))
+	assert.Equal(t, "John\u200b Doe\ufeff", NormalizeNickname("Ｊｏｈｎ\u200b Doe\ufeff"))
+	assert.Equal(t, "\u00e9", NormalizeNickname("e\u0301"))
+	assert.Equal(t, "fi", NormalizeNickname("\ufb01"))
+}
+
+func TestNicknameLength(t *testing.T) {
+	tests := []struct {
+		nickname string
+		length   int
+		marks    int
+	}{
+		{nickname: "ace", length: 3},
+		{nickname: "e\u0301e\u0301", length: 2, marks: 1},
+		{nickname: "z\u0301\u0302\u0303", length: 1, marks: 3},
+		{nickname: "\U0001F1F5\U0001F1F9\U0001F1EB", length: 2},
+		{nickname: "\u2764\ufe0f\U0001F44D\U0001F3FD", length: 2},
+		{nickname: "\U0001F468\u200d\U0001F469\u200d\U0001F467", length: 1},
+		{nickname: "\U0001F3F4\u200d\u2620\ufe0f", length: 1},
+		{nickname: "\u1100\u1161\u11a8", length: 1},
+		{nickname: "a\u200bb\u200c", length: 2},
+		{nickname: "\u200b\ufeff"},
+	}
+	for _, test := range tests {
+		t.Run(test.nickname, func(t *testing.T) {
+			length, marks := NicknameLength(test.nickname)
+			assert.Equal(t, test.length, length)
+			assert.Equal(t, test.marks, marks)
+		})
+	}
+}
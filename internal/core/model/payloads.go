@@ -25,6 +25,9 @@ type CreateUserArgs struct {
 
 	// Country is the user country
 	Country string
+
+	// NicknameReservationToken is the token of a reservation of the nickname, claimed by the creation. Optional.
+	NicknameReservationToken string
 }
 
 // CreateUserResponse contains the response of the CreateUser method.
@@ -41,6 +44,10 @@ type ListUsersArgs struct {
 	// Countries to which the desired users belong to. Zero-value will be ignored as filter.
 	Countries []string
 
+	// Statuses are the statuses of the desired users. Zero-value will be ignored as filter. Deleted users are only
+	// listed along with IncludeDeleted.
+	Statuses []UserStatus
+
 	// CreatedAfter is the left time boundary in which the user was created. Zero-value will be ignored as filter.
 	CreatedAfter time.Time
 
@@ -52,6 +59,13 @@ type ListUsersArgs struct {
 
 	// Offset is the offset to apply (for pagination). Zero-value will be interpreted as 0 Offset.
 	Offset uint32
+
+	// IncludeDeleted makes soft-deleted users eligible to be returned.
+	IncludeDeleted bool
+
+	// Attributes are the custom attributes the desired users have, by key, compared by their text representation
+	// (e.g. "42" or "true"). Zero-value will be ignored as filter.
+	Attributes map[string]string
 }
 
 // ListUsersResponse contains the users matching the input query of the ListUsers api.
@@ -60,6 +74,42 @@ type ListUsersResponse struct {
 	Users []User
 }
 
+// GetUserArgs contains the arguments for retrieving a single user.
+type GetUserArgs struct {
+	// ID is the id of the user.
+	ID uuid.UUID
+
+	// AsOf is the time at which the state of the user is read. Zero-value reads the current state.
+	AsOf time.Time
+
+	// IncludeDeleted makes a soft-deleted user eligible to be returned.
+	IncludeDeleted bool
+}
+
+// GetUserResponse contains the response of the GetUser method.
+type GetUserResponse struct {
+	// User
+	User User
+}
+
+// GetUserHistoryArgs contains the arguments for listing the versions of a user.
+type GetUserHistoryArgs struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Limit is the maximum amount of versions to return (for pagination). Zero-value will be interpreted as no-limit.
+	Limit uint32
+
+	// Offset is the offset to apply (for pagination). Zero-value will be interpreted as 0 Offset.
+	Offset uint32
+}
+
+// GetUserHistoryResponse contains the versions of a user, oldest first.
+type GetUserHistoryResponse struct {
+	// Versions are the versions of the user.
+	Versions []UserVersion
+}
+
 // DeleteUserArgs contains the arguments for deleting a user.
 type DeleteUserArgs struct {
 	// ID is the id of the user to be deleted.
@@ -88,6 +138,13 @@ type UpdateUserArgs struct {
 
 	// Country is the user country
 	Country string
+
+	// Attributes are the custom attributes to set, by key. Nil values remove the attribute, the attributes not
+	// listed are left untouched.
+	Attributes map[string]interface{}
+
+	// ProfileVisibility decides who can see the public profile of the user. Empty leaves it untouched.
+	ProfileVisibility ProfileVisibility
 }
 
 // UpdateUserResponse contains the response of the UpdateUser method.
@@ -95,3 +152,466 @@ type UpdateUserResponse struct {
 	// User
 	User User
 }
+
+// ExportUserDataArgs contains the arguments for exporting the data held about a user.
+type ExportUserDataArgs struct {
+	// UserID is the id of the user whose data is exported.
+	UserID uuid.UUID
+
+	// FieldPolicy redacts the exported user before the archive is signed, see FieldPolicy.Redact. It is set by the
+	// authorization layer, which shapes every user returned.
+	FieldPolicy FieldPolicy
+}
+
+// ExportUserDataResponse contains the signed archive of the user data.
+type ExportUserDataResponse struct {
+	// Archive is the JSON encoded DataExport.
+	Archive []byte
+
+	// Signature is the detached signature of the archive.
+	Signature Signature
+}
+
+// EraseUserArgs contains the arguments for erasing the personal data of a user.
+type EraseUserArgs struct {
+	// ID is the id of the user to be erased.
+	ID uuid.UUID
+}
+
+// RestoreUserArgs contains the arguments for restoring a soft-deleted user.
+type RestoreUserArgs struct {
+	// ID is the id of the user to be restored.
+	ID uuid.UUID
+}
+
+// RestoreUserResponse contains the response of the RestoreUser method.
+type RestoreUserResponse struct {
+	// User is the restored user.
+	User User
+}
+
+// ChangePasswordArgs contains the arguments for changing the password of a user.
+type ChangePasswordArgs struct {
+	// ID is the id of the user whose password is changed.
+	ID uuid.UUID
+
+	// CurrentPassword is the password currently set for the user.
+	CurrentPassword string
+
+	// NewPassword is the password to set.
+	NewPassword string
+}
+
+// UnlockUserArgs contains the arguments for lifting the lock of an account.
+type UnlockUserArgs struct {
+	// ID is the id of the user to unlock.
+	ID uuid.UUID
+}
+
+// VerifyUserArgs contains the arguments for recording the verification of the email of a user.
+type VerifyUserArgs struct {
+	// ID is the id of the user whose email was verified.
+	ID uuid.UUID
+}
+
+// LoginArgs contains the arguments for logging a user in.
+type LoginArgs struct {
+	// Email is the email of the user.
+	Email string
+
+	// Password is the password of the user.
+	Password string
+}
+
+// LoginResponse contains the response of the Login method: the session tokens or, if the user enabled a second
+// factor, the challenge to complete the login with.
+type LoginResponse struct {
+	// Tokens are the credentials of the new session. Nil if a second factor is required.
+	Tokens *SessionTokens
+
+	// MFAChallenge is the challenge to pass to CompleteLogin along with a code of the second factor. Empty if no
+	// second factor is required.
+	MFAChallenge string
+
+	// MFAChallengeExpiresAt is the time after which the challenge is rejected.
+	MFAChallengeExpiresAt time.Time
+}
+
+// CompleteLoginArgs contains the arguments for completing the login of a user with a second factor.
+type CompleteLoginArgs struct {
+	// MFAChallenge is the challenge returned by Login.
+	MFAChallenge string
+
+	// Code is a TOTP code or a recovery code.
+	Code string
+}
+
+// EnrollTOTPArgs contains the arguments for enrolling TOTP as second factor of a user.
+type EnrollTOTPArgs struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+}
+
+// ConfirmTOTPArgs contains the arguments for confirming the TOTP enrollment of a user.
+type ConfirmTOTPArgs struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Code is a TOTP code generated with the enrolled secret.
+	Code string
+}
+
+// ConfirmTOTPResponse contains the response of the ConfirmTOTP method.
+type ConfirmTOTPResponse struct {
+	// RecoveryCodes are one-time codes replacing TOTP codes when the authenticator is lost. They are shown only once.
+	RecoveryCodes []string
+}
+
+// DisableTOTPArgs contains the arguments for disabling the TOTP second factor of a user.
+type DisableTOTPArgs struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Code is a TOTP code or a recovery code. Required unless the actor is an admin.
+	Code string
+}
+
+// RefreshSessionArgs contains the arguments for refreshing a session.
+type RefreshSessionArgs struct {
+	// RefreshToken is the refresh token last handed out for the session.
+	RefreshToken string
+}
+
+// ListSessionsArgs contains the arguments for listing the sessions of a user.
+type ListSessionsArgs struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+}
+
+// ListSessionsResponse contains the active sessions of a user.
+type ListSessionsResponse struct {
+	// Sessions are the active sessions of the user, most recently created first.
+	Sessions []Session
+}
+
+// RevokeSessionArgs contains the arguments for revoking a session.
+type RevokeSessionArgs struct {
+	// UserID is the id of the user the session belongs to.
+	UserID uuid.UUID
+
+	// SessionID is the id of the session to revoke.
+	SessionID uuid.UUID
+}
+
+// ListAuditEntriesArgs contains the arguments for listing the audit trail of a user.
+type ListAuditEntriesArgs struct {
+	// UserID is the id of the user the entries refer to.
+	UserID uuid.UUID
+
+	// Actions are the operations to list. Zero-value will be ignored as filter.
+	Actions []AuditAction
+
+	// ActorID is the id of the actor that performed the operations. Zero-value will be ignored as filter.
+	ActorID string
+
+	// OccurredAfter is the left time boundary in which the operation was performed. Zero-value will be ignored as filter.
+	OccurredAfter time.Time
+
+	// OccurredBefore is the right time boundary in which the operation was performed. Zero-value will be ignored as filter.
+	OccurredBefore time.Time
+
+	// Limit is the maximum amount of entries to return (for pagination). Zero-value will be interpreted as no-limit.
+	Limit uint32
+
+	// Offset is the offset to apply (for pagination). Zero-value will be interpreted as 0 Offset.
+	Offset uint32
+}
+
+// ListAuditEntriesResponse contains the entries matching the input query of the ListAuditEntries api.
+type ListAuditEntriesResponse struct {
+	// Entries are the audit entries matching the query, oldest first.
+	Entries []AuditEntry
+}
+
+// CreateAPIKeyArgs contains the arguments for creating an API key.
+type CreateAPIKeyArgs struct {
+	// Name describes the holder of the key.
+	Name string
+
+	// Scopes are the roles granted to the holder of the key.
+	Scopes []Role
+
+	// ExpiresAt is the time after which the key is rejected. Zero-value means that the key does not expire.
+	ExpiresAt time.Time
+}
+
+// CreateAPIKeyResponse contains the response of the CreateAPIKey method.
+type CreateAPIKeyResponse struct {
+	// APIKey is the created key.
+	APIKey APIKey
+
+	// Key is the secret to present in the x-api-key header. It is shown only once.
+	Key string
+}
+
+// ListAPIKeysResponse contains the response of the ListAPIKeys method.
+type ListAPIKeysResponse struct {
+	// APIKeys are the API keys, most recently created first.
+	APIKeys []APIKey
+}
+
+// RevokeAPIKeyArgs contains the arguments for revoking an API key.
+type RevokeAPIKeyArgs struct {
+	// ID is the id of the key.
+	ID uuid.UUID
+}
+
+// GrantRoleArgs contain the arguments for the GrantRole use-case.
+type GrantRoleArgs struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Role is the role to grant.
+	Role Role
+}
+
+// RevokeRoleArgs contain the arguments for the RevokeRole use-case.
+type RevokeRoleArgs struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Role is the role to revoke.
+	Role Role
+}
+
+// RequestFriendshipArgs contain the arguments for the RequestFriendship use-case.
+type RequestFriendshipArgs struct {
+	// UserID is the id of the user sending the friend request.
+	UserID uuid.UUID
+
+	// FriendID is the id of the user the friend request is sent to.
+	FriendID uuid.UUID
+}
+
+// RequestFriendshipResponse contains the pending friendship.
+type RequestFriendshipResponse struct {
+	// Friendship is the pending friendship.
+	Friendship Friendship
+}
+
+// AnswerFriendshipArgs contain the arguments for the AcceptFriendship and DeclineFriendship use-cases.
+type AnswerFriendshipArgs struct {
+	// UserID is the id of the user the friend request was sent to.
+	UserID uuid.UUID
+
+	// FriendID is the id of the user who sent the friend request.
+	FriendID uuid.UUID
+}
+
+// RemoveFriendArgs contain the arguments for the RemoveFriend use-case.
+type RemoveFriendArgs struct {
+	// UserID is the id of the user removing the friendship.
+	UserID uuid.UUID
+
+	// FriendID is the id of the other user of the friendship.
+	FriendID uuid.UUID
+}
+
+// ListFriendsArgs contain the arguments for the ListFriends use-case.
+type ListFriendsArgs struct {
+	// UserID is the id of the user whose friendships are listed.
+	UserID uuid.UUID
+
+	// Status restricts the listed friendships to the given status. Optional.
+	Status FriendshipStatus
+
+	// Limit is the maximum number of friendships to return, 0 means unbounded.
+	Limit uint32
+
+	// Offset is the number of friendships to skip.
+	Offset uint32
+}
+
+// ListFriendsResponse contains the friendships of a user.
+type ListFriendsResponse struct {
+	// Friendships are the friendships of the user, oldest first.
+	Friendships []Friendship
+}
+
+// BlockUserArgs contain the arguments for the BlockUser use-case.
+type BlockUserArgs struct {
+	// UserID is the id of the user blocking the other one.
+	UserID uuid.UUID
+
+	// BlockedID is the id of the user to block.
+	BlockedID uuid.UUID
+}
+
+// BlockUserResponse contains the block.
+type BlockUserResponse struct {
+	// Block is the block.
+	Block Block
+}
+
+// UnblockUserArgs contain the arguments for the UnblockUser use-case.
+type UnblockUserArgs struct {
+	// UserID is the id of the user who blocked the other one.
+	UserID uuid.UUID
+
+	// BlockedID is the id of the user to unblock.
+	BlockedID uuid.UUID
+}
+
+// ListBlockedUsersArgs contain the arguments for the ListBlockedUsers use-case.
+type ListBlockedUsersArgs struct {
+	// UserID is the id of the user whose blocks are listed.
+	UserID uuid.UUID
+
+	// Limit is the maximum number of blocks to return, 0 means unbounded.
+	Limit uint32
+
+	// Offset is the number of blocks to skip.
+	Offset uint32
+}
+
+// ListBlockedUsersResponse contains the blocks of a user.
+type ListBlockedUsersResponse struct {
+	// Blocks are the blocks of the user, most recent first.
+	Blocks []Block
+}
+
+// IsBlockedArgs contain the arguments for the IsBlocked use-case.
+type IsBlockedArgs struct {
+	// UserID is the id of one of the users.
+	UserID uuid.UUID
+
+	// OtherUserID is the id of the other user.
+	OtherUserID uuid.UUID
+}
+
+// SuspendUserArgs contain the arguments for the SuspendUser use-case.
+type SuspendUserArgs struct {
+	// UserID is the id of the user to suspend.
+	UserID uuid.UUID
+
+	// Reason is the reason code of the suspension.
+	Reason SuspensionReason
+
+	// Note is a free-text note about the suspension, for the staff only.
+	Note string
+
+	// ExpiresAt is the time at which the suspension expires. Zero-valued for bans.
+	ExpiresAt time.Time
+}
+
+// SuspendUserResponse contains the suspension.
+type SuspendUserResponse struct {
+	// Suspension is the suspension in force.
+	Suspension Suspension
+}
+
+// LiftSuspensionArgs contain the arguments for the LiftSuspension use-case.
+type LiftSuspensionArgs struct {
+	// UserID is the id of the suspended user.
+	UserID uuid.UUID
+}
+
+// ListSuspensionsArgs contain the arguments for the ListSuspensions use-case.
+type ListSuspensionsArgs struct {
+	// UserID is the id of the user whose suspensions are listed.
+	UserID uuid.UUID
+
+	// Limit is the maximum number of suspensions to return, 0 means unbounded.
+	Limit uint32
+
+	// Offset is the number of suspensions to skip.
+	Offset uint32
+}
+
+// ListSuspensionsResponse contains the suspensions of a user.
+type ListSuspensionsResponse struct {
+	// Suspensions are the suspensions of the user, most recent first.
+	Suspensions []Suspension
+}
+
+// RequestAccountDeletionArgs contain the arguments for the RequestAccountDeletion use-case.
+type RequestAccountDeletionArgs struct {
+	// UserID is the id of the user leaving.
+	UserID uuid.UUID
+}
+
+// RequestAccountDeletionResponse contains the time at which the deletion is carried out.
+type RequestAccountDeletionResponse struct {
+	// ScheduledFor is the end of the grace period, when the account is deleted unless the deletion is cancelled.
+	ScheduledFor time.Time
+}
+
+// CancelAccountDeletionArgs contain the arguments for the CancelAccountDeletion use-case.
+type CancelAccountDeletionArgs struct {
+	// UserID is the id of the user staying.
+	UserID uuid.UUID
+}
+
+// GetNicknameHistoryArgs contain the arguments for the GetNicknameHistory use-case.
+type GetNicknameHistoryArgs struct {
+	// UserID is the id of the user whose nickname changes are listed.
+	UserID uuid.UUID
+
+	// Limit is the maximum number of changes to return, 0 means unbounded.
+	Limit uint32
+
+	// Offset is the number of changes to skip.
+	Offset uint32
+}
+
+// GetNicknameHistoryResponse contains the nickname changes of a user.
+type GetNicknameHistoryResponse struct {
+	// Changes are the nickname changes of the user, most recent first.
+	Changes []NicknameChange
+}
+
+// CheckNicknameAvailabilityArgs contain the arguments for the CheckNicknameAvailability use-case.
+type CheckNicknameAvailabilityArgs struct {
+	// Nickname is the nickname to check.
+	Nickname string
+
+	// Reserve asks for a reservation of the nickname if it is available.
+	Reserve bool
+}
+
+// CheckNicknameAvailabilityResponse contains the availability of a nickname.
+type CheckNicknameAvailabilityResponse struct {
+	// Nickname is the normalized nickname, the one the user would be created with.
+	Nickname string
+
+	// Available tells whether the nickname can be taken.
+	Available bool
+
+	// Rejection is the reason the nickname cannot be taken for, when it is not available.
+	Rejection NicknameRejection
+
+	// Suggestions are available nicknames close to the requested one, when it is taken.
+	Suggestions []string
+
+	// ReservationToken is the token CreateUser claims the reservation with, when one was asked for.
+	ReservationToken string
+
+	// ReservationExpiresAt is the time the reservation expires, when one was asked for.
+	ReservationExpiresAt time.Time
+}
+
+// GetPublicProfileArgs contains the arguments of the GetPublicProfile method. Exactly one of UserID and Nickname is
+// set.
+type GetPublicProfileArgs struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Nickname is the nickname of the user, matched ignoring the case and the confusable characters.
+	Nickname string
+}
+
+// GetPublicProfileResponse contains the response of the GetPublicProfile method.
+type GetPublicProfileResponse struct {
+	// Profile is the public profile of the user.
+	Profile PublicProfile
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProfileVisibility is the privacy setting of a user deciding who can see their public profile.
+type ProfileVisibility string
+
+const (
+	// ProfileVisibilityPublic shows the profile to every user. It is the default visibility.
+	ProfileVisibilityPublic ProfileVisibility = "public"
+
+	// ProfileVisibilityFriends shows the profile to the friends of the user only.
+	ProfileVisibilityFriends ProfileVisibility = "friends"
+
+	// ProfileVisibilityPrivate shows the profile to nobody but the user.
+	ProfileVisibilityPrivate ProfileVisibility = "private"
+)
+
+// Valid reports whether the visibility is a known one.
+func (v ProfileVisibility) Valid() bool {
+	switch v {
+	case ProfileVisibilityPublic, ProfileVisibilityFriends, ProfileVisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+// OrDefault returns the visibility, ProfileVisibilityPublic if it is empty, e.g. for the users who never chose one.
+func (v ProfileVisibility) OrDefault() ProfileVisibility {
+	if v == "" {
+		return ProfileVisibilityPublic
+	}
+	return v
+}
+
+// PublicProfile is the view of a user shown to the other players. It carries no personal data, neither the email nor
+// the real name of the user.
+type PublicProfile struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Nickname is the user nickname.
+	Nickname string
+
+	// Visibility is the privacy setting of the user.
+	Visibility ProfileVisibility
+
+	// Restricted is set when the visibility hides the profile from the viewer. Only UserID, Nickname and Visibility
+	// are set then.
+	Restricted bool
+
+	// Country is the user country.
+	Country string
+
+	// JoinedAt is the time at which the user was created.
+	JoinedAt time.Time
+
+	// Status is the state of the account of the user.
+	Status UserStatus
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// RateLimit is a token bucket holding up to Burst tokens and refilled with Rate tokens per second. Every call takes a
+// token, calls finding the bucket empty are rejected.
+type RateLimit struct {
+	// Rate is the number of tokens added to the bucket per second.
+	Rate float64 `json:"rate"`
+
+	// Burst is the capacity of the bucket, i.e. the number of calls allowed at once.
+	Burst int `json:"burst"`
+}
+
+// Interval is the time needed to refill the bucket with a single token.
+func (l RateLimit) Interval() time.Duration {
+	return time.Duration(float64(time.Second) / l.Rate)
+}
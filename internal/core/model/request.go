@@ -0,0 +1,28 @@
+package model
+
+import "context"
+
+// RequestMetadata describes the request on whose behalf an operation is performed.
+type RequestMetadata struct {
+	// RequestID identifies the request across the services it traverses.
+	RequestID string
+
+	// SourceIP is the IP address of the client that originated the request.
+	SourceIP string
+
+	// UserAgent describes the client software that originated the request.
+	UserAgent string
+}
+
+type requestMetadataContextKey struct{}
+
+// ContextWithRequestMetadata returns a copy of ctx carrying the request metadata.
+func ContextWithRequestMetadata(ctx context.Context, metadata RequestMetadata) context.Context {
+	return context.WithValue(ctx, requestMetadataContextKey{}, metadata)
+}
+
+// RequestMetadataFromContext returns the request metadata carried by ctx, zero-valued if ctx carries none.
+func RequestMetadataFromContext(ctx context.Context) RequestMetadata {
+	metadata, _ := ctx.Value(requestMetadataContextKey{}).(RequestMetadata)
+	return metadata
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is a login of a user on a device. It is kept alive by rotating refresh tokens, every refresh token being
+// valid for a single use.
+type Session struct {
+	// ID is the unique identifier of the session.
+	ID uuid.UUID
+
+	// UserID is the id of the logged in user.
+	UserID uuid.UUID
+
+	// UserAgent is the user agent of the client that logged in.
+	UserAgent string
+
+	// SourceIP is the IP address of the client that logged in.
+	SourceIP string
+
+	// CreatedAt is the time of the login.
+	CreatedAt time.Time
+
+	// RefreshedAt is the time of the last use of a refresh token of the session.
+	RefreshedAt time.Time
+
+	// ExpiresAt is the time after which the refresh tokens of the session are rejected.
+	ExpiresAt time.Time
+
+	// RevokedAt is the time at which the session was revoked. Zero-valued if the session was not revoked.
+	RevokedAt time.Time
+}
+
+// Active reports whether the refresh tokens of the session are accepted at the given time.
+func (s Session) Active(at time.Time) bool {
+	return s.RevokedAt.IsZero() && at.Before(s.ExpiresAt)
+}
+
+// RefreshToken is a single-use credential of a session, stored by digest.
+type RefreshToken struct {
+	// Hash is the SHA-256 digest of the token, hex encoded.
+	Hash string
+
+	// SessionID is the id of the session the token belongs to.
+	SessionID uuid.UUID
+
+	// CreatedAt is the time at which the token was issued.
+	CreatedAt time.Time
+
+	// UsedAt is the time at which the token was exchanged for its successor. Zero-valued if the token was not used.
+	UsedAt time.Time
+}
+
+// SessionTokens are the credentials handed out to a client on login and on every refresh.
+type SessionTokens struct {
+	// SessionID is the id of the session.
+	SessionID uuid.UUID
+
+	// AccessToken is the bearer token authenticating the calls of the user.
+	AccessToken string
+
+	// AccessTokenExpiresAt is the time after which the access token is rejected.
+	AccessTokenExpiresAt time.Time
+
+	// RefreshToken is exchanged, once, for new session tokens.
+	RefreshToken string
+
+	// RefreshTokenExpiresAt is the time after which the refresh token is rejected.
+	RefreshTokenExpiresAt time.Time
+}
@@ -0,0 +1,95 @@
+package model
+
+import "fmt"
+
+// UserStatus is the state of the account of a user.
+type UserStatus string
+
+const (
+	// UserStatusPendingVerification is the status of the users whose email is not verified yet.
+	UserStatusPendingVerification UserStatus = "pending_verification"
+
+	// UserStatusActive is the status of the verified users that are neither suspended nor leaving.
+	UserStatusActive UserStatus = "active"
+
+	// UserStatusSuspended is the status of the users with a suspension in force.
+	UserStatusSuspended UserStatus = "suspended"
+
+	// UserStatusScheduledForDeletion is the status of the users who requested the deletion of their account, until it
+	// is carried out.
+	UserStatusScheduledForDeletion UserStatus = "scheduled_for_deletion"
+
+	// UserStatusDeleted is the status of the deleted users.
+	UserStatusDeleted UserStatus = "deleted"
+)
+
+// userStatusTransitions lists the statuses each status can transition to. A status does not transition to itself.
+var userStatusTransitions = map[UserStatus][]UserStatus{
+	UserStatusPendingVerification: {
+		UserStatusActive,
+		UserStatusSuspended,
+		UserStatusScheduledForDeletion,
+		UserStatusDeleted,
+	},
+	UserStatusActive: {
+		UserStatusSuspended,
+		UserStatusScheduledForDeletion,
+		UserStatusDeleted,
+	},
+	// lifting a suspension settles the user back, suspended users cannot schedule the deletion of their account
+	UserStatusSuspended: {
+		UserStatusPendingVerification,
+		UserStatusActive,
+		UserStatusDeleted,
+	},
+	// users leaving can still be suspended, so that cheaters cannot dodge a ban
+	UserStatusScheduledForDeletion: {
+		UserStatusPendingVerification,
+		UserStatusActive,
+		UserStatusSuspended,
+		UserStatusDeleted,
+	},
+	// restoring a user settles them back, suspended if a suspension is still in force
+	UserStatusDeleted: {
+		UserStatusPendingVerification,
+		UserStatusActive,
+		UserStatusSuspended,
+	},
+}
+
+// Valid reports whether the status is a known one.
+func (s UserStatus) Valid() bool {
+	_, ok := userStatusTransitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether an account in the status can transition to the given one.
+func (s UserStatus) CanTransitionTo(to UserStatus) bool {
+	for _, allowed := range userStatusTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTransition returns an error wrapping ErrFailedPrecondition if an account in the status cannot transition to
+// the given one.
+func (s UserStatus) CheckTransition(to UserStatus) error {
+	if !s.CanTransitionTo(to) {
+		return fmt.Errorf("%w: user cannot go from %s to %s", ErrFailedPrecondition, s, to)
+	}
+	return nil
+}
+
+// SettledStatus returns the status the user settles in when no deletion holds the account: suspended while a
+// suspension is in force, active once the email is verified and pending verification before.
+func (u User) SettledStatus() UserStatus {
+	switch {
+	case !u.SuspendedAt.IsZero():
+		return UserStatusSuspended
+	case !u.VerifiedAt.IsZero():
+		return UserStatusActive
+	}
+	return UserStatusPendingVerification
+}
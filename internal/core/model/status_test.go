@@ -0,0 +1,65 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from UserStatus
+		to   UserStatus
+		want bool
+	}{
+		{from: UserStatusPendingVerification, to: UserStatusActive, want: true},
+		{from: UserStatusPendingVerification, to: UserStatusSuspended, want: true},
+		{from: UserStatusPendingVerification, to: UserStatusScheduledForDeletion, want: true},
+		{from: UserStatusPendingVerification, to: UserStatusDeleted, want: true},
+		{from: UserStatusActive, to: UserStatusPendingVerification, want: false},
+		{from: UserStatusActive, to: UserStatusActive, want: false},
+		{from: UserStatusActive, to: UserStatusSuspended, want: true},
+		{from: UserStatusActive, to: UserStatusScheduledForDeletion, want: true},
+		{from: UserStatusActive, to: UserStatusDeleted, want: true},
+		{from: UserStatusSuspended, to: UserStatusSuspended, want: false},
+		{from: UserStatusSuspended, to: UserStatusActive, want: true},
+		{from: UserStatusSuspended, to: UserStatusPendingVerification, want: true},
+		{from: UserStatusSuspended, to: UserStatusScheduledForDeletion, want: false},
+		{from: UserStatusSuspended, to: UserStatusDeleted, want: true},
+		{from: UserStatusScheduledForDeletion, to: UserStatusScheduledForDeletion, want: false},
+		{from: UserStatusScheduledForDeletion, to: UserStatusActive, want: true},
+		{from: UserStatusScheduledForDeletion, to: UserStatusSuspended, want: true},
+		{from: UserStatusScheduledForDeletion, to: UserStatusDeleted, want: true},
+		{from: UserStatusDeleted, to: UserStatusDeleted, want: false},
+		{from: UserStatusDeleted, to: UserStatusScheduledForDeletion, want: false},
+		{from: UserStatusDeleted, to: UserStatusActive, want: true},
+		{from: UserStatusDeleted, to: UserStatusSuspended, want: true},
+		{from: UserStatus("unknown"), to: UserStatusActive, want: false},
+		{from: UserStatusActive, to: UserStatus("unknown"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" to "+string(tt.to), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to))
+			if tt.want {
+				assert.NoError(t, tt.from.CheckTransition(tt.to))
+			} else {
+				assert.ErrorIs(t, tt.from.CheckTransition(tt.to), ErrFailedPrecondition)
+			}
+		})
+	}
+}
+
+func TestUserStatus_Valid(t *testing.T) {
+	assert.True(t, UserStatusScheduledForDeletion.Valid())
+	assert.False(t, UserStatus("").Valid())
+	assert.False(t, UserStatus("banned").Valid())
+}
+
+func TestUser_SettledStatus(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, UserStatusPendingVerification, User{}.SettledStatus())
+	assert.Equal(t, UserStatusActive, User{VerifiedAt: now}.SettledStatus())
+	assert.Equal(t, UserStatusSuspended, User{VerifiedAt: now, SuspendedAt: now}.SettledStatus())
+	assert.Equal(t, UserStatusSuspended, User{SuspendedAt: now}.SettledStatus())
+}
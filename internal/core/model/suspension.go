@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SuspensionReason is the reason code of a Suspension.
+type SuspensionReason string
+
+const (
+	// SuspensionReasonCheating is the reason of the suspensions issued by the anti-cheat.
+	SuspensionReasonCheating SuspensionReason = "cheating"
+
+	// SuspensionReasonAbusiveBehavior is the reason of the suspensions for toxicity, harassment or griefing.
+	SuspensionReasonAbusiveBehavior SuspensionReason = "abusive_behavior"
+
+	// SuspensionReasonAccountSharing is the reason of the suspensions for accounts played by someone else.
+	SuspensionReasonAccountSharing SuspensionReason = "account_sharing"
+
+	// SuspensionReasonFraud is the reason of the suspensions for payment fraud and chargebacks.
+	SuspensionReasonFraud SuspensionReason = "fraud"
+
+	// SuspensionReasonOther is the reason of the suspensions that fit no other reason, the note tells more.
+	SuspensionReasonOther SuspensionReason = "other"
+)
+
+// Valid reports whether the reason is one of the known reason codes.
+func (r SuspensionReason) Valid() bool {
+	switch r {
+	case SuspensionReasonCheating, SuspensionReasonAbusiveBehavior, SuspensionReasonAccountSharing,
+		SuspensionReasonFraud, SuspensionReasonOther:
+		return true
+	}
+	return false
+}
+
+// Suspension is the suspension of a user. A suspension without expiry is a ban. Ended suspensions are kept as history.
+type Suspension struct {
+	// ID is the id of the suspension.
+	ID uuid.UUID
+
+	// UserID is the id of the suspended user.
+	UserID uuid.UUID
+
+	// Reason is the reason code of the suspension.
+	Reason SuspensionReason
+
+	// Note is a free-text note about the suspension, for the staff only.
+	Note string
+
+	// IssuedBy is the id of the actor that issued the suspension.
+	IssuedBy string
+
+	// CreatedAt is the time of the suspension.
+	CreatedAt time.Time
+
+	// ExpiresAt is the time at which the suspension expires. Zero-valued for bans.
+	ExpiresAt time.Time
+
+	// EndedAt is the time at which the suspension was lifted or expired. Zero-valued while in force.
+	EndedAt time.Time
+
+	// LiftedBy is the id of the actor that lifted the suspension. Empty if it expired or is in force.
+	LiftedBy string
+}
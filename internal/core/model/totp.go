@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TOTPCredential is the time-based one-time password (RFC 6238) second factor of a user.
+type TOTPCredential struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Secret is the base32 encoded shared secret.
+	Secret string
+
+	// CreatedAt is the time of the enrollment.
+	CreatedAt time.Time
+
+	// ConfirmedAt is the time at which the user proved to hold the secret. Zero-valued while the enrollment is pending.
+	ConfirmedAt time.Time
+
+	// LastUsedStep is the time step of the last accepted code. Codes of earlier or equal steps are rejected as replays.
+	LastUsedStep int64
+}
+
+// TOTPEnrollment is handed out to the user to set up their authenticator app.
+type TOTPEnrollment struct {
+	// Secret is the base32 encoded shared secret.
+	Secret string
+
+	// URI is the otpauth:// URI of the secret, usually rendered as a QR code.
+	URI string
+}
+
+// LoginChallenge is the pending second step of the login of a user with a second factor.
+type LoginChallenge struct {
+	// Hash is the SHA-256 digest of the challenge, hex encoded.
+	Hash string
+
+	// UserID is the id of the user logging in.
+	UserID uuid.UUID
+
+	// CreatedAt is the time at which the password of the user was verified.
+	CreatedAt time.Time
+
+	// ExpiresAt is the time after which the challenge is rejected.
+	ExpiresAt time.Time
+}
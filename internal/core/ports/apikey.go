@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// APIKeyRepository is the interface for the persistence of the API keys.
+type APIKeyRepository interface {
+	// SaveAPIKey saves a new API key.
+	SaveAPIKey(ctx context.Context, key *model.APIKey) error
+
+	// GetAPIKeyByHash returns the API key by digest. It returns model.ErrNotFound if it does not exist.
+	GetAPIKeyByHash(ctx context.Context, hash string) (*model.APIKey, error)
+
+	// ListAPIKeys lists the API keys, revoked and expired ones included, most recently created first.
+	ListAPIKeys(ctx context.Context) ([]model.APIKey, error)
+
+	// RevokeAPIKey revokes the API key. It returns model.ErrNotFound if the key does not exist or was revoked
+	// already.
+	RevokeAPIKey(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+}
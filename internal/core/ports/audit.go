@@ -0,0 +1,48 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// AuditRepository is the interface for the persistence of the audit trail.
+type AuditRepository interface {
+	// SaveAuditEntry appends the entry to the audit trail.
+	SaveAuditEntry(ctx context.Context, entry *model.AuditEntry) error
+
+	// ListAuditEntries lists the audit entries matching the query parameters, oldest first.
+	ListAuditEntries(ctx context.Context, query ListAuditEntriesQuery) (*ListAuditEntriesResult, error)
+}
+
+// ListAuditEntriesQuery gathers the parameters for listing audit entries.
+type ListAuditEntriesQuery struct {
+	// UserID is the id of the user the entries refer to.
+	UserID uuid.UUID
+
+	// Actions are the operations to list. Zero-value will be ignored as filter.
+	Actions []model.AuditAction
+
+	// ActorID is the id of the actor that performed the operations. Zero-value will be ignored as filter.
+	ActorID string
+
+	// OccurredAfter is the left time boundary in which the operation was performed. Zero-value will be ignored as filter.
+	OccurredAfter time.Time
+
+	// OccurredBefore is the right time boundary in which the operation was performed. Zero-value will be ignored as filter.
+	OccurredBefore time.Time
+
+	// Limit is the maximum amount of entries to return (for pagination). Zero-value will be interpreted as no-limit.
+	Limit uint32
+
+	// Offset is the offset to apply (for pagination). Zero-value will be interpreted as 0 Offset.
+	Offset uint32
+}
+
+// ListAuditEntriesResult gathers the result of listing audit entries.
+type ListAuditEntriesResult struct {
+	// Entries are the audit entries matching the query parameters.
+	Entries []model.AuditEntry
+}
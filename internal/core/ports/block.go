@@ -0,0 +1,38 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// BlockRepository is the interface for the persistence of the blocks between users.
+type BlockRepository interface {
+	// SaveBlock saves the block and deletes the friendship between the users, if any. It returns model.ErrNotFound if
+	// either user does not exist or is deleted, and an error wrapping model.ErrFailedPrecondition if the user is
+	// blocked already.
+	SaveBlock(ctx context.Context, block *model.Block) error
+
+	// DeleteBlock deletes the block of the blocked user by the blocker. It returns model.ErrNotFound if there is no
+	// such block.
+	DeleteBlock(ctx context.Context, blockerID, blockedID uuid.UUID) error
+
+	// ListBlocks lists the blocks of the blocker, most recent first.
+	ListBlocks(ctx context.Context, query ListBlocksQuery) ([]model.Block, error)
+
+	// IsBlocked reports whether either user blocked the other.
+	IsBlocked(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error)
+}
+
+// ListBlocksQuery gathers the parameters for listing the blocks of a user.
+type ListBlocksQuery struct {
+	// BlockerID is the id of the user who blocked the others.
+	BlockerID uuid.UUID
+
+	// Limit is the maximum number of blocks to return, 0 means unbounded.
+	Limit uint32
+
+	// Offset is the number of blocks to skip.
+	Offset uint32
+}
@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeletionRepository is the interface for the persistence of the deletions requested by the users. The users are
+// scheduled for deletion until the end of their grace period.
+type DeletionRepository interface {
+	// ScheduleDeletion schedules the deletion of the user for the given time. It returns model.ErrNotFound if the user
+	// does not exist or is deleted, and an error wrapping model.ErrFailedPrecondition if the user is scheduled for
+	// deletion already or cannot be in their status.
+	ScheduleDeletion(ctx context.Context, userID uuid.UUID, scheduledFor time.Time, requestedAt time.Time) error
+
+	// CancelDeletion cancels the scheduled deletion of the user, who settles back in their status. It returns
+	// model.ErrNotFound if the user does not exist or is deleted, and an error wrapping model.ErrFailedPrecondition if
+	// the user is not scheduled for deletion.
+	CancelDeletion(ctx context.Context, userID uuid.UUID, cancelledAt time.Time) error
+
+	// ListDueDeletions returns the ids of the users whose deletion is due at the given time.
+	ListDueDeletions(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+
+	// DeleteScheduledUser soft-deletes the user if their deletion is due at the given time. It returns
+	// model.ErrNotFound if the user does not exist and an error wrapping model.ErrFailedPrecondition if the deletion of
+	// the user is not due, e.g. it was cancelled since it was listed.
+	DeleteScheduledUser(ctx context.Context, userID uuid.UUID, now time.Time) error
+}
@@ -0,0 +1,47 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// FriendshipRepository is the interface for the persistence of the friendships between users.
+type FriendshipRepository interface {
+	// SaveFriendship saves a pending friendship. It returns model.ErrNotFound if either user does not exist or is
+	// deleted, and an error wrapping model.ErrFailedPrecondition if the users already have a friendship, whatever its
+	// direction and status.
+	SaveFriendship(ctx context.Context, friendship *model.Friendship) error
+
+	// GetFriendship returns the friendship between the two users, whatever its direction. It returns
+	// model.ErrNotFound if the users have no friendship.
+	GetFriendship(ctx context.Context, userID, friendID uuid.UUID) (*model.Friendship, error)
+
+	// AcceptFriendship accepts the pending friend request of the requester to the addressee. It returns
+	// model.ErrNotFound if there is no such pending request.
+	AcceptFriendship(ctx context.Context, requesterID, addresseeID uuid.UUID, acceptedAt time.Time) error
+
+	// DeleteFriendship deletes the friendship if it is still in the given status. It returns model.ErrNotFound
+	// otherwise.
+	DeleteFriendship(ctx context.Context, friendship model.Friendship) error
+
+	// ListFriendships lists the friendships of a user, in both directions, oldest first.
+	ListFriendships(ctx context.Context, query ListFriendshipsQuery) ([]model.Friendship, error)
+}
+
+// ListFriendshipsQuery gathers the parameters for listing the friendships of a user.
+type ListFriendshipsQuery struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Status restricts the friendships to the given status. Optional.
+	Status model.FriendshipStatus
+
+	// Limit is the maximum number of friendships to return, 0 means unbounded.
+	Limit uint32
+
+	// Offset is the number of friendships to skip.
+	Offset uint32
+}
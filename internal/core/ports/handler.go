@@ -11,3 +11,9 @@ type UserEventHandler interface {
 	// Handle will receive an incoming user event and handle it.
 	Handle(ctx context.Context, userEvent model.UserEvent) error
 }
+
+// FriendshipEventHandler handles incoming FriendshipEvents.
+type FriendshipEventHandler interface {
+	// Handle will receive an incoming friendship event, identified by id, and handle it.
+	Handle(ctx context.Context, id string, friendshipEvent model.FriendshipEvent) error
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// LockoutRepository is the interface for the persistence of the failed credential checks.
+type LockoutRepository interface {
+	// GetCredentialFailures returns the failures counted against the key. It returns model.ErrNotFound if there are none.
+	GetCredentialFailures(ctx context.Context, scope model.CredentialScope, key string) (*model.CredentialFailures, error)
+
+	// RecordCredentialFailure atomically counts a failure against the key and returns the updated counter.
+	RecordCredentialFailure(ctx context.Context, query RecordCredentialFailureQuery) (*model.CredentialFailures, error)
+
+	// LockCredentials rejects the credential checks of the key until the given time. Locks of accounts are
+	// reflected in the user.
+	LockCredentials(ctx context.Context, scope model.CredentialScope, key string, until time.Time) error
+
+	// ResetCredentialFailures forgets the failures counted against the key and lifts its lock.
+	ResetCredentialFailures(ctx context.Context, scope model.CredentialScope, key string) error
+}
+
+// RecordCredentialFailureQuery gathers the parameters for recording a failed credential check.
+type RecordCredentialFailureQuery struct {
+	// Scope is what the failure is counted against.
+	Scope model.CredentialScope
+
+	// Key identifies the account or the source IP within the scope.
+	Key string
+
+	// ResetBefore restarts the counter if its last failure happened before this time.
+	ResetBefore time.Time
+}
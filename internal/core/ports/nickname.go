@@ -0,0 +1,56 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// NicknameRepository is the interface for the nickname history of the users. The changes are recorded by
+// Repository.UpdateUser.
+type NicknameRepository interface {
+	// ListNicknameChanges lists the nickname changes of a user, most recent first.
+	ListNicknameChanges(ctx context.Context, query ListNicknameChangesQuery) ([]model.NicknameChange, error)
+
+	// NicknameTaken reports whether a nickname with the given key is held by another user, was released by another
+	// user since the given time or is reserved by another token.
+	NicknameTaken(ctx context.Context, query NicknameTakenQuery) (bool, error)
+
+	// ReserveNickname holds the nickname until the reservation expires, the expired reservations are taken over. It
+	// returns model.ErrAlreadyExists if the nickname is reserved already.
+	ReserveNickname(ctx context.Context, reservation model.NicknameReservation) error
+
+	// SaveUserWithReservation saves the user and claims the reservation of their nickname with the token hash in the
+	// same transaction. It returns model.ErrFailedPrecondition if the token holds no live reservation of the nickname.
+	SaveUserWithReservation(ctx context.Context, user *model.User, tokenHash string) error
+}
+
+// ListNicknameChangesQuery gathers the parameters for listing the nickname changes of a user.
+type ListNicknameChangesQuery struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Limit is the maximum number of changes to return, 0 means unbounded.
+	Limit uint32
+
+	// Offset is the number of changes to skip.
+	Offset uint32
+}
+
+// NicknameTakenQuery gathers the parameters for checking whether a nickname is taken.
+type NicknameTakenQuery struct {
+	// Key is the key of the nickname, see model.NicknameKey.
+	Key string
+
+	// UserID is the id of the user asking for the nickname, their own nicknames are not taken. Optional.
+	UserID uuid.UUID
+
+	// ReleasedSince is the time since which the released nicknames are reserved to their previous holder.
+	ReleasedSince time.Time
+
+	// TokenHash is the hash of the reservation token presented for the nickname, its reservation does not take it.
+	// Optional.
+	TokenHash string
+}
@@ -14,14 +14,75 @@ type Repository interface {
 	SaveUser(ctx context.Context, user *model.User) error
 
 	// UpdateUser updates the user and saves the state in the persistence layer.
-	// All the non-zero values specified will be updated.
-	UpdateUser(ctx context.Context, user *model.User) error
+	// All the non-zero values specified will be updated. A change of the nickname is recorded in the nickname history.
+	// It returns model.ErrNotFound if the user does not exist and an error wrapping model.ErrFailedPrecondition if the
+	// nickname changes before the cooldown of the query elapsed.
+	UpdateUser(ctx context.Context, user *model.User, query UpdateUserQuery) error
+
+	// GetUser returns the user matching the query parameters. It returns model.ErrNotFound if no user matches.
+	GetUser(ctx context.Context, query GetUserQuery) (*model.User, error)
 
 	// ListUsers lists all users matching the query parameters.
 	ListUsers(ctx context.Context, query ListUsersQuery) (*ListUsersResult, error)
 
-	// DeleteUser removes the user matching the query parameters.
+	// ListUserVersions lists the versions of a user matching the query parameters, oldest first.
+	ListUserVersions(ctx context.Context, query ListUserVersionsQuery) (*ListUserVersionsResult, error)
+
+	// DeleteUser removes the user matching the query parameters. Soft-deletions return model.ErrNotFound if the user
+	// does not exist and an error wrapping model.ErrFailedPrecondition if the user is deleted already.
 	DeleteUser(ctx context.Context, query DeleteUserQuery) error
+
+	// RestoreUser reverts the soft-deletion of a user, who settles back in their status. It returns model.ErrNotFound
+	// if the user does not exist or is erased, erased users cannot be restored, and an error wrapping
+	// model.ErrFailedPrecondition if the user is not deleted.
+	RestoreUser(ctx context.Context, id uuid.UUID) (*model.User, error)
+
+	// VerifyUser records the verification of the email of a pending user, activating their account. It returns
+	// model.ErrNotFound if the user does not exist and an error wrapping model.ErrFailedPrecondition if the user is
+	// not pending verification.
+	VerifyUser(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error
+
+	// EraseUser replaces the user with an anonymized and deleted tombstone, deletes their friendships, blocks and
+	// nickname history and clears the notes of their suspensions. It returns model.ErrNotFound if the user does not exist.
+	EraseUser(ctx context.Context, id uuid.UUID) error
+}
+
+// GetUserQuery gathers the parameters for retrieving a single user.
+type GetUserQuery struct {
+	// ID is the id of the user.
+	ID uuid.UUID
+
+	// Email is the email of the user, matched by its canonical form, see model.CanonicalEmail. It replaces ID when set
+	// and is not supported along with AsOf. The oldest user is returned if several share the email.
+	Email string
+
+	// Nickname is the nickname of the user, matched by its key, see model.NicknameKey. It replaces ID when set and is
+	// not supported along with AsOf. The oldest user is returned if several share the key.
+	Nickname string
+
+	// IncludeDeleted makes soft-deleted users eligible to be returned.
+	IncludeDeleted bool
+
+	// AsOf is the time at which the state of the user is read. Zero-value reads the current state.
+	AsOf time.Time
+}
+
+// ListUserVersionsQuery gathers the parameters for listing the versions of a user.
+type ListUserVersionsQuery struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Limit is the maximum amount of versions to return (for pagination). Zero-value will be interpreted as no-limit.
+	Limit uint32
+
+	// Offset is the offset to apply (for pagination). Zero-value will be interpreted as 0 Offset.
+	Offset uint32
+}
+
+// ListUserVersionsResult gathers the versions of a user.
+type ListUserVersionsResult struct {
+	// Versions are the versions matching the query parameters.
+	Versions []model.UserVersion
 }
 
 // ListUsersQuery gather the parameters for which the query
@@ -32,6 +93,9 @@ type ListUsersQuery struct {
 	// Countries to which the desired users belong to. Zero-value will be ignored as filter.
 	Countries []string
 
+	// Statuses are the statuses of the desired users. Zero-value will be ignored as filter.
+	Statuses []model.UserStatus
+
 	// CreatedAfter is the left time boundary in which the user was created. Zero-value will be ignored as filter.
 	CreatedAfter time.Time
 
@@ -43,6 +107,13 @@ type ListUsersQuery struct {
 
 	// Offset is the offset to apply (for pagination). Zero-value will be interpreted as 0 Offset.
 	Offset uint32
+
+	// IncludeDeleted makes soft-deleted users eligible to be returned.
+	IncludeDeleted bool
+
+	// Attributes are the custom attributes the desired users have, by key, compared by their text representation.
+	// Zero-value will be ignored as filter.
+	Attributes map[string]string
 }
 
 // ListUsersResult gathers the result
@@ -51,6 +122,13 @@ type ListUsersResult struct {
 	Users []model.User
 }
 
+// UpdateUserQuery gathers the conditions of an update.
+type UpdateUserQuery struct {
+	// NicknameChangeCooldown is the time that must have elapsed since the last nickname change of the user for their
+	// nickname to change, checked under the lock of the user. Zero-value will be ignored.
+	NicknameChangeCooldown time.Duration
+}
+
 // DeleteUserQuery
 type DeleteUserQuery struct {
 	// ID is the ID of the user to be deleted
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// RoleRepository is the interface for the persistence of the roles granted to the users. The roles are reflected in
+// the users.
+type RoleRepository interface {
+	// GrantRole grants the role to the user. It returns model.ErrNotFound if the user does not exist or is deleted, and
+	// an error wrapping model.ErrFailedPrecondition if the role is granted already.
+	GrantRole(ctx context.Context, query GrantRoleQuery) error
+
+	// RevokeRole revokes the role of the user. It returns model.ErrNotFound if the user does not exist or is deleted,
+	// and an error wrapping model.ErrFailedPrecondition if the role is not granted.
+	RevokeRole(ctx context.Context, userID uuid.UUID, role model.Role, revokedAt time.Time) error
+}
+
+// GrantRoleQuery gathers the parameters for granting a role.
+type GrantRoleQuery struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Role is the role to grant.
+	Role model.Role
+
+	// GrantedBy is the id of the actor granting the role.
+	GrantedBy string
+
+	// GrantedAt is the time of the grant.
+	GrantedAt time.Time
+}
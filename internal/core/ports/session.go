@@ -0,0 +1,48 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// SessionRepository is the interface for the persistence of the sessions and of their refresh tokens.
+type SessionRepository interface {
+	// SaveSession saves a new session along with its first refresh token.
+	SaveSession(ctx context.Context, session *model.Session, token *model.RefreshToken) error
+
+	// GetSession returns the session. It returns model.ErrNotFound if the session does not exist.
+	GetSession(ctx context.Context, id uuid.UUID) (*model.Session, error)
+
+	// GetRefreshToken returns the refresh token by digest. It returns model.ErrNotFound if the token does not exist.
+	GetRefreshToken(ctx context.Context, hash string) (*model.RefreshToken, error)
+
+	// RotateRefreshToken atomically marks the refresh token as used, saves its successor and extends the session up
+	// to expiresAt. It returns model.ErrNotFound if the token does not exist or was used already.
+	RotateRefreshToken(ctx context.Context, hash string, next *model.RefreshToken, expiresAt time.Time) error
+
+	// ListSessions lists the sessions of the user that are active at the given time, most recently created first.
+	ListSessions(ctx context.Context, userID uuid.UUID, activeAt time.Time) ([]model.Session, error)
+
+	// RevokeSession revokes the session of the user. It returns model.ErrNotFound if the user has no such session
+	// or if it was revoked already.
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+
+	// SaveLoginChallenge saves the pending second step of a login.
+	SaveLoginChallenge(ctx context.Context, challenge *model.LoginChallenge) error
+
+	// GetLoginChallenge returns the login challenge by digest. It returns model.ErrNotFound if it does not exist.
+	GetLoginChallenge(ctx context.Context, hash string) (*model.LoginChallenge, error)
+
+	// DeleteLoginChallenge consumes the login challenge. It returns model.ErrNotFound if it was consumed already.
+	DeleteLoginChallenge(ctx context.Context, hash string) error
+}
+
+// TokenIssuer is the port for issuing the access tokens of the sessions.
+type TokenIssuer interface {
+	// IssueAccessToken issues a bearer token authenticating the actor within the session and returns it along with
+	// its expiry.
+	IssueAccessToken(ctx context.Context, actor model.Actor, sessionID uuid.UUID) (string, time.Time, error)
+}
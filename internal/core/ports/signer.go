@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// Signer is the port for producing detached signatures over payloads handed out by the service.
+type Signer interface {
+	// Sign signs the payload.
+	Sign(ctx context.Context, payload []byte) (*model.Signature, error)
+}
@@ -0,0 +1,42 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// SuspensionRepository is the interface for the persistence of the suspensions of the users. The suspension in force
+// is reflected in the users.
+type SuspensionRepository interface {
+	// SaveSuspension saves the suspension in force of the user. It returns model.ErrNotFound if the user does not exist
+	// or is deleted, and an error wrapping model.ErrFailedPrecondition if the user is suspended already or cannot be
+	// suspended in their status.
+	SaveSuspension(ctx context.Context, suspension *model.Suspension) error
+
+	// LiftSuspension ends the suspension in force of the user, who settles back in their status. It returns
+	// model.ErrNotFound if the user does not exist or is deleted, and an error wrapping model.ErrFailedPrecondition if
+	// the user is not suspended.
+	LiftSuspension(ctx context.Context, userID uuid.UUID, liftedBy string, liftedAt time.Time) error
+
+	// ListSuspensions lists the suspensions of a user, most recent first.
+	ListSuspensions(ctx context.Context, query ListSuspensionsQuery) ([]model.Suspension, error)
+
+	// ExpireSuspensions ends the suspensions in force that expired at the given time, settling their users back in
+	// their status, and returns the ids of the users whose suspension ended.
+	ExpireSuspensions(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+}
+
+// ListSuspensionsQuery gathers the parameters for listing the suspensions of a user.
+type ListSuspensionsQuery struct {
+	// UserID is the id of the user.
+	UserID uuid.UUID
+
+	// Limit is the maximum number of suspensions to return, 0 means unbounded.
+	Limit uint32
+
+	// Offset is the number of suspensions to skip.
+	Offset uint32
+}
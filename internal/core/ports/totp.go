@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// TOTPRepository is the interface for the persistence of the TOTP second factors and of their recovery codes.
+type TOTPRepository interface {
+	// SaveTOTPCredential saves a pending enrollment, replacing the previous pending one. It returns
+	// model.ErrFailedPrecondition if the user has a confirmed credential.
+	SaveTOTPCredential(ctx context.Context, credential *model.TOTPCredential) error
+
+	// GetTOTPCredential returns the credential of the user. It returns model.ErrNotFound if the user has none.
+	GetTOTPCredential(ctx context.Context, userID uuid.UUID) (*model.TOTPCredential, error)
+
+	// ConfirmTOTPCredential confirms the pending credential of the user, accepting the code of the given step, saves
+	// its recovery codes by digest and enables TOTP on the user. It returns model.ErrNotFound if the user has no
+	// pending credential.
+	ConfirmTOTPCredential(ctx context.Context, userID uuid.UUID, step int64, recoveryCodeHashes []string, confirmedAt time.Time) error
+
+	// UseTOTPStep accepts the code of the given step. It returns model.ErrNotFound if the credential is not confirmed
+	// or if a code of the same or of a later step was accepted already.
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error
+
+	// UseRecoveryCode consumes the recovery code by digest. It returns model.ErrNotFound if the user has no such
+	// unused code.
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) error
+
+	// DeleteTOTPCredential removes the credential of the user along with its recovery codes and disables TOTP on the
+	// user. It returns model.ErrNotFound if the user has no credential.
+	DeleteTOTPCredential(ctx context.Context, userID uuid.UUID) error
+}
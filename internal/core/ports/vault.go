@@ -0,0 +1,39 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// Vault protects the personal data of users with per-user data keys. Destroying the data key of a user
+// makes every sealed copy of their data unreadable (crypto-shredding).
+type Vault interface {
+	// Seal encrypts in place the values with the data key of the user, creating the key if needed.
+	// Empty values are left untouched. It returns model.ErrDataKeyDestroyed if the key was destroyed.
+	Seal(ctx context.Context, userID uuid.UUID, values ...*string) error
+
+	// Open decrypts in place the values sealed with the data key of the user. Values that are not sealed are left
+	// untouched. It returns model.ErrDataKeyDestroyed if the key was destroyed.
+	Open(ctx context.Context, userID uuid.UUID, values ...*string) error
+
+	// BlindIndex returns a keyed digest of the value, allowing sealed values to be looked up by equality without
+	// decrypting them. It does not depend on the data key of the user, erasures must discard it along with the value.
+	BlindIndex(value string) string
+
+	// DestroyKey irreversibly destroys the data key of the user.
+	DestroyKey(ctx context.Context, userID uuid.UUID) error
+}
+
+// DataKeyRepository is the interface for the persistence of the wrapped data keys.
+type DataKeyRepository interface {
+	// GetDataKey returns the data key of the user. It returns model.ErrNotFound if the user has no data key.
+	GetDataKey(ctx context.Context, userID uuid.UUID) (*model.DataKey, error)
+
+	// SaveDataKey saves the data key unless the user already has one.
+	SaveDataKey(ctx context.Context, key *model.DataKey) error
+
+	// DestroyDataKey discards the wrapped data key of the user and marks it as destroyed.
+	DestroyDataKey(ctx context.Context, userID uuid.UUID) error
+}
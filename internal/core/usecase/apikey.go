@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// apiKeyPrefix tells the API keys apart from other secrets, e.g. for secret scanners.
+const apiKeyPrefix = "fth_"
+
+// CreateAPIKey creates an API key granting its scopes to the services holding it. The key is returned once, only its
+// digest is stored.
+func (s *UserService) CreateAPIKey(ctx context.Context, args model.CreateAPIKeyArgs) (*model.CreateAPIKeyResponse, error) {
+	secret, err := newToken()
+	if err != nil {
+		return nil, fmt.Errorf("error generating api key: %w", err)
+	}
+	key := apiKeyPrefix + secret
+	actor, _ := model.ActorFromContext(ctx)
+	apiKey := &model.APIKey{
+		ID:        uuid.New(),
+		Name:      args.Name,
+		Hash:      hashToken(key),
+		Scopes:    args.Scopes,
+		CreatedBy: actor.ID,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: args.ExpiresAt,
+	}
+	if err := s.apiKeyRepository.SaveAPIKey(ctx, apiKey); err != nil {
+		return nil, fmt.Errorf("error saving api key: %w", err)
+	}
+	return &model.CreateAPIKeyResponse{APIKey: *apiKey, Key: key}, nil
+}
+
+// ListAPIKeys lists the API keys, revoked and expired ones included.
+func (s *UserService) ListAPIKeys(ctx context.Context) (*model.ListAPIKeysResponse, error) {
+	apiKeys, err := s.apiKeyRepository.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing api keys: %w", err)
+	}
+	return &model.ListAPIKeysResponse{APIKeys: apiKeys}, nil
+}
+
+// RevokeAPIKey revokes an API key, it is rejected from then on. It returns model.ErrNotFound if the key does not exist
+// or was revoked already.
+func (s *UserService) RevokeAPIKey(ctx context.Context, args model.RevokeAPIKeyArgs) error {
+	if err := s.apiKeyRepository.RevokeAPIKey(ctx, args.ID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error revoking api key: %w", err)
+	}
+	return nil
+}
+
+// AuthenticateAPIKey returns the actor the API key was issued to. It returns an error wrapping
+// model.ErrUnauthenticated if the key is unknown, expired or revoked.
+func (s *UserService) AuthenticateAPIKey(ctx context.Context, key string) (model.Actor, error) {
+	apiKey, err := s.apiKeyRepository.GetAPIKeyByHash(ctx, hashToken(key))
+	if errors.Is(err, model.ErrNotFound) {
+		return model.Actor{}, fmt.Errorf("%w: unknown api key", model.ErrUnauthenticated)
+	}
+	if err != nil {
+		return model.Actor{}, fmt.Errorf("error getting api key: %w", err)
+	}
+	if !apiKey.Active(time.Now()) {
+		return model.Actor{}, fmt.Errorf("%w: api key expired or revoked", model.ErrUnauthenticated)
+	}
+	return apiKey.Actor(), nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAPIKeyRepository is an in-memory implementation of the APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	keys map[uuid.UUID]model.APIKey
+}
+
+func (m *MockAPIKeyRepository) SaveAPIKey(ctx context.Context, key *model.APIKey) error {
+	m.keys[key.ID] = *key
+	return nil
+}
+
+func (m *MockAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (*model.APIKey, error) {
+	for _, key := range m.keys {
+		if key.Hash == hash {
+			return &key, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m *MockAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]model.APIKey, error) {
+	var keys []model.APIKey
+	for _, key := range m.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.After(keys[j].CreatedAt) })
+	return keys, nil
+}
+
+func (m *MockAPIKeyRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	key, ok := m.keys[id]
+	if !ok || !key.RevokedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	key.RevokedAt = revokedAt
+	m.keys[id] = key
+	return nil
+}
+
+func TestUserService_APIKeys(t *testing.T) {
+	apiKeyRepository := &MockAPIKeyRepository{keys: map[uuid.UUID]model.APIKey{}}
+	svc := NewUserService(UserServiceArgs{APIKeyRepository: apiKeyRepository})
+	ctx := model.ContextWithActor(context.Background(), model.Actor{ID: "admin", Roles: []model.Role{model.RoleAdmin}})
+
+	created, err := svc.CreateAPIKey(ctx, model.CreateAPIKeyArgs{Name: "matchmaking", Scopes: []model.Role{model.RoleSupport}})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(created.Key, apiKeyPrefix))
+	assert.Equal(t, "admin", created.APIKey.CreatedBy)
+	// only the digest of the key is stored
+	assert.Equal(t, hashToken(created.Key), apiKeyRepository.keys[created.APIKey.ID].Hash)
+
+	actor, err := svc.AuthenticateAPIKey(context.Background(), created.Key)
+	require.NoError(t, err)
+	assert.Equal(t, model.Actor{ID: "apikey:" + created.APIKey.ID.String(), Roles: []model.Role{model.RoleSupport}}, actor)
+	_, err = svc.AuthenticateAPIKey(context.Background(), apiKeyPrefix+"unknown")
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+
+	expired, err := svc.CreateAPIKey(ctx, model.CreateAPIKeyArgs{Name: "backoffice", Scopes: []model.Role{model.RoleAdmin}, ExpiresAt: time.Now().Add(-time.Second)})
+	require.NoError(t, err)
+	_, err = svc.AuthenticateAPIKey(context.Background(), expired.Key)
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+
+	res, err := svc.ListAPIKeys(ctx)
+	require.NoError(t, err)
+	assert.Len(t, res.APIKeys, 2)
+
+	require.NoError(t, svc.RevokeAPIKey(ctx, model.RevokeAPIKeyArgs{ID: created.APIKey.ID}))
+	_, err = svc.AuthenticateAPIKey(context.Background(), created.Key)
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+	require.ErrorIs(t, svc.RevokeAPIKey(ctx, model.RevokeAPIKeyArgs{ID: created.APIKey.ID}), model.ErrNotFound)
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// validateAttributes checks the attributes against the schemas of the attribute registry. Nil values, removing an
+// attribute, are accepted for every registered key. It returns an error wrapping model.ErrInvalidArgument if a key is
+// not registered or if a value does not conform to the schema of its key.
+func (s *UserService) validateAttributes(attributes map[string]interface{}) error {
+	for _, key := range sortedKeys(attributes) {
+		schema, ok := s.attributeSchemas[key]
+		if !ok {
+			return fmt.Errorf("%w: unknown attribute %q", model.ErrInvalidArgument, key)
+		}
+		if attributes[key] == nil {
+			continue
+		}
+		if err := validateAttribute(schema, attributes[key]); err != nil {
+			return fmt.Errorf("%w: attribute %q %s", model.ErrInvalidArgument, key, err)
+		}
+	}
+	return nil
+}
+
+// validateAttributeFilters checks that the attributes users are filtered by are registered. It returns an error
+// wrapping model.ErrInvalidArgument otherwise.
+func (s *UserService) validateAttributeFilters(filters map[string]string) error {
+	for key := range filters {
+		if _, ok := s.attributeSchemas[key]; !ok {
+			return fmt.Errorf("%w: unknown attribute %q", model.ErrInvalidArgument, key)
+		}
+	}
+	return nil
+}
+
+// validateAttribute checks that the decoded JSON value conforms to the schema.
+func validateAttribute(schema model.AttributeSchema, value interface{}) error {
+	switch schema.Type {
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return errors.New("must be a string")
+		}
+		length := utf8.RuneCountInString(str)
+		if schema.MinLength != nil && length < *schema.MinLength {
+			return fmt.Errorf("must be at least %d characters long", *schema.MinLength)
+		}
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			return fmt.Errorf("must be at most %d characters long", *schema.MaxLength)
+		}
+		if schema.Pattern != "" {
+			if matched, err := regexp.MatchString(schema.Pattern, str); err != nil || !matched {
+				return fmt.Errorf("must match %q", schema.Pattern)
+			}
+		}
+	case "number", "integer":
+		number, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("must be a %s", schema.Type)
+		}
+		if schema.Type == "integer" && number != math.Trunc(number) {
+			return errors.New("must be an integer")
+		}
+		if schema.Minimum != nil && number < *schema.Minimum {
+			return fmt.Errorf("must be at least %v", *schema.Minimum)
+		}
+		if schema.Maximum != nil && number > *schema.Maximum {
+			return fmt.Errorf("must be at most %v", *schema.Maximum)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return errors.New("must be a boolean")
+		}
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return errors.New("must be an array")
+		}
+		if schema.MaxItems != nil && len(items) > *schema.MaxItems {
+			return fmt.Errorf("must have at most %d items", *schema.MaxItems)
+		}
+		if schema.Items != nil {
+			for i, item := range items {
+				if err := validateAttribute(*schema.Items, item); err != nil {
+					return fmt.Errorf("item %d %s", i, err)
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("has the unsupported type %q", schema.Type)
+	}
+
+	if len(schema.Enum) > 0 {
+		for _, allowed := range schema.Enum {
+			if reflect.DeepEqual(allowed, value) {
+				return nil
+			}
+		}
+		return errors.New("must be one of the enumerated values")
+	}
+	return nil
+}
+
+// changedAttributes returns the field names, as "attributes.<key>", of the attributes that differ.
+func changedAttributes(before, after map[string]interface{}) []string {
+	var fields []string
+	for key, value := range after {
+		if previous, ok := before[key]; !ok || !reflect.DeepEqual(previous, value) {
+			fields = append(fields, "attributes."+key)
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			fields = append(fields, "attributes."+key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func sortedKeys(attributes map[string]interface{}) []string {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAttribute(t *testing.T) {
+	var schemas map[string]model.AttributeSchema
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"team": {"type": "string", "minLength": 2, "maxLength": 8, "pattern": "^[a-z]+$"},
+		"level": {"type": "integer", "minimum": 1, "maximum": 10},
+		"ratio": {"type": "number"},
+		"pro": {"type": "boolean"},
+		"platform": {"type": "string", "enum": ["pc", "console"]},
+		"games": {"type": "array", "maxItems": 2, "items": {"type": "string"}}
+	}`), &schemas))
+
+	tests := []struct {
+		name    string
+		key     string
+		value   interface{}
+		wantErr bool
+	}{
+		{name: "valid string", key: "team", value: "red"},
+		{name: "too short string", key: "team", value: "r", wantErr: true},
+		{name: "too long string", key: "team", value: "redredred", wantErr: true},
+		{name: "string not matching the pattern", key: "team", value: "Red", wantErr: true},
+		{name: "string of wrong type", key: "team", value: float64(1), wantErr: true},
+		{name: "valid integer", key: "level", value: float64(3)},
+		{name: "fractional integer", key: "level", value: 3.5, wantErr: true},
+		{name: "integer below minimum", key: "level", value: float64(0), wantErr: true},
+		{name: "integer above maximum", key: "level", value: float64(11), wantErr: true},
+		{name: "valid number", key: "ratio", value: 0.5},
+		{name: "valid boolean", key: "pro", value: true},
+		{name: "boolean of wrong type", key: "pro", value: "true", wantErr: true},
+		{name: "enumerated value", key: "platform", value: "pc"},
+		{name: "value not enumerated", key: "platform", value: "mobile", wantErr: true},
+		{name: "valid array", key: "games", value: []interface{}{"cs", "dota"}},
+		{name: "array with too many items", key: "games", value: []interface{}{"cs", "dota", "lol"}, wantErr: true},
+		{name: "array with invalid item", key: "games", value: []interface{}{true}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateAttribute(schemas[test.key], test.value)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUserService_Attributes(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		userID: {ID: userID, Email: "jane@example.com", Attributes: map[string]interface{}{"team": "red"}},
+	}}
+	auditRepository := &MockAuditRepository{}
+	svc := NewUserService(UserServiceArgs{
+		Repository:      repository,
+		AuditRepository: auditRepository,
+		AttributeSchemas: map[string]model.AttributeSchema{
+			"team":  {Type: "string"},
+			"level": {Type: "integer"},
+		},
+	})
+	ctx := context.Background()
+
+	resp, err := svc.UpdateUser(ctx, model.UpdateUserArgs{ID: userID, Attributes: map[string]interface{}{"team": nil, "level": float64(3)}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"level": float64(3)}, resp.User.Attributes)
+	assert.Equal(t, []string{"attributes.level", "attributes.team"}, auditRepository.entries[len(auditRepository.entries)-1].ChangedFields)
+
+	_, err = svc.UpdateUser(ctx, model.UpdateUserArgs{ID: userID, Attributes: map[string]interface{}{"level": "3"}})
+	assert.ErrorIs(t, err, model.ErrInvalidArgument)
+	_, err = svc.UpdateUser(ctx, model.UpdateUserArgs{ID: userID, Attributes: map[string]interface{}{"rank": "gold"}})
+	assert.ErrorIs(t, err, model.ErrInvalidArgument)
+	assert.Equal(t, map[string]interface{}{"level": float64(3)}, repository.users[userID].Attributes)
+
+	_, err = svc.ListUsers(ctx, model.ListUsersArgs{Attributes: map[string]string{"rank": "gold"}})
+	assert.ErrorIs(t, err, model.ErrInvalidArgument)
+}
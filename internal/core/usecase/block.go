@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// BlockUser blocks a user on behalf of another one, removing their friendship or pending friend request if any. The
+// blocked user cannot send friend requests to the blocker anymore. It returns an error wrapping
+// model.ErrInvalidArgument if users block themselves, model.ErrNotFound if either user does not exist and an error
+// wrapping model.ErrFailedPrecondition if the user is blocked already.
+func (s *UserService) BlockUser(ctx context.Context, args model.BlockUserArgs) (*model.BlockUserResponse, error) {
+	if args.UserID == args.BlockedID {
+		return nil, fmt.Errorf("%w: users cannot block themselves", model.ErrInvalidArgument)
+	}
+	block := &model.Block{BlockerID: args.UserID, BlockedID: args.BlockedID, CreatedAt: time.Now().UTC()}
+	if err := s.blockRepository.SaveBlock(ctx, block); err != nil {
+		return nil, fmt.Errorf("error saving block: %w", err)
+	}
+	if err := s.audit(ctx, args.UserID, model.AuditActionBlock); err != nil {
+		return nil, err
+	}
+	return &model.BlockUserResponse{Block: *block}, nil
+}
+
+// UnblockUser lifts the block of a user. It returns model.ErrNotFound if the user did not block the other one.
+func (s *UserService) UnblockUser(ctx context.Context, args model.UnblockUserArgs) error {
+	if err := s.blockRepository.DeleteBlock(ctx, args.UserID, args.BlockedID); err != nil {
+		return fmt.Errorf("error deleting block: %w", err)
+	}
+	return s.audit(ctx, args.UserID, model.AuditActionUnblock)
+}
+
+// ListBlockedUsers lists the users blocked by a user, most recently blocked first.
+func (s *UserService) ListBlockedUsers(ctx context.Context, args model.ListBlockedUsersArgs) (*model.ListBlockedUsersResponse, error) {
+	blocks, err := s.blockRepository.ListBlocks(ctx, ports.ListBlocksQuery{
+		BlockerID: args.UserID,
+		Limit:     args.Limit,
+		Offset:    args.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing blocks: %w", err)
+	}
+	return &model.ListBlockedUsersResponse{Blocks: blocks}, nil
+}
+
+// IsBlocked reports whether either user blocked the other, e.g. for chat and matchmaking to keep them apart.
+func (s *UserService) IsBlocked(ctx context.Context, args model.IsBlockedArgs) (bool, error) {
+	blocked, err := s.blockRepository.IsBlocked(ctx, args.UserID, args.OtherUserID)
+	if err != nil {
+		return false, fmt.Errorf("error checking blocks: %w", err)
+	}
+	return blocked, nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBlockRepository is an in-memory implementation of the BlockRepository interface. It deletes the friendships of
+// the FriendshipRepository, if any, on blocks.
+type MockBlockRepository struct {
+	blocks      []model.Block
+	friendships *MockFriendshipRepository
+}
+
+func (m *MockBlockRepository) SaveBlock(ctx context.Context, block *model.Block) error {
+	for _, existing := range m.blocks {
+		if existing.BlockerID == block.BlockerID && existing.BlockedID == block.BlockedID {
+			return fmt.Errorf("%w: user already blocked", model.ErrFailedPrecondition)
+		}
+	}
+	m.blocks = append(m.blocks, *block)
+	if m.friendships != nil {
+		if friendship, err := m.friendships.GetFriendship(ctx, block.BlockerID, block.BlockedID); err == nil {
+			return m.friendships.DeleteFriendship(ctx, *friendship)
+		}
+	}
+	return nil
+}
+
+func (m *MockBlockRepository) DeleteBlock(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	for i, block := range m.blocks {
+		if block.BlockerID == blockerID && block.BlockedID == blockedID {
+			m.blocks = append(m.blocks[:i:i], m.blocks[i+1:]...)
+			return nil
+		}
+	}
+	return model.ErrNotFound
+}
+
+func (m *MockBlockRepository) ListBlocks(ctx context.Context, query ports.ListBlocksQuery) ([]model.Block, error) {
+	var blocks []model.Block
+	for i := len(m.blocks) - 1; i >= 0; i-- {
+		if m.blocks[i].BlockerID == query.BlockerID {
+			blocks = append(blocks, m.blocks[i])
+		}
+	}
+	return blocks, nil
+}
+
+func (m *MockBlockRepository) IsBlocked(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error) {
+	for _, block := range m.blocks {
+		if block.BlockerID == userID && block.BlockedID == otherUserID ||
+			block.BlockerID == otherUserID && block.BlockedID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestUserService_Blocks(t *testing.T) {
+	jane, john, jim := uuid.New(), uuid.New(), uuid.New()
+	friendships := &MockFriendshipRepository{}
+	auditRepository := &MockAuditRepository{}
+	svc := NewUserService(UserServiceArgs{
+		AuditRepository:      auditRepository,
+		FriendshipRepository: friendships,
+		BlockRepository:      &MockBlockRepository{friendships: friendships},
+	})
+	ctx := context.Background()
+
+	_, err := svc.BlockUser(ctx, model.BlockUserArgs{UserID: jane, BlockedID: jane})
+	require.ErrorIs(t, err, model.ErrInvalidArgument)
+
+	// blocking removes the friendship
+	_, err = svc.RequestFriendship(ctx, model.RequestFriendshipArgs{UserID: john, FriendID: jane})
+	require.NoError(t, err)
+	resp, err := svc.BlockUser(ctx, model.BlockUserArgs{UserID: jane, BlockedID: john})
+	require.NoError(t, err)
+	assert.Equal(t, john, resp.Block.BlockedID)
+	_, err = svc.BlockUser(ctx, model.BlockUserArgs{UserID: jane, BlockedID: john})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+	friends, err := svc.ListFriends(ctx, model.ListFriendsArgs{UserID: jane})
+	require.NoError(t, err)
+	assert.Empty(t, friends.Friendships)
+
+	// blocks are enforced in both directions
+	for _, args := range []model.RequestFriendshipArgs{{UserID: john, FriendID: jane}, {UserID: jane, FriendID: john}} {
+		_, err = svc.RequestFriendship(ctx, args)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	}
+	blocked, err := svc.IsBlocked(ctx, model.IsBlockedArgs{UserID: john, OtherUserID: jane})
+	require.NoError(t, err)
+	assert.True(t, blocked)
+	blocked, err = svc.IsBlocked(ctx, model.IsBlockedArgs{UserID: john, OtherUserID: jim})
+	require.NoError(t, err)
+	assert.False(t, blocked)
+
+	_, err = svc.BlockUser(ctx, model.BlockUserArgs{UserID: jane, BlockedID: jim})
+	require.NoError(t, err)
+	blocks, err := svc.ListBlockedUsers(ctx, model.ListBlockedUsersArgs{UserID: jane})
+	require.NoError(t, err)
+	require.Len(t, blocks.Blocks, 2)
+	assert.Equal(t, jim, blocks.Blocks[0].BlockedID)
+
+	// only the blocker lifts the block
+	require.ErrorIs(t, svc.UnblockUser(ctx, model.UnblockUserArgs{UserID: john, BlockedID: jane}), model.ErrNotFound)
+	require.NoError(t, svc.UnblockUser(ctx, model.UnblockUserArgs{UserID: jane, BlockedID: john}))
+	_, err = svc.RequestFriendship(ctx, model.RequestFriendshipArgs{UserID: john, FriendID: jane})
+	require.NoError(t, err)
+
+	// the blocks and their lifts are audited in the trail of the blocker
+	var actions []model.AuditAction
+	for _, entry := range auditRepository.entries {
+		assert.Equal(t, jane, entry.UserID)
+		actions = append(actions, entry.Action)
+	}
+	assert.Equal(t, []model.AuditAction{model.AuditActionBlock, model.AuditActionBlock, model.AuditActionUnblock}, actions)
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// DefaultAccountDeletionGracePeriod is the grace period of the deletions requested by the users when none is
+// configured.
+const DefaultAccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+// RequestAccountDeletion schedules the deletion of the account of a user at the end of the grace period, during which
+// the user can cancel it. The account stays usable until the worker deletes it. It returns model.ErrNotFound if the
+// user does not exist and an error wrapping model.ErrFailedPrecondition if the user is scheduled for deletion already
+// or is suspended.
+func (s *UserService) RequestAccountDeletion(ctx context.Context, args model.RequestAccountDeletionArgs) (*model.RequestAccountDeletionResponse, error) {
+	now := time.Now().UTC()
+	scheduledFor := now.Add(s.accountDeletionGracePeriod)
+	if err := s.deletionRepository.ScheduleDeletion(ctx, args.UserID, scheduledFor, now); err != nil {
+		return nil, fmt.Errorf("error scheduling deletion: %w", err)
+	}
+	if err := s.audit(ctx, args.UserID, model.AuditActionRequestDeletion, "deletion_scheduled_for", "status"); err != nil {
+		return nil, err
+	}
+	return &model.RequestAccountDeletionResponse{ScheduledFor: scheduledFor}, nil
+}
+
+// CancelAccountDeletion cancels the scheduled deletion of the account of a user, who settles back in their status. It
+// returns model.ErrNotFound if the user does not exist and an error wrapping model.ErrFailedPrecondition if the user is
+// not scheduled for deletion.
+func (s *UserService) CancelAccountDeletion(ctx context.Context, args model.CancelAccountDeletionArgs) error {
+	if err := s.deletionRepository.CancelDeletion(ctx, args.UserID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error cancelling deletion: %w", err)
+	}
+	return s.audit(ctx, args.UserID, model.AuditActionCancelDeletion, "deletion_scheduled_for", "status")
+}
+
+// deleteDueUsers carries out the deletions that are due at the given time and returns how many were carried out. The
+// users are soft-deleted, then erased if hardDelete is set, which destroys their data key as EraseUser does. Every step
+// is audited, attributed to the actor carried by ctx.
+func (s *UserService) deleteDueUsers(ctx context.Context, now time.Time, hardDelete bool) (int, error) {
+	userIDs, err := s.deletionRepository.ListDueDeletions(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("error listing due deletions: %w", err)
+	}
+	deleted := 0
+	for _, userID := range userIDs {
+		err := s.deletionRepository.DeleteScheduledUser(ctx, userID, now)
+		// the deletion may have been cancelled, or the user deleted, since it was listed
+		if errors.Is(err, model.ErrFailedPrecondition) || errors.Is(err, model.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return deleted, fmt.Errorf("error deleting scheduled user %s: %w", userID, err)
+		}
+		if err := s.audit(ctx, userID, model.AuditActionDelete, "deleted_at", "status"); err != nil {
+			return deleted, err
+		}
+		if hardDelete {
+			if err := s.EraseUser(ctx, model.EraseUserArgs{ID: userID}); err != nil {
+				return deleted, fmt.Errorf("error erasing scheduled user %s: %w", userID, err)
+			}
+		}
+		deleted++
+	}
+	return deleted, nil
+}
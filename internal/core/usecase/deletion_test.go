@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDeletionRepository is an in-memory implementation of the DeletionRepository interface scheduling the deletions
+// in the users of a MockRepository.
+type MockDeletionRepository struct {
+	*MockRepository
+}
+
+func (m *MockDeletionRepository) ScheduleDeletion(ctx context.Context, userID uuid.UUID, scheduledFor time.Time, requestedAt time.Time) error {
+	user, ok := m.users[userID]
+	if !ok || !user.DeletedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	if err := user.Status.CheckTransition(model.UserStatusScheduledForDeletion); err != nil {
+		return err
+	}
+	user.Status, user.DeletionScheduledFor = model.UserStatusScheduledForDeletion, scheduledFor
+	m.users[userID] = user
+	return nil
+}
+
+func (m *MockDeletionRepository) CancelDeletion(ctx context.Context, userID uuid.UUID, cancelledAt time.Time) error {
+	user, ok := m.users[userID]
+	if !ok || !user.DeletedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	if user.Status != model.UserStatusScheduledForDeletion {
+		return fmt.Errorf("%w: user is %s, not scheduled for deletion", model.ErrFailedPrecondition, user.Status)
+	}
+	user.Status, user.DeletionScheduledFor = user.SettledStatus(), time.Time{}
+	m.users[userID] = user
+	return nil
+}
+
+func (m *MockDeletionRepository) ListDueDeletions(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	var due []uuid.UUID
+	for id, user := range m.users {
+		if user.Status == model.UserStatusScheduledForDeletion && !user.DeletionScheduledFor.After(now) {
+			due = append(due, id)
+		}
+	}
+	return due, nil
+}
+
+func (m *MockDeletionRepository) DeleteScheduledUser(ctx context.Context, userID uuid.UUID, now time.Time) error {
+	user, ok := m.users[userID]
+	if !ok {
+		return model.ErrNotFound
+	}
+	if user.Status != model.UserStatusScheduledForDeletion || user.DeletionScheduledFor.After(now) {
+		return fmt.Errorf("%w: deletion of user is not due", model.ErrFailedPrecondition)
+	}
+	user.Status, user.DeletedAt, user.DeletionScheduledFor = model.UserStatusDeleted, now, time.Time{}
+	m.users[userID] = user
+	return nil
+}
+
+func TestUserService_AccountDeletion(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		userID: {ID: userID, Status: model.UserStatusActive, VerifiedAt: time.Now()},
+	}}
+	auditRepository := &MockAuditRepository{}
+	svc := NewUserService(UserServiceArgs{
+		Repository:                 repository,
+		AuditRepository:            auditRepository,
+		SuspensionRepository:       &MockSuspensionRepository{MockRepository: repository},
+		DeletionRepository:         &MockDeletionRepository{MockRepository: repository},
+		AccountDeletionGracePeriod: 24 * time.Hour,
+	})
+	ctx := model.ContextWithActor(context.Background(), model.Actor{ID: userID.String()})
+
+	resp, err := svc.RequestAccountDeletion(ctx, model.RequestAccountDeletionArgs{UserID: userID})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), resp.ScheduledFor, time.Minute)
+	assert.Equal(t, model.UserStatusScheduledForDeletion, repository.users[userID].Status)
+	assert.Equal(t, resp.ScheduledFor, repository.users[userID].DeletionScheduledFor)
+	assert.Equal(t, model.AuditActionRequestDeletion, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	_, err = svc.RequestAccountDeletion(ctx, model.RequestAccountDeletionArgs{UserID: userID})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+	_, err = svc.RequestAccountDeletion(ctx, model.RequestAccountDeletionArgs{UserID: uuid.New()})
+	require.ErrorIs(t, err, model.ErrNotFound)
+
+	require.NoError(t, svc.CancelAccountDeletion(ctx, model.CancelAccountDeletionArgs{UserID: userID}))
+	assert.Equal(t, model.UserStatusActive, repository.users[userID].Status)
+	assert.True(t, repository.users[userID].DeletionScheduledFor.IsZero())
+	assert.Equal(t, model.AuditActionCancelDeletion, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	require.ErrorIs(t, svc.CancelAccountDeletion(ctx, model.CancelAccountDeletionArgs{UserID: userID}), model.ErrFailedPrecondition)
+
+	// suspending a user cancels their deletion, the suspended users cannot schedule it
+	_, err = svc.RequestAccountDeletion(ctx, model.RequestAccountDeletionArgs{UserID: userID})
+	require.NoError(t, err)
+	_, err = svc.SuspendUser(ctx, model.SuspendUserArgs{UserID: userID, Reason: model.SuspensionReasonCheating})
+	require.NoError(t, err)
+	assert.True(t, repository.users[userID].DeletionScheduledFor.IsZero())
+	_, err = svc.RequestAccountDeletion(ctx, model.RequestAccountDeletionArgs{UserID: userID})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+}
+
+func TestUserService_AccountDeletionDefaultGracePeriod(t *testing.T) {
+	userID := uuid.New()
+	repository := &MockRepository{users: map[uuid.UUID]model.User{userID: {ID: userID, Status: model.UserStatusPendingVerification}}}
+	svc := NewUserService(UserServiceArgs{
+		Repository:         repository,
+		AuditRepository:    &MockAuditRepository{},
+		DeletionRepository: &MockDeletionRepository{MockRepository: repository},
+	})
+
+	resp, err := svc.RequestAccountDeletion(context.Background(), model.RequestAccountDeletionArgs{UserID: userID})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(DefaultAccountDeletionGracePeriod), resp.ScheduledFor, time.Minute)
+}
+
+func TestSweeper_SweepAccountDeletions(t *testing.T) {
+	due, later := uuid.New(), uuid.New()
+	for _, hardDelete := range []bool{false, true} {
+		t.Run(fmt.Sprintf("hard delete %t", hardDelete), func(t *testing.T) {
+			repository := &MockRepository{users: map[uuid.UUID]model.User{
+				due:   {ID: due, Nickname: "due", Status: model.UserStatusScheduledForDeletion, DeletionScheduledFor: time.Now().Add(-time.Minute)},
+				later: {ID: later, Status: model.UserStatusScheduledForDeletion, DeletionScheduledFor: time.Now().Add(time.Hour)},
+			}}
+			auditRepository := &MockAuditRepository{}
+			vault := &MockVault{}
+			sweeper := NewSweeper(SweeperArgs{
+				UserService: NewUserService(UserServiceArgs{
+					Repository:           repository,
+					AuditRepository:      auditRepository,
+					Vault:                vault,
+					SuspensionRepository: &MockSuspensionRepository{MockRepository: repository},
+					DeletionRepository:   &MockDeletionRepository{MockRepository: repository},
+				}),
+				HardDeleteAccounts: hardDelete,
+			})
+
+			res, err := sweeper.Sweep(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, 1, res.DeletedAccounts)
+			user := repository.users[due]
+			// the erased users are crypto-shredded like the ones erased by the admins
+			wantActions := []model.AuditAction{model.AuditActionDelete}
+			if hardDelete {
+				assert.Empty(t, user.Nickname)
+				assert.Equal(t, []uuid.UUID{due}, vault.destroyed)
+				wantActions = append(wantActions, model.AuditActionErase)
+			} else {
+				assert.Equal(t, model.UserStatusDeleted, user.Status)
+				assert.False(t, user.DeletedAt.IsZero())
+				assert.Empty(t, vault.destroyed)
+			}
+			var actions []model.AuditAction
+			for _, entry := range auditRepository.entries {
+				assert.Equal(t, due, entry.UserID)
+				assert.Equal(t, "system:sweeper", entry.ActorID)
+				actions = append(actions, entry.Action)
+			}
+			assert.Equal(t, wantActions, actions)
+			assert.Equal(t, model.UserStatusScheduledForDeletion, repository.users[later].Status)
+
+			res, err = sweeper.Sweep(context.Background())
+			require.NoError(t, err)
+			assert.Zero(t, res.DeletedAccounts)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// checkEmail checks that the email can be given to the user: its domain must not be a disposable email domain, or a
+// subdomain of one, and no other user may hold the same canonical email, see model.CanonicalEmail. It returns an error
+// wrapping model.ErrInvalidArgument or model.ErrAlreadyExists otherwise.
+func (s *UserService) checkEmail(ctx context.Context, userID uuid.UUID, email string) error {
+	domain := model.EmailDomain(email)
+	for _, disposable := range s.disposableEmailDomains {
+		if domain == disposable || strings.HasSuffix(domain, "."+disposable) {
+			return fmt.Errorf("%w: email domain %q is not allowed", model.ErrInvalidArgument, domain)
+		}
+	}
+	holder, err := s.repository.GetUser(ctx, ports.GetUserQuery{Email: email})
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return fmt.Errorf("error getting user from repository: %w", err)
+	}
+	if err == nil && holder.ID != userID {
+		return fmt.Errorf("%w: email is taken", model.ErrAlreadyExists)
+	}
+	return nil
+}
+
+// emailDomains returns the lowercase domains, skipping the empty ones.
+func emailDomains(domains []string) []string {
+	var ret []string
+	for _, domain := range domains {
+		if domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), "."); domain != "" {
+			ret = append(ret, domain)
+		}
+	}
+	return ret
+}
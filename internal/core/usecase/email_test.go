@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_Emails(t *testing.T) {
+	jane := uuid.New()
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		jane: {ID: jane, Nickname: "jane", Email: "Jane.Doe@gmail.com"},
+	}}
+	svc := NewUserService(UserServiceArgs{
+		Repository:             repository,
+		AuditRepository:        &MockAuditRepository{},
+		LockoutRepository:      newMockLockoutRepository(),
+		DisposableEmailDomains: []string{" Mailinator.com ", ""},
+	})
+	ctx := context.Background()
+
+	// the disposable domains and their subdomains are rejected
+	for _, email := range []string{"john@mailinator.com", "john@MAILINATOR.COM", "john@eu.mailinator.com"} {
+		_, err := svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "john", Email: email, Password: "password"})
+		require.ErrorIs(t, err, model.ErrInvalidArgument, email)
+	}
+
+	// the emails reaching the same mailbox are taken
+	for _, email := range []string{"jane.doe@gmail.com", "JANEDOE@gmail.com", "jane.doe+games@googlemail.com"} {
+		_, err := svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "john", Email: email, Password: "password"})
+		require.ErrorIs(t, err, model.ErrAlreadyExists, email)
+	}
+
+	// the email is kept as typed
+	resp, err := svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "john", Email: "John@X.com", Password: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "John@X.com", resp.User.Email)
+	_, err = svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "jim", Email: "john@x.com", Password: "password"})
+	require.ErrorIs(t, err, model.ErrAlreadyExists)
+
+	// the users update their email to another form of theirs, not to the one of another user
+	actor := model.ContextWithActor(ctx, model.Actor{ID: jane.String()})
+	_, err = svc.UpdateUser(actor, model.UpdateUserArgs{ID: jane, Email: "janedoe@gmail.com"})
+	require.NoError(t, err)
+	_, err = svc.UpdateUser(actor, model.UpdateUserArgs{ID: jane, Email: "JOHN@x.com"})
+	require.ErrorIs(t, err, model.ErrAlreadyExists)
+	_, err = svc.UpdateUser(actor, model.UpdateUserArgs{ID: jane, Email: "jane@mailinator.com"})
+	require.ErrorIs(t, err, model.ErrInvalidArgument)
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// RequestFriendship sends a friend request, pending until the addressee accepts or declines it. It returns an error
+// wrapping model.ErrInvalidArgument if users befriend themselves, model.ErrNotFound if either user does not exist or
+// blocked the other one and an error wrapping model.ErrFailedPrecondition if the users already have a friendship or a
+// pending request.
+func (s *UserService) RequestFriendship(ctx context.Context, args model.RequestFriendshipArgs) (*model.RequestFriendshipResponse, error) {
+	if args.UserID == args.FriendID {
+		return nil, fmt.Errorf("%w: users cannot befriend themselves", model.ErrInvalidArgument)
+	}
+	// blocked users do not learn about the block, the blocker looks like a missing user
+	blocked, err := s.blockRepository.IsBlocked(ctx, args.UserID, args.FriendID)
+	if err != nil {
+		return nil, fmt.Errorf("error checking blocks: %w", err)
+	}
+	if blocked {
+		return nil, model.ErrNotFound
+	}
+	friendship := &model.Friendship{
+		RequesterID: args.UserID,
+		AddresseeID: args.FriendID,
+		Status:      model.FriendshipStatusPending,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := s.friendshipRepository.SaveFriendship(ctx, friendship); err != nil {
+		return nil, fmt.Errorf("error saving friendship: %w", err)
+	}
+	return &model.RequestFriendshipResponse{Friendship: *friendship}, nil
+}
+
+// AcceptFriendship accepts the friend request sent to the user by the friend. It returns model.ErrNotFound if there is
+// no such pending request.
+func (s *UserService) AcceptFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error {
+	if err := s.friendshipRepository.AcceptFriendship(ctx, args.FriendID, args.UserID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error accepting friendship: %w", err)
+	}
+	return nil
+}
+
+// DeclineFriendship declines the friend request sent to the user by the friend. It returns model.ErrNotFound if there
+// is no such pending request.
+func (s *UserService) DeclineFriendship(ctx context.Context, args model.AnswerFriendshipArgs) error {
+	err := s.friendshipRepository.DeleteFriendship(ctx, model.Friendship{
+		RequesterID: args.FriendID,
+		AddresseeID: args.UserID,
+		Status:      model.FriendshipStatusPending,
+	})
+	if err != nil {
+		return fmt.Errorf("error declining friendship: %w", err)
+	}
+	return nil
+}
+
+// RemoveFriend removes the friendship between the user and the friend, withdrawing the friend request if it is still
+// pending. It returns model.ErrNotFound if the users have no friendship.
+func (s *UserService) RemoveFriend(ctx context.Context, args model.RemoveFriendArgs) error {
+	friendship, err := s.friendshipRepository.GetFriendship(ctx, args.UserID, args.FriendID)
+	if err != nil {
+		return fmt.Errorf("error getting friendship: %w", err)
+	}
+	// the deletion fails if the friendship changed in the meantime, e.g. if the request was accepted concurrently
+	if err := s.friendshipRepository.DeleteFriendship(ctx, *friendship); err != nil {
+		return fmt.Errorf("error removing friendship: %w", err)
+	}
+	return nil
+}
+
+// ListFriends lists the friendships of a user, pending and accepted, in both directions, oldest first.
+func (s *UserService) ListFriends(ctx context.Context, args model.ListFriendsArgs) (*model.ListFriendsResponse, error) {
+	friendships, err := s.friendshipRepository.ListFriendships(ctx, ports.ListFriendshipsQuery{
+		UserID: args.UserID,
+		Status: args.Status,
+		Limit:  args.Limit,
+		Offset: args.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing friendships: %w", err)
+	}
+	return &model.ListFriendsResponse{Friendships: friendships}, nil
+}
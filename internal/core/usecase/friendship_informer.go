@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// NewFriendshipInformer builds a new friendship informer.
+func NewFriendshipInformer(sender ports.Sender) *FriendshipInformer {
+	return &FriendshipInformer{sender: sender}
+}
+
+// FriendshipInformer adapts CDC events of the friendships to a public-facing event. It publicly 'informs' about the
+// changes of the relationships between users.
+type FriendshipInformer struct {
+	sender ports.Sender
+}
+
+// Handle publishes the friendship event as it is, it only carries the ids of the users.
+func (i *FriendshipInformer) Handle(ctx context.Context, id string, friendshipEvent model.FriendshipEvent) error {
+	if err := i.sender.Send(ctx, model.UserEvent{ID: id, Friendship: &friendshipEvent}); err != nil {
+		return fmt.Errorf("error sending friendship event ID [%s]: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFriendshipInformer_Handle(t *testing.T) {
+	friendshipEvent := model.FriendshipEvent{
+		Type:        model.FriendshipEventAccepted,
+		RequesterID: uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+		AddresseeID: uuid.MustParse("6f2a6b0e-5d1c-4a8e-9a43-2b5a1f0c7d11"),
+	}
+	sender := &MockSender{
+		t: t,
+		UserEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+			require.Equal(t, "1", userEvent.ID)
+			require.Nil(t, userEvent.Before)
+			require.Nil(t, userEvent.After)
+			require.NotNil(t, userEvent.Friendship)
+			require.Equal(t, friendshipEvent, *userEvent.Friendship)
+		},
+	}
+	require.NoError(t, NewFriendshipInformer(sender).Handle(context.Background(), "1", friendshipEvent))
+	require.True(t, sender.called)
+
+	sendingError := errors.New("sending error")
+	sender = &MockSender{t: t, SendError: sendingError}
+	err := NewFriendshipInformer(sender).Handle(context.Background(), "2", friendshipEvent)
+	require.ErrorIs(t, err, sendingError)
+}
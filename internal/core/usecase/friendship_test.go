@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockFriendshipRepository is an in-memory implementation of the FriendshipRepository interface.
+type MockFriendshipRepository struct {
+	friendships []model.Friendship
+}
+
+func (m *MockFriendshipRepository) SaveFriendship(ctx context.Context, friendship *model.Friendship) error {
+	if _, err := m.GetFriendship(ctx, friendship.RequesterID, friendship.AddresseeID); err == nil {
+		return fmt.Errorf("%w: friendship already exists", model.ErrFailedPrecondition)
+	}
+	m.friendships = append(m.friendships, *friendship)
+	return nil
+}
+
+func (m *MockFriendshipRepository) GetFriendship(ctx context.Context, userID, friendID uuid.UUID) (*model.Friendship, error) {
+	for _, friendship := range m.friendships {
+		if friendship.RequesterID == userID && friendship.AddresseeID == friendID ||
+			friendship.RequesterID == friendID && friendship.AddresseeID == userID {
+			return &friendship, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m *MockFriendshipRepository) AcceptFriendship(ctx context.Context, requesterID, addresseeID uuid.UUID, acceptedAt time.Time) error {
+	for i, friendship := range m.friendships {
+		if friendship.RequesterID == requesterID && friendship.AddresseeID == addresseeID && friendship.Status == model.FriendshipStatusPending {
+			m.friendships[i].Status = model.FriendshipStatusAccepted
+			m.friendships[i].AcceptedAt = acceptedAt
+			return nil
+		}
+	}
+	return model.ErrNotFound
+}
+
+func (m *MockFriendshipRepository) DeleteFriendship(ctx context.Context, friendship model.Friendship) error {
+	for i, existing := range m.friendships {
+		if existing.RequesterID == friendship.RequesterID && existing.AddresseeID == friendship.AddresseeID && existing.Status == friendship.Status {
+			m.friendships = append(m.friendships[:i:i], m.friendships[i+1:]...)
+			return nil
+		}
+	}
+	return model.ErrNotFound
+}
+
+func (m *MockFriendshipRepository) ListFriendships(ctx context.Context, query ports.ListFriendshipsQuery) ([]model.Friendship, error) {
+	var friendships []model.Friendship
+	for _, friendship := range m.friendships {
+		if friendship.RequesterID != query.UserID && friendship.AddresseeID != query.UserID {
+			continue
+		}
+		if query.Status != "" && friendship.Status != query.Status {
+			continue
+		}
+		friendships = append(friendships, friendship)
+	}
+	return friendships, nil
+}
+
+func TestUserService_Friendships(t *testing.T) {
+	jane, john, jim := uuid.New(), uuid.New(), uuid.New()
+	svc := NewUserService(UserServiceArgs{
+		FriendshipRepository: &MockFriendshipRepository{},
+		BlockRepository:      &MockBlockRepository{},
+	})
+	ctx := context.Background()
+
+	_, err := svc.RequestFriendship(ctx, model.RequestFriendshipArgs{UserID: jane, FriendID: jane})
+	require.ErrorIs(t, err, model.ErrInvalidArgument)
+
+	resp, err := svc.RequestFriendship(ctx, model.RequestFriendshipArgs{UserID: jane, FriendID: john})
+	require.NoError(t, err)
+	assert.Equal(t, model.FriendshipStatusPending, resp.Friendship.Status)
+	_, err = svc.RequestFriendship(ctx, model.RequestFriendshipArgs{UserID: john, FriendID: jane})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+
+	// only the addressee answers the request
+	require.ErrorIs(t, svc.AcceptFriendship(ctx, model.AnswerFriendshipArgs{UserID: jane, FriendID: john}), model.ErrNotFound)
+	require.NoError(t, svc.AcceptFriendship(ctx, model.AnswerFriendshipArgs{UserID: john, FriendID: jane}))
+
+	_, err = svc.RequestFriendship(ctx, model.RequestFriendshipArgs{UserID: jim, FriendID: jane})
+	require.NoError(t, err)
+	require.ErrorIs(t, svc.DeclineFriendship(ctx, model.AnswerFriendshipArgs{UserID: jim, FriendID: jane}), model.ErrNotFound)
+	require.NoError(t, svc.DeclineFriendship(ctx, model.AnswerFriendshipArgs{UserID: jane, FriendID: jim}))
+
+	friends, err := svc.ListFriends(ctx, model.ListFriendsArgs{UserID: jane})
+	require.NoError(t, err)
+	require.Len(t, friends.Friendships, 1)
+	assert.Equal(t, john, friends.Friendships[0].FriendID(jane))
+	assert.Equal(t, model.FriendshipStatusAccepted, friends.Friendships[0].Status)
+
+	// either user removes the friendship
+	require.NoError(t, svc.RemoveFriend(ctx, model.RemoveFriendArgs{UserID: john, FriendID: jane}))
+	require.ErrorIs(t, svc.RemoveFriend(ctx, model.RemoveFriendArgs{UserID: jane, FriendID: john}), model.ErrNotFound)
+
+	// pending requests are withdrawn by their requester
+	_, err = svc.RequestFriendship(ctx, model.RequestFriendshipArgs{UserID: jane, FriendID: jim})
+	require.NoError(t, err)
+	require.NoError(t, svc.RemoveFriend(ctx, model.RemoveFriendArgs{UserID: jane, FriendID: jim}))
+	friends, err = svc.ListFriends(ctx, model.ListFriendsArgs{UserID: jane})
+	require.NoError(t, err)
+	assert.Empty(t, friends.Friendships)
+}
@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/rbroggi/faceittha/internal/core/model"
 	"github.com/rbroggi/faceittha/internal/core/ports"
@@ -21,6 +23,52 @@ type Informer struct {
 
 func (i *Informer) Handle(ctx context.Context, userEvent model.UserEvent) error {
 
+	// the tombstone of an erased user is not of interest to consumers, they already purged the user.
+	if userEvent.Before != nil && !userEvent.Before.ErasedAt.IsZero() {
+		return nil
+	}
+
+	// erasures are announced with a dedicated event that does not carry any personal data. Consumers are
+	// expected to purge their copies of the user data.
+	if userEvent.After != nil && !userEvent.After.ErasedAt.IsZero() {
+		erasure := model.UserEvent{
+			ID:      userEvent.ID,
+			Erasure: &model.UserErasure{UserID: userEvent.After.ID, ErasedAt: userEvent.After.ErasedAt},
+		}
+		if err := i.sender.Send(ctx, erasure); err != nil {
+			return fmt.Errorf("error sending user erasure event ID [%s]: %w", userEvent.ID, err)
+		}
+		return nil
+	}
+
+	// locks of the account are announced with a dedicated security event, they are not a change of the user profile.
+	if userEvent.Before != nil && userEvent.After != nil && userEvent.After.LockedUntil.After(userEvent.Before.LockedUntil) {
+		security := model.UserEvent{
+			ID: userEvent.ID,
+			Security: &model.SecurityEvent{
+				UserID:      userEvent.After.ID,
+				Type:        model.SecurityEventAccountLocked,
+				LockedUntil: userEvent.After.LockedUntil,
+			},
+		}
+		if err := i.sender.Send(ctx, security); err != nil {
+			return fmt.Errorf("error sending security event ID [%s]: %w", userEvent.ID, err)
+		}
+	}
+	// the steps of the deletions requested by the users are announced with dedicated events, the user event follows.
+	if deletion := accountDeletionEvent(userEvent); deletion != nil {
+		if err := i.sender.Send(ctx, model.UserEvent{ID: userEvent.ID, AccountDeletion: deletion}); err != nil {
+			return fmt.Errorf("error sending account deletion event ID [%s]: %w", userEvent.ID, err)
+		}
+	}
+
+	if userEvent.Before != nil {
+		userEvent.Before.LockedUntil = time.Time{}
+	}
+	if userEvent.After != nil {
+		userEvent.After.LockedUntil = time.Time{}
+	}
+
 	// 1. we don't want to publish changes in password
 	if userEvent.Before != nil {
 		userEvent.Before.PasswordHash = ""
@@ -47,6 +95,35 @@ func (i *Informer) Handle(ctx context.Context, userEvent model.UserEvent) error
 	return nil
 }
 
+// accountDeletionEvent returns the step of the deletion requested by the user the event is about, if any.
+func accountDeletionEvent(userEvent model.UserEvent) *model.AccountDeletionEvent {
+	before, after := userEvent.Before, userEvent.After
+	switch {
+	case after != nil && after.Status == model.UserStatusScheduledForDeletion &&
+		(before == nil || before.Status != model.UserStatusScheduledForDeletion):
+		return &model.AccountDeletionEvent{
+			UserID:       after.ID,
+			Type:         model.AccountDeletionScheduled,
+			ScheduledFor: after.DeletionScheduledFor,
+		}
+	case before == nil || before.Status != model.UserStatusScheduledForDeletion:
+		return nil
+	case after == nil || after.Status == model.UserStatusDeleted:
+		return &model.AccountDeletionEvent{
+			UserID:       before.ID,
+			Type:         model.AccountDeletionCompleted,
+			ScheduledFor: before.DeletionScheduledFor,
+		}
+	case after.Status != model.UserStatusScheduledForDeletion:
+		return &model.AccountDeletionEvent{
+			UserID:       before.ID,
+			Type:         model.AccountDeletionCancelled,
+			ScheduledFor: before.DeletionScheduledFor,
+		}
+	}
+	return nil
+}
+
 func eventsAreEqual(before *model.User, after *model.User) bool {
 	if before == nil && after == nil {
 		return true
@@ -57,5 +134,5 @@ func eventsAreEqual(before *model.User, after *model.User) bool {
 	if before != nil && after == nil {
 		return false
 	}
-	return *before == *after
+	return reflect.DeepEqual(*before, *after)
 }
\ No newline at end of file
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rbroggi/faceittha/internal/core/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,7 @@ func (m *MockSender) Send(ctx context.Context, userEvent model.UserEvent) error
 
 func TestInformer_Handle(t *testing.T) {
 	sendingError := errors.New("sending error")
+	erasedAt := time.Now()
 	tests := []struct {
 		name             string
 		userEvent        model.UserEvent
@@ -110,6 +112,70 @@ func TestInformer_Handle(t *testing.T) {
 			},
 			callsSendMethod: true,
 		},
+		{
+			name: "role grant",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					FirstName: "name1",
+				},
+				After:  &model.User{
+					FirstName: "name1",
+					Roles:     []model.Role{model.RoleSupport},
+				},
+			},
+			userEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+				require.NotNil(t, userEvent.Before)
+				require.NotNil(t, userEvent.After)
+				require.Empty(t, userEvent.Before.Roles)
+				require.Equal(t, []model.Role{model.RoleSupport}, userEvent.After.Roles)
+			},
+			callsSendMethod: true,
+		},
+		{
+			name: "attribute change",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					FirstName:  "name1",
+					Attributes: map[string]interface{}{"team": "red"},
+				},
+				After:  &model.User{
+					FirstName:  "name1",
+					Attributes: map[string]interface{}{"team": "blue"},
+				},
+			},
+			userEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+				require.NotNil(t, userEvent.Before)
+				require.NotNil(t, userEvent.After)
+				require.Equal(t, map[string]interface{}{"team": "blue"}, userEvent.After.Attributes)
+			},
+			callsSendMethod: true,
+		},
+		{
+			name: "suspension expiry",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					FirstName:        "name1",
+					SuspensionReason: model.SuspensionReasonCheating,
+					SuspendedAt:      erasedAt,
+					SuspendedUntil:   erasedAt.Add(time.Hour),
+					Status:           model.UserStatusSuspended,
+				},
+				After:  &model.User{
+					FirstName: "name1",
+					Status:    model.UserStatusActive,
+				},
+			},
+			userEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+				require.NotNil(t, userEvent.Before)
+				require.NotNil(t, userEvent.After)
+				require.Equal(t, model.UserStatusSuspended, userEvent.Before.Status)
+				require.Equal(t, model.UserStatusActive, userEvent.After.Status)
+			},
+			callsSendMethod: true,
+		},
 		{
 			name: "update only in the password hash should not send event",
 			userEvent: model.UserEvent{
@@ -125,6 +191,179 @@ func TestInformer_Handle(t *testing.T) {
 			},
 			callsSendMethod: false,
 		},
+		{
+			name: "user erasure sends a dedicated event without personal data",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					ID:        uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					FirstName: "name1",
+				},
+				After:  &model.User{
+					ID:        uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					DeletedAt: erasedAt,
+					ErasedAt:  erasedAt,
+				},
+			},
+			userEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+				require.Nil(t, userEvent.Before)
+				require.Nil(t, userEvent.After)
+				require.Equal(t, "1", userEvent.ID)
+				require.Equal(t, &model.UserErasure{
+					UserID:   uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					ErasedAt: erasedAt,
+				}, userEvent.Erasure)
+			},
+			callsSendMethod: true,
+		},
+		{
+			name: "account lock sends a security event instead of a user update",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					ID:        uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					FirstName: "name1",
+				},
+				After:  &model.User{
+					ID:          uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					FirstName:   "name1",
+					LockedUntil: erasedAt,
+				},
+			},
+			userEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+				require.Nil(t, userEvent.Before)
+				require.Nil(t, userEvent.After)
+				require.Equal(t, "1", userEvent.ID)
+				require.Equal(t, &model.SecurityEvent{
+					UserID:      uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					Type:        model.SecurityEventAccountLocked,
+					LockedUntil: erasedAt,
+				}, userEvent.Security)
+			},
+			callsSendMethod: true,
+		},
+		{
+			name: "deletion request sends a scheduled event followed by the user update",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					ID:     uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					Status: model.UserStatusActive,
+				},
+				After:  &model.User{
+					ID:                   uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					Status:               model.UserStatusScheduledForDeletion,
+					DeletionScheduledFor: erasedAt,
+				},
+			},
+			userEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+				require.Equal(t, "1", userEvent.ID)
+				if userEvent.AccountDeletion == nil {
+					require.NotNil(t, userEvent.After)
+					require.Equal(t, model.UserStatusScheduledForDeletion, userEvent.After.Status)
+					return
+				}
+				require.Nil(t, userEvent.Before)
+				require.Nil(t, userEvent.After)
+				require.Equal(t, &model.AccountDeletionEvent{
+					UserID:       uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					Type:         model.AccountDeletionScheduled,
+					ScheduledFor: erasedAt,
+				}, userEvent.AccountDeletion)
+			},
+			callsSendMethod: true,
+		},
+		{
+			name: "deletion cancellation sends a cancelled event followed by the user update",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					ID:                   uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					Status:               model.UserStatusScheduledForDeletion,
+					DeletionScheduledFor: erasedAt,
+				},
+				After:  &model.User{
+					ID:     uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					Status: model.UserStatusActive,
+				},
+			},
+			userEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+				if userEvent.AccountDeletion == nil {
+					require.NotNil(t, userEvent.After)
+					require.Equal(t, model.UserStatusActive, userEvent.After.Status)
+					return
+				}
+				require.Equal(t, model.AccountDeletionCancelled, userEvent.AccountDeletion.Type)
+				require.Equal(t, erasedAt, userEvent.AccountDeletion.ScheduledFor)
+			},
+			callsSendMethod: true,
+		},
+		{
+			name: "scheduled soft deletion sends a completed event followed by the deletion",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					ID:                   uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					Status:               model.UserStatusScheduledForDeletion,
+					DeletionScheduledFor: erasedAt,
+				},
+				After:  &model.User{
+					ID:        uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					Status:    model.UserStatusDeleted,
+					DeletedAt: erasedAt,
+				},
+			},
+			userEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+				if userEvent.AccountDeletion == nil {
+					require.NotNil(t, userEvent.Before)
+					require.Nil(t, userEvent.After)
+					return
+				}
+				require.Equal(t, model.AccountDeletionCompleted, userEvent.AccountDeletion.Type)
+				require.Equal(t, erasedAt, userEvent.AccountDeletion.ScheduledFor)
+			},
+			callsSendMethod: true,
+		},
+		{
+			name: "scheduled hard deletion sends a completed event followed by the deletion",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					ID:                   uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"),
+					Status:               model.UserStatusScheduledForDeletion,
+					DeletionScheduledFor: erasedAt,
+				},
+			},
+			userEventAssertion: func(t *testing.T, userEvent model.UserEvent) {
+				if userEvent.AccountDeletion == nil {
+					require.NotNil(t, userEvent.Before)
+					require.Nil(t, userEvent.After)
+					return
+				}
+				require.Equal(t, model.AccountDeletionCompleted, userEvent.AccountDeletion.Type)
+			},
+			callsSendMethod: true,
+		},
+		{
+			name: "account unlock should not send event",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{FirstName: "name1", LockedUntil: erasedAt},
+				After:  &model.User{FirstName: "name1"},
+			},
+			callsSendMethod: false,
+		},
+		{
+			name: "changes to the tombstone of an erased user should not send event",
+			userEvent: model.UserEvent{
+				ID:     "1",
+				Before: &model.User{
+					DeletedAt: erasedAt,
+					ErasedAt:  erasedAt,
+				},
+			},
+			callsSendMethod: false,
+		},
 		{
 			name: "error in sending event triggers error in handler",
 			userEvent: model.UserEvent{ID: "1", Before: &model.User{FirstName: "name1"}, After: &model.User{FirstName: "name2"}},
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alexedwards/argon2id"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// lockoutPolicy defines when the credential checks of an account or a source IP are locked.
+type lockoutPolicy struct {
+	// maxFailures is the number of consecutive failures tolerated before locking.
+	maxFailures int
+
+	// baseLock is the duration of the first lock, every further failure doubles it.
+	baseLock time.Duration
+
+	// maxLock caps the duration of the locks.
+	maxLock time.Duration
+
+	// resetAfter restarts the counter when the last failure is older.
+	resetAfter time.Duration
+}
+
+// lockDuration is the duration of the lock after the given number of consecutive failures, zero if it is tolerated.
+func (p lockoutPolicy) lockDuration(failures int) time.Duration {
+	if failures < p.maxFailures {
+		return 0
+	}
+	duration := p.baseLock
+	for i := p.maxFailures; i < failures && duration < p.maxLock; i++ {
+		duration *= 2
+	}
+	if duration > p.maxLock {
+		return p.maxLock
+	}
+	return duration
+}
+
+var (
+	// accountLockoutPolicy protects a single account against guessing of its password.
+	accountLockoutPolicy = lockoutPolicy{maxFailures: 5, baseLock: time.Minute, maxLock: 24 * time.Hour, resetAfter: 24 * time.Hour}
+
+	// ipLockoutPolicy protects the accounts against a source IP spraying passwords across many of them.
+	ipLockoutPolicy = lockoutPolicy{maxFailures: 20, baseLock: time.Minute, maxLock: 24 * time.Hour, resetAfter: 24 * time.Hour}
+)
+
+// UnlockUser lifts the lock of an account locked after too many failed credential checks and forgets the failures.
+// It returns model.ErrNotFound if the ID does not correspond to an existing user.
+func (s *UserService) UnlockUser(ctx context.Context, args model.UnlockUserArgs) error {
+	user, err := s.repository.GetUser(ctx, ports.GetUserQuery{ID: args.ID, IncludeDeleted: true})
+	if err != nil {
+		return fmt.Errorf("error getting user from repository: %w", err)
+	}
+	if err := s.lockoutRepository.ResetCredentialFailures(ctx, model.CredentialScopeEmail, user.Email); err != nil {
+		return fmt.Errorf("error resetting credential failures: %w", err)
+	}
+	return s.audit(ctx, args.ID, model.AuditActionUnlock, "locked_until")
+}
+
+var (
+	dummyPasswordHashOnce sync.Once
+	dummyPasswordHash     string
+)
+
+// verifyPassword checks the password of the user looked up by email, with protection against brute-force: the
+// failures are counted against the email and the source IP of the request, and each of them is locked, for
+// exponentially growing periods, once it exceeds its lockout policy. It returns model.ErrInvalidCredentials if the
+// password does not match, and while locked without checking the password, so that the locks are not told apart.
+// A nil user, i.e. an unknown account, is checked against a dummy hash so that it is not told apart by the response
+// time, and its failures are counted and locked like the ones of the existing accounts.
+// The failures are not forgotten on success: the callers reset them once every credential of the operation is checked.
+func (s *UserService) verifyPassword(ctx context.Context, email string, user *model.User, password string) error {
+	keys := credentialKeys(ctx, email)
+	if err := s.checkCredentialLocks(ctx, keys); err != nil {
+		return err
+	}
+
+	passwordHash := ""
+	if user != nil {
+		passwordHash = user.PasswordHash
+	} else {
+		dummyPasswordHashOnce.Do(func() {
+			dummyPasswordHash, _ = argon2id.CreateHash(uuid.NewString(), argon2id.DefaultParams)
+		})
+		passwordHash = dummyPasswordHash
+	}
+	match, err := argon2id.ComparePasswordAndHash(password, passwordHash)
+	if err != nil {
+		return fmt.Errorf("error comparing password hash: %w", err)
+	}
+	if match && user != nil {
+		return nil
+	}
+	return s.credentialFailure(ctx, user, keys)
+}
+
+// credentialKeys are the keys the failed credential checks for the email are counted against within the request.
+func credentialKeys(ctx context.Context, email string) map[model.CredentialScope]string {
+	keys := map[model.CredentialScope]string{model.CredentialScopeEmail: email}
+	if sourceIP := model.RequestMetadataFromContext(ctx).SourceIP; sourceIP != "" {
+		keys[model.CredentialScopeIP] = sourceIP
+	}
+	return keys
+}
+
+// checkCredentialLocks returns model.ErrInvalidCredentials if any of the keys is locked.
+func (s *UserService) checkCredentialLocks(ctx context.Context, keys map[model.CredentialScope]string) error {
+	now := time.Now()
+	for scope, key := range keys {
+		failures, err := s.lockoutRepository.GetCredentialFailures(ctx, scope, key)
+		if errors.Is(err, model.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error getting credential failures: %w", err)
+		}
+		if failures.LockedUntil.After(now) {
+			return model.ErrInvalidCredentials
+		}
+	}
+	return nil
+}
+
+// credentialFailure counts a failed credential check against the keys, locking the ones exceeding their lockout
+// policy. The locks of existing accounts are audited. It returns model.ErrInvalidCredentials.
+func (s *UserService) credentialFailure(ctx context.Context, user *model.User, keys map[model.CredentialScope]string) error {
+	now := time.Now()
+	for scope, key := range keys {
+		policy := accountLockoutPolicy
+		if scope == model.CredentialScopeIP {
+			policy = ipLockoutPolicy
+		}
+		failures, err := s.lockoutRepository.RecordCredentialFailure(ctx, ports.RecordCredentialFailureQuery{
+			Scope:       scope,
+			Key:         key,
+			ResetBefore: now.Add(-policy.resetAfter),
+		})
+		if err != nil {
+			return fmt.Errorf("error recording credential failure: %w", err)
+		}
+		lock := policy.lockDuration(failures.Failures)
+		if lock == 0 {
+			continue
+		}
+		if err := s.lockoutRepository.LockCredentials(ctx, scope, key, now.Add(lock)); err != nil {
+			return fmt.Errorf("error locking credentials: %w", err)
+		}
+		if scope == model.CredentialScopeEmail && user != nil {
+			if err := s.audit(ctx, user.ID, model.AuditActionLock, "locked_until"); err != nil {
+				return err
+			}
+		}
+	}
+	return model.ErrInvalidCredentials
+}
+
+// resetCredentialFailures forgets the failed credential checks of the user after a successful one.
+func (s *UserService) resetCredentialFailures(ctx context.Context, user *model.User) error {
+	if err := s.lockoutRepository.ResetCredentialFailures(ctx, model.CredentialScopeEmail, user.Email); err != nil {
+		return fmt.Errorf("error resetting credential failures: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockLockoutRepository is an in-memory implementation of the LockoutRepository interface.
+type MockLockoutRepository struct {
+	failures map[model.CredentialScope]map[string]model.CredentialFailures
+}
+
+func newMockLockoutRepository() *MockLockoutRepository {
+	return &MockLockoutRepository{failures: map[model.CredentialScope]map[string]model.CredentialFailures{
+		model.CredentialScopeEmail: {},
+		model.CredentialScopeIP:    {},
+	}}
+}
+
+// key canonicalizes the emails, as the repositories looking the accounts up by canonical email.
+func (m *MockLockoutRepository) key(scope model.CredentialScope, key string) string {
+	if scope == model.CredentialScopeEmail {
+		return model.CanonicalEmail(key)
+	}
+	return key
+}
+
+func (m *MockLockoutRepository) GetCredentialFailures(ctx context.Context, scope model.CredentialScope, key string) (*model.CredentialFailures, error) {
+	failures, ok := m.failures[scope][m.key(scope, key)]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return &failures, nil
+}
+
+func (m *MockLockoutRepository) RecordCredentialFailure(ctx context.Context, query ports.RecordCredentialFailureQuery) (*model.CredentialFailures, error) {
+	key := m.key(query.Scope, query.Key)
+	failures := m.failures[query.Scope][key]
+	if failures.LastFailureAt.Before(query.ResetBefore) {
+		failures.Failures = 0
+	}
+	failures.Scope, failures.Key = query.Scope, key
+	failures.Failures++
+	failures.LastFailureAt = time.Now()
+	m.failures[query.Scope][key] = failures
+	return &failures, nil
+}
+
+func (m *MockLockoutRepository) LockCredentials(ctx context.Context, scope model.CredentialScope, key string, until time.Time) error {
+	key = m.key(scope, key)
+	failures := m.failures[scope][key]
+	failures.LockedUntil = until
+	m.failures[scope][key] = failures
+	return nil
+}
+
+func (m *MockLockoutRepository) ResetCredentialFailures(ctx context.Context, scope model.CredentialScope, key string) error {
+	delete(m.failures[scope], m.key(scope, key))
+	return nil
+}
+
+func TestLockoutPolicy_LockDuration(t *testing.T) {
+	policy := lockoutPolicy{maxFailures: 3, baseLock: time.Minute, maxLock: 10 * time.Minute}
+	for failures, expected := range map[int]time.Duration{
+		1:  0,
+		2:  0,
+		3:  time.Minute,
+		4:  2 * time.Minute,
+		5:  4 * time.Minute,
+		6:  8 * time.Minute,
+		7:  10 * time.Minute,
+		50: 10 * time.Minute,
+	} {
+		assert.Equal(t, expected, policy.lockDuration(failures), "%d failures", failures)
+	}
+}
+
+func TestUserService_ChangePasswordLocksAccount(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		userID: {ID: userID, Email: "jane@example.com", PasswordHash: mustHash(t, "current-password")},
+	}}
+	auditRepository := &MockAuditRepository{}
+	lockoutRepository := newMockLockoutRepository()
+	svc := NewUserService(UserServiceArgs{
+		Repository:        repository,
+		AuditRepository:   auditRepository,
+		LockoutRepository: lockoutRepository,
+	})
+	ctx := model.ContextWithRequestMetadata(context.Background(), model.RequestMetadata{SourceIP: "10.0.0.1"})
+	wrong := model.ChangePasswordArgs{ID: userID, CurrentPassword: "wrong-password", NewPassword: "new-password"}
+	right := model.ChangePasswordArgs{ID: userID, CurrentPassword: "current-password", NewPassword: "new-password"}
+
+	for i := 0; i < accountLockoutPolicy.maxFailures; i++ {
+		require.ErrorIs(t, svc.ChangePassword(ctx, wrong), model.ErrInvalidCredentials)
+	}
+	// the right password is rejected while locked
+	require.ErrorIs(t, svc.ChangePassword(ctx, right), model.ErrInvalidCredentials)
+	locked := lockoutRepository.failures[model.CredentialScopeEmail]["jane@example.com"]
+	assert.WithinDuration(t, time.Now().Add(accountLockoutPolicy.baseLock), locked.LockedUntil, time.Second)
+	require.Len(t, auditRepository.entries, 1)
+	assert.Equal(t, model.AuditActionLock, auditRepository.entries[0].Action)
+	// the failures are counted against the source IP too, below its own threshold
+	assert.Equal(t, accountLockoutPolicy.maxFailures, lockoutRepository.failures[model.CredentialScopeIP]["10.0.0.1"].Failures)
+	assert.True(t, lockoutRepository.failures[model.CredentialScopeIP]["10.0.0.1"].LockedUntil.IsZero())
+
+	// once the lock expired, a further failure locks for twice as long
+	locked.LockedUntil = time.Now().Add(-time.Second)
+	lockoutRepository.failures[model.CredentialScopeEmail]["jane@example.com"] = locked
+	require.ErrorIs(t, svc.ChangePassword(ctx, wrong), model.ErrInvalidCredentials)
+	relocked := lockoutRepository.failures[model.CredentialScopeEmail]["jane@example.com"]
+	assert.WithinDuration(t, time.Now().Add(2*accountLockoutPolicy.baseLock), relocked.LockedUntil, time.Second)
+
+	// an admin lifts the lock
+	require.NoError(t, svc.UnlockUser(ctx, model.UnlockUserArgs{ID: userID}))
+	assert.Empty(t, lockoutRepository.failures[model.CredentialScopeEmail])
+	assert.Equal(t, model.AuditActionUnlock, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	require.NoError(t, svc.ChangePassword(ctx, right))
+
+	require.ErrorIs(t, svc.UnlockUser(ctx, model.UnlockUserArgs{ID: uuid.New()}), model.ErrNotFound)
+}
+
+func TestUserService_ChangePasswordLocksSourceIP(t *testing.T) {
+	repository := &MockRepository{users: map[uuid.UUID]model.User{}}
+	lockoutRepository := newMockLockoutRepository()
+	svc := NewUserService(UserServiceArgs{
+		Repository:        repository,
+		AuditRepository:   &MockAuditRepository{},
+		LockoutRepository: lockoutRepository,
+	})
+	ctx := model.ContextWithRequestMetadata(context.Background(), model.RequestMetadata{SourceIP: "10.0.0.1"})
+
+	// a single attempt per account, sprayed across many of them
+	for i := 0; i < ipLockoutPolicy.maxFailures; i++ {
+		userID := uuid.New()
+		repository.users[userID] = model.User{ID: userID, Email: userID.String() + "@example.com", PasswordHash: mustHash(t, "password")}
+		err := svc.ChangePassword(ctx, model.ChangePasswordArgs{ID: userID, CurrentPassword: "guess", NewPassword: "new-password"})
+		require.ErrorIs(t, err, model.ErrInvalidCredentials)
+	}
+
+	userID := uuid.New()
+	repository.users[userID] = model.User{ID: userID, Email: userID.String() + "@example.com", PasswordHash: mustHash(t, "password")}
+	err := svc.ChangePassword(ctx, model.ChangePasswordArgs{ID: userID, CurrentPassword: "password", NewPassword: "new-password"})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+
+	// other source IPs are not affected
+	otherCtx := model.ContextWithRequestMetadata(context.Background(), model.RequestMetadata{SourceIP: "10.0.0.2"})
+	require.NoError(t, svc.ChangePassword(otherCtx, model.ChangePasswordArgs{ID: userID, CurrentPassword: "password", NewPassword: "new-password"}))
+}
+
+func TestUserService_LoginLocksUnknownAccounts(t *testing.T) {
+	svc, _, _, auditRepository := newSessionTestService(t)
+	ctx := context.Background()
+
+	// the existing and the unknown accounts are locked alike, with the error of a wrong password
+	for _, email := range []string{"jane@example.com", "unknown@example.com"} {
+		for i := 0; i < accountLockoutPolicy.maxFailures; i++ {
+			_, err := svc.Login(ctx, model.LoginArgs{Email: email, Password: "wrong"})
+			require.ErrorIs(t, err, model.ErrInvalidCredentials)
+		}
+		_, err := svc.Login(ctx, model.LoginArgs{Email: email, Password: "password"})
+		require.ErrorIs(t, err, model.ErrInvalidCredentials, email)
+		assert.Equal(t, "invalid credentials", err.Error(), email)
+	}
+	// only the lock of the existing account is audited
+	require.Len(t, auditRepository.entries, 1)
+	assert.Equal(t, model.AuditActionLock, auditRepository.entries[0].Action)
+}
@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+const (
+	// DefaultNicknameChangeCooldown is the time the users wait between two changes of their nickname when none is
+	// configured.
+	DefaultNicknameChangeCooldown = 30 * 24 * time.Hour
+
+	// DefaultNicknameReservation is the time the released nicknames are reserved to their previous holder when none is
+	// configured.
+	DefaultNicknameReservation = 90 * 24 * time.Hour
+
+	// nicknameReservationTimeout is how long a nickname checked on signup is held for the creation of the user.
+	nicknameReservationTimeout = 10 * time.Minute
+
+	// nicknameSuggestions is the number of suggestions made for a taken nickname.
+	nicknameSuggestions = 3
+
+	// DefaultNicknameMinLength is the minimum length of the nicknames when none is configured.
+	DefaultNicknameMinLength = 2
+
+	// DefaultNicknameMaxLength is the maximum length of the nicknames when none is configured.
+	DefaultNicknameMaxLength = 32
+
+	// maxNicknameMarks is the number of combining marks a character of a nickname carries at most, more stack into
+	// unreadable text.
+	maxNicknameMarks = 2
+)
+
+// DefaultReservedNicknames are the nicknames given by the admins only when none are configured.
+var DefaultReservedNicknames = []string{"admin", "administrator", "moderator", "support", "staff", "system", "root"}
+
+// GetNicknameHistory lists the nickname changes of a user, most recent first. It returns model.ErrNotFound if the user
+// does not exist.
+func (s *UserService) GetNicknameHistory(ctx context.Context, args model.GetNicknameHistoryArgs) (*model.GetNicknameHistoryResponse, error) {
+	if _, err := s.repository.GetUser(ctx, ports.GetUserQuery{ID: args.UserID, IncludeDeleted: true}); err != nil {
+		return nil, fmt.Errorf("error getting user from repository: %w", err)
+	}
+	if s.nicknameRepository == nil {
+		return &model.GetNicknameHistoryResponse{}, nil
+	}
+	changes, err := s.nicknameRepository.ListNicknameChanges(ctx, ports.ListNicknameChangesQuery{
+		UserID: args.UserID,
+		Limit:  args.Limit,
+		Offset: args.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nickname changes: %w", err)
+	}
+	return &model.GetNicknameHistoryResponse{Changes: changes}, nil
+}
+
+// checkNicknameChange checks that the nickname the user changes to is not taken.
+func (s *UserService) checkNicknameChange(ctx context.Context, userID uuid.UUID, nickname string) error {
+	if s.nicknameRepository == nil {
+		return nil
+	}
+	return s.checkNicknameTaken(ctx, userID, nickname, "", time.Now().UTC())
+}
+
+// nicknameCooldown is the cooldown since their last nickname change the repository enforces on the update of a user,
+// none when the actor is an admin, e.g. renaming an offensive nickname.
+func (s *UserService) nicknameCooldown(ctx context.Context) time.Duration {
+	if s.nicknameRepository == nil {
+		return 0
+	}
+	if actor, _ := model.ActorFromContext(ctx); actor.HasRole(model.RoleAdmin) {
+		return 0
+	}
+	return s.nicknameChangeCooldown
+}
+
+// CheckNicknameAvailability tells whether a nickname can be taken, see validateNickname, with the reason of its
+// rejection otherwise. Taken nicknames come with suggestions, available ones are reserved on demand for the creation of
+// a user with the token of the reservation.
+func (s *UserService) CheckNicknameAvailability(ctx context.Context, args model.CheckNicknameAvailabilityArgs) (*model.CheckNicknameAvailabilityResponse, error) {
+	if s.nicknameRepository == nil {
+		return nil, fmt.Errorf("%w: nickname checks are not configured", model.ErrFailedPrecondition)
+	}
+	nickname := model.NormalizeNickname(args.Nickname)
+	if rejection := s.nicknameRejection(ctx, nickname); rejection != "" {
+		return &model.CheckNicknameAvailabilityResponse{Nickname: nickname, Rejection: rejection}, nil
+	}
+	now := time.Now().UTC()
+	taken, err := s.nicknameTaken(ctx, uuid.Nil, nickname, "", now)
+	if err != nil {
+		return nil, err
+	}
+	if !taken && !args.Reserve {
+		return &model.CheckNicknameAvailabilityResponse{Nickname: nickname, Available: true}, nil
+	}
+	if !taken {
+		token, err := newToken()
+		if err != nil {
+			return nil, fmt.Errorf("error generating reservation token: %w", err)
+		}
+		reservation := model.NicknameReservation{
+			Nickname:  nickname,
+			TokenHash: hashToken(token),
+			ExpiresAt: now.Add(nicknameReservationTimeout),
+		}
+		err = s.nicknameRepository.ReserveNickname(ctx, reservation)
+		if err == nil {
+			return &model.CheckNicknameAvailabilityResponse{
+				Nickname:             nickname,
+				Available:            true,
+				ReservationToken:     token,
+				ReservationExpiresAt: reservation.ExpiresAt,
+			}, nil
+		}
+		// the nickname was reserved in the meantime
+		if !errors.Is(err, model.ErrAlreadyExists) {
+			return nil, fmt.Errorf("error reserving nickname: %w", err)
+		}
+	}
+	suggestions, err := s.suggestNicknames(ctx, nickname, now)
+	if err != nil {
+		return nil, err
+	}
+	return &model.CheckNicknameAvailabilityResponse{
+		Nickname:    nickname,
+		Rejection:   model.NicknameRejectionTaken,
+		Suggestions: suggestions,
+	}, nil
+}
+
+// suggestNicknames returns available nicknames made of the given one and a random number.
+func (s *UserService) suggestNicknames(ctx context.Context, nickname string, now time.Time) ([]string, error) {
+	var suggestions []string
+	for attempt := 0; attempt < 4*nicknameSuggestions && len(suggestions) < nicknameSuggestions; attempt++ {
+		// the suggestions get longer as the short ones are taken
+		suggestion := nickname + strconv.Itoa(rand.Intn(100*(attempt+1)))
+		if s.nicknameRejection(ctx, suggestion) != "" {
+			continue
+		}
+		taken, err := s.nicknameTaken(ctx, uuid.Nil, suggestion, "", now)
+		if err != nil {
+			return nil, err
+		}
+		if !taken && !containsNicknameKey(suggestions, suggestion) {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+	return suggestions, nil
+}
+
+// validateNickname returns the normalized nickname, see model.NormalizeNickname, or a model.NicknameError if it is
+// rejected, see nicknameRejection.
+func (s *UserService) validateNickname(ctx context.Context, nickname string) (string, error) {
+	nickname = model.NormalizeNickname(nickname)
+	if rejection := s.nicknameRejection(ctx, nickname); rejection != "" {
+		return "", &model.NicknameError{Nickname: nickname, Rejection: rejection}
+	}
+	return nickname, nil
+}
+
+// nicknameRejection returns the reason the normalized nickname is rejected for, if any: non-printable characters, except
+// the invisible ones the keys ignore, or stacked combining marks, a length out of bounds, a profane word or, except for
+// the admins, a reserved word. The words are compared by model.NicknameKey, so that their look-alikes are rejected too.
+func (s *UserService) nicknameRejection(ctx context.Context, nickname string) model.NicknameRejection {
+	for _, r := range nickname {
+		if !unicode.IsPrint(r) && !model.IsNicknameIgnorable(r) {
+			return model.NicknameRejectionInvalidCharacters
+		}
+	}
+	length, marks := model.NicknameLength(nickname)
+	switch {
+	case marks > maxNicknameMarks:
+		return model.NicknameRejectionInvalidCharacters
+	case length < s.nicknameMinLength:
+		return model.NicknameRejectionTooShort
+	case length > s.nicknameMaxLength:
+		return model.NicknameRejectionTooLong
+	}
+	key := model.NicknameKey(nickname)
+	if key == "" {
+		// the nickname is made of separators only
+		return model.NicknameRejectionInvalidCharacters
+	}
+	for _, word := range s.profaneWords {
+		if strings.Contains(key, word) {
+			return model.NicknameRejectionProfane
+		}
+	}
+	if actor, _ := model.ActorFromContext(ctx); !actor.HasRole(model.RoleAdmin) {
+		for _, word := range s.reservedNicknames {
+			if key == word {
+				return model.NicknameRejectionReserved
+			}
+		}
+	}
+	return ""
+}
+
+// saveUser saves the new user, claiming the reservation of their nickname with the token if one is given.
+func (s *UserService) saveUser(ctx context.Context, user *model.User, reservationToken string) error {
+	now := time.Now().UTC()
+	if reservationToken == "" {
+		if err := s.checkNicknameTaken(ctx, user.ID, user.Nickname, "", now); err != nil {
+			return err
+		}
+		if err := s.repository.SaveUser(ctx, user); err != nil {
+			return fmt.Errorf("error saving user in repository: %w", err)
+		}
+		return nil
+	}
+	if s.nicknameRepository == nil {
+		return fmt.Errorf("%w: nickname reservations are not configured", model.ErrFailedPrecondition)
+	}
+	tokenHash := hashToken(reservationToken)
+	if err := s.checkNicknameTaken(ctx, user.ID, user.Nickname, tokenHash, now); err != nil {
+		return err
+	}
+	if err := s.nicknameRepository.SaveUserWithReservation(ctx, user, tokenHash); err != nil {
+		return fmt.Errorf("error saving user in repository: %w", err)
+	}
+	return nil
+}
+
+// checkNicknameTaken returns a model.NicknameError if the nickname is taken for the user, see nicknameTaken.
+func (s *UserService) checkNicknameTaken(ctx context.Context, userID uuid.UUID, nickname, tokenHash string, now time.Time) error {
+	taken, err := s.nicknameTaken(ctx, userID, nickname, tokenHash, now)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return &model.NicknameError{Nickname: nickname, Rejection: model.NicknameRejectionTaken}
+	}
+	return nil
+}
+
+// nicknameTaken reports whether the nickname is held by another user, was released by another user during the
+// reservation period or is reserved by a token other than the one with the given hash.
+func (s *UserService) nicknameTaken(ctx context.Context, userID uuid.UUID, nickname, tokenHash string, now time.Time) (bool, error) {
+	if s.nicknameRepository == nil {
+		return false, nil
+	}
+	taken, err := s.nicknameRepository.NicknameTaken(ctx, ports.NicknameTakenQuery{
+		Key:           model.NicknameKey(nickname),
+		UserID:        userID,
+		ReleasedSince: now.Add(-s.nicknameReservation),
+		TokenHash:     tokenHash,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error checking nickname: %w", err)
+	}
+	return taken, nil
+}
+
+func containsNicknameKey(nicknames []string, nickname string) bool {
+	for _, n := range nicknames {
+		if model.NicknameKey(n) == model.NicknameKey(nickname) {
+			return true
+		}
+	}
+	return false
+}
+
+// nicknameKeys returns the keys of the words, see model.NicknameKey, skipping the empty ones.
+func nicknameKeys(words []string) []string {
+	var keys []string
+	for _, word := range words {
+		if key := model.NicknameKey(word); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
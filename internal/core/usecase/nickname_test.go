@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockNicknameRepository is a MockRepository recording the nickname changes and reservations of its users.
+type MockNicknameRepository struct {
+	*MockRepository
+	changes      []model.NicknameChange
+	reservations map[string]model.NicknameReservation
+}
+
+func (m *MockNicknameRepository) UpdateUser(ctx context.Context, user *model.User, query ports.UpdateUserQuery) error {
+	before, ok := m.users[user.ID]
+	if ok && user.Nickname != "" && user.Nickname != before.Nickname {
+		for _, change := range m.changes {
+			if change.UserID == user.ID && change.ChangedAt.Add(query.NicknameChangeCooldown).After(time.Now()) {
+				return model.ErrFailedPrecondition
+			}
+		}
+	}
+	if err := m.MockRepository.UpdateUser(ctx, user, query); err != nil {
+		return err
+	}
+	if after := m.users[user.ID]; ok && after.Nickname != before.Nickname {
+		m.changes = append(m.changes, model.NicknameChange{
+			ID:          uuid.New(),
+			UserID:      user.ID,
+			OldNickname: before.Nickname,
+			NewNickname: after.Nickname,
+			ChangedAt:   time.Now().UTC(),
+		})
+	}
+	return nil
+}
+
+func (m *MockNicknameRepository) ListNicknameChanges(ctx context.Context, query ports.ListNicknameChangesQuery) ([]model.NicknameChange, error) {
+	var changes []model.NicknameChange
+	for i := len(m.changes) - 1; i >= 0; i-- {
+		if m.changes[i].UserID == query.UserID {
+			changes = append(changes, m.changes[i])
+		}
+	}
+	if query.Limit != 0 && int(query.Limit) < len(changes) {
+		changes = changes[:query.Limit]
+	}
+	return changes, nil
+}
+
+func (m *MockNicknameRepository) NicknameTaken(ctx context.Context, query ports.NicknameTakenQuery) (bool, error) {
+	for id, user := range m.users {
+		if id != query.UserID && model.NicknameKey(user.Nickname) == query.Key {
+			return true, nil
+		}
+	}
+	for _, change := range m.changes {
+		if change.UserID != query.UserID && model.NicknameKey(change.OldNickname) == query.Key && !change.ChangedAt.Before(query.ReleasedSince) {
+			return true, nil
+		}
+	}
+	reservation, ok := m.reservations[query.Key]
+	return ok && reservation.TokenHash != query.TokenHash && reservation.ExpiresAt.After(time.Now()), nil
+}
+
+func (m *MockNicknameRepository) ReserveNickname(ctx context.Context, reservation model.NicknameReservation) error {
+	key := model.NicknameKey(reservation.Nickname)
+	if existing, ok := m.reservations[key]; ok && existing.ExpiresAt.After(time.Now()) {
+		return model.ErrAlreadyExists
+	}
+	if m.reservations == nil {
+		m.reservations = map[string]model.NicknameReservation{}
+	}
+	m.reservations[key] = reservation
+	return nil
+}
+
+func (m *MockNicknameRepository) SaveUserWithReservation(ctx context.Context, user *model.User, tokenHash string) error {
+	key := model.NicknameKey(user.Nickname)
+	if reservation, ok := m.reservations[key]; !ok || reservation.TokenHash != tokenHash || !reservation.ExpiresAt.After(time.Now()) {
+		return model.ErrFailedPrecondition
+	}
+	delete(m.reservations, key)
+	return m.SaveUser(ctx, user)
+}
+
+func TestUserService_NicknameChanges(t *testing.T) {
+	jane, john := uuid.New(), uuid.New()
+	repository := &MockNicknameRepository{MockRepository: &MockRepository{users: map[uuid.UUID]model.User{
+		jane: {ID: jane, Nickname: "jane"},
+		john: {ID: john, Nickname: "john"},
+	}}}
+	svc := NewUserService(UserServiceArgs{
+		Repository:             repository,
+		AuditRepository:        &MockAuditRepository{},
+		LockoutRepository:      newMockLockoutRepository(),
+		NicknameRepository:     repository,
+		NicknameChangeCooldown: time.Hour,
+		NicknameReservation:    24 * time.Hour,
+	})
+	ctx := model.ContextWithActor(context.Background(), model.Actor{ID: jane.String()})
+
+	_, err := svc.UpdateUser(ctx, model.UpdateUserArgs{ID: jane, Nickname: "ace"})
+	require.NoError(t, err)
+	require.Len(t, repository.changes, 1)
+
+	// the cooldown applies to the nickname changes only
+	_, err = svc.UpdateUser(ctx, model.UpdateUserArgs{ID: jane, Nickname: "jane"})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+	_, err = svc.UpdateUser(ctx, model.UpdateUserArgs{ID: jane, Nickname: "ace", Country: "PT"})
+	require.NoError(t, err)
+
+	// the released nickname is reserved to its previous holder, whatever its case
+	_, err = svc.UpdateUser(ctx, model.UpdateUserArgs{ID: john, Nickname: "JANE"})
+	require.ErrorIs(t, err, model.ErrAlreadyExists)
+	_, err = svc.CreateUser(context.Background(), model.CreateUserArgs{Nickname: "jane", Email: "e@mail.com", Password: "password"})
+	require.ErrorIs(t, err, model.ErrAlreadyExists)
+	_, err = svc.CreateUser(context.Background(), model.CreateUserArgs{Nickname: "joe", Email: "e@mail.com", Password: "password"})
+	require.NoError(t, err)
+
+	// the admins rename the users whatever the cooldown, the previous holder takes their nickname back
+	admin := model.ContextWithActor(context.Background(), model.Actor{ID: uuid.NewString(), Roles: []model.Role{model.RoleAdmin}})
+	_, err = svc.UpdateUser(admin, model.UpdateUserArgs{ID: jane, Nickname: "jane"})
+	require.NoError(t, err)
+
+	// the reservations expire
+	for i := range repository.changes {
+		repository.changes[i].ChangedAt = repository.changes[i].ChangedAt.Add(-48 * time.Hour)
+	}
+	_, err = svc.UpdateUser(ctx, model.UpdateUserArgs{ID: john, Nickname: "ace"})
+	require.NoError(t, err)
+
+	history, err := svc.GetNicknameHistory(ctx, model.GetNicknameHistoryArgs{UserID: jane})
+	require.NoError(t, err)
+	require.Len(t, history.Changes, 2)
+	assert.Equal(t, "ace", history.Changes[0].OldNickname)
+	assert.Equal(t, "jane", history.Changes[0].NewNickname)
+	assert.Equal(t, "jane", history.Changes[1].OldNickname)
+	_, err = svc.GetNicknameHistory(ctx, model.GetNicknameHistoryArgs{UserID: uuid.New()})
+	require.ErrorIs(t, err, model.ErrNotFound)
+}
+
+func TestUserService_CheckNicknameAvailability(t *testing.T) {
+	jane := uuid.New()
+	repository := &MockNicknameRepository{MockRepository: &MockRepository{users: map[uuid.UUID]model.User{
+		jane: {ID: jane, Nickname: "Jane_Doe"},
+	}}}
+	svc := NewUserService(UserServiceArgs{
+		Repository:         repository,
+		AuditRepository:    &MockAuditRepository{},
+		LockoutRepository:  newMockLockoutRepository(),
+		NicknameRepository: repository,
+	})
+	ctx := context.Background()
+
+	availability, err := svc.CheckNicknameAvailability(ctx, model.CheckNicknameAvailabilityArgs{Nickname: "_."})
+	require.NoError(t, err)
+	assert.False(t, availability.Available)
+	assert.Equal(t, model.NicknameRejectionInvalidCharacters, availability.Rejection)
+
+	// the nicknames are compared case and confusable insensitively, the taken ones come with suggestions
+	availability, err = svc.CheckNicknameAvailability(ctx, model.CheckNicknameAvailabilityArgs{Nickname: "JANED0E", Reserve: true})
+	require.NoError(t, err)
+	assert.False(t, availability.Available)
+	assert.Equal(t, model.NicknameRejectionTaken, availability.Rejection)
+	assert.Empty(t, availability.ReservationToken)
+	require.Len(t, availability.Suggestions, nicknameSuggestions)
+	for _, suggestion := range availability.Suggestions {
+		assert.True(t, strings.HasPrefix(suggestion, "JANED0E"))
+	}
+
+	// the available nicknames are reserved on demand
+	availability, err = svc.CheckNicknameAvailability(ctx, model.CheckNicknameAvailabilityArgs{Nickname: "ace"})
+	require.NoError(t, err)
+	assert.True(t, availability.Available)
+	assert.Empty(t, availability.ReservationToken)
+	availability, err = svc.CheckNicknameAvailability(ctx, model.CheckNicknameAvailabilityArgs{Nickname: "ace", Reserve: true})
+	require.NoError(t, err)
+	assert.True(t, availability.Available)
+	require.NotEmpty(t, availability.ReservationToken)
+	assert.WithinDuration(t, time.Now().Add(nicknameReservationTimeout), availability.ReservationExpiresAt, time.Minute)
+	token := availability.ReservationToken
+	availability, err = svc.CheckNicknameAvailability(ctx, model.CheckNicknameAvailabilityArgs{Nickname: "4CE", Reserve: true})
+	require.NoError(t, err)
+	assert.False(t, availability.Available)
+
+	// the reserved nickname is taken with its token only
+	_, err = svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "ace", Email: "e@mail.com", Password: "password"})
+	require.ErrorIs(t, err, model.ErrAlreadyExists)
+	_, err = svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "ace", Email: "e@mail.com", Password: "password", NicknameReservationToken: "invalid"})
+	require.ErrorIs(t, err, model.ErrAlreadyExists)
+	created, err := svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "ace", Email: "e@mail.com", Password: "password", NicknameReservationToken: token})
+	require.NoError(t, err)
+	assert.Equal(t, "ace", created.User.Nickname)
+	assert.Empty(t, repository.reservations)
+	_, err = svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "ace", Email: "f@mail.com", Password: "password", NicknameReservationToken: token})
+	require.ErrorIs(t, err, model.ErrAlreadyExists)
+
+	// the token claims the nickname it reserved
+	availability, err = svc.CheckNicknameAvailability(ctx, model.CheckNicknameAvailabilityArgs{Nickname: "bob", Reserve: true})
+	require.NoError(t, err)
+	_, err = svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "alice", Email: "f@mail.com", Password: "password", NicknameReservationToken: availability.ReservationToken})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+}
+
+func TestUserService_NicknameValidation(t *testing.T) {
+	jane := uuid.New()
+	repository := &MockNicknameRepository{MockRepository: &MockRepository{users: map[uuid.UUID]model.User{
+		jane: {ID: jane, Nickname: "jane"},
+	}}}
+	svc := NewUserService(UserServiceArgs{
+		Repository:         repository,
+		AuditRepository:    &MockAuditRepository{},
+		LockoutRepository:  newMockLockoutRepository(),
+		NicknameRepository: repository,
+		NicknameMaxLength:  8,
+		ProfaneWords:       []string{"darn", ""},
+	})
+	ctx := context.Background()
+	admin := model.ContextWithActor(ctx, model.Actor{ID: uuid.NewString(), Roles: []model.Role{model.RoleAdmin}})
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		nickname  string
+		rejection model.NicknameRejection
+	}{
+		{name: "too short", ctx: ctx, nickname: "a\u200b", rejection: model.NicknameRejectionTooShort},
+		{name: "too long", ctx: ctx, nickname: "abcdefghi", rejection: model.NicknameRejectionTooLong},
+		{name: "graphemes", ctx: ctx, nickname: "e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301e\u0301"},
+		{name: "flags", ctx: ctx, nickname: "gg\U0001F1F5\U0001F1F9\U0001F1EB\U0001F1F7"},
+		{name: "emoji sequences", ctx: ctx, nickname: "gg\U0001F468\u200d\U0001F469\u200d\U0001F467\U0001F44D\U0001F3FD"},
+		{name: "stacked marks", ctx: ctx, nickname: "ze\u0301\u0302\u0303\u0304d", rejection: model.NicknameRejectionInvalidCharacters},
+		{name: "control characters", ctx: ctx, nickname: "ze\u0007d", rejection: model.NicknameRejectionInvalidCharacters},
+		{name: "separators only", ctx: ctx, nickname: "-_-", rejection: model.NicknameRejectionInvalidCharacters},
+		{name: "profane", ctx: ctx, nickname: "xXd4rnXx", rejection: model.NicknameRejectionProfane},
+		{name: "profane for admins", ctx: admin, nickname: "DARN", rejection: model.NicknameRejectionProfane},
+		{name: "reserved", ctx: ctx, nickname: "4dm1n", rejection: model.NicknameRejectionReserved},
+		{name: "reserved for admins", ctx: admin, nickname: "admin"},
+		{name: "homoglyphs", ctx: ctx, nickname: "јаnе", rejection: model.NicknameRejectionTaken},
+		{name: "zero-width characters", ctx: ctx, nickname: "ja\u200dne", rejection: model.NicknameRejectionTaken},
+		{name: "full-width characters", ctx: ctx, nickname: "ＪＡＮＥ", rejection: model.NicknameRejectionTaken},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			availability, err := svc.CheckNicknameAvailability(test.ctx, model.CheckNicknameAvailabilityArgs{Nickname: test.nickname})
+			require.NoError(t, err)
+			assert.Equal(t, test.rejection, availability.Rejection)
+			assert.Equal(t, test.rejection == "", availability.Available)
+
+			_, err = svc.CreateUser(test.ctx, model.CreateUserArgs{Nickname: test.nickname, Email: uuid.NewString() + "@mail.com", Password: "password"})
+			if test.rejection == "" {
+				require.NoError(t, err)
+				return
+			}
+			var nicknameErr *model.NicknameError
+			require.ErrorAs(t, err, &nicknameErr)
+			assert.Equal(t, test.rejection, nicknameErr.Rejection)
+			if test.rejection == model.NicknameRejectionTaken {
+				assert.ErrorIs(t, err, model.ErrAlreadyExists)
+			} else {
+				assert.ErrorIs(t, err, model.ErrInvalidArgument)
+			}
+		})
+	}
+
+	// the nicknames are stored normalized, with their invisible characters that only the keys ignore
+	created, err := svc.CreateUser(ctx, model.CreateUserArgs{Nickname: " Ｊｏｅ\u200b  Doe ", Email: uuid.NewString() + "@mail.com", Password: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "Joe\u200b Doe", created.User.Nickname)
+	_, err = svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "JoeDoe", Email: uuid.NewString() + "@mail.com", Password: "password"})
+	require.ErrorIs(t, err, model.ErrAlreadyExists)
+	_, err = svc.UpdateUser(model.ContextWithActor(ctx, model.Actor{ID: jane.String()}), model.UpdateUserArgs{ID: jane, Nickname: "jоеdое"})
+	require.ErrorIs(t, err, model.ErrAlreadyExists)
+}
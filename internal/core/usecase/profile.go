@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// GetPublicProfile returns the public profile of a user, looked up by ID or by nickname. The profiles hidden from the
+// actor by the privacy setting of the user are restricted to the nickname, the users themselves and the moderators
+// always see the whole profile. It returns an error wrapping model.ErrInvalidArgument unless exactly one of the ID and
+// the nickname is given and model.ErrNotFound if the user does not exist, is deleted or either user blocked the other.
+func (s *UserService) GetPublicProfile(ctx context.Context, args model.GetPublicProfileArgs) (*model.GetPublicProfileResponse, error) {
+	if (args.UserID == uuid.Nil) == (args.Nickname == "") {
+		return nil, fmt.Errorf("%w: either the user id or the nickname must be given", model.ErrInvalidArgument)
+	}
+	query := ports.GetUserQuery{ID: args.UserID}
+	if args.Nickname != "" {
+		query = ports.GetUserQuery{Nickname: args.Nickname}
+	}
+	user, err := s.repository.GetUser(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user from repository: %w", err)
+	}
+
+	visible, err := s.profileVisible(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	profile := model.PublicProfile{
+		UserID:     user.ID,
+		Nickname:   user.Nickname,
+		Visibility: user.ProfileVisibility.OrDefault(),
+	}
+	if visible {
+		profile.Country = user.Country
+		profile.JoinedAt = user.CreatedAt
+		profile.Status = user.Status
+	} else {
+		profile.Restricted = true
+	}
+	return &model.GetPublicProfileResponse{Profile: profile}, nil
+}
+
+// profileVisible reports whether the privacy setting of the user shows their profile to the actor carried by ctx. It
+// returns model.ErrNotFound if either user blocked the other, blocked users do not learn about the block.
+func (s *UserService) profileVisible(ctx context.Context, user *model.User) (bool, error) {
+	actor, _ := model.ActorFromContext(ctx)
+	if actor.IsUser(user.ID) || actor.HasRole(model.RoleSupport) || actor.HasRole(model.RoleAdmin) {
+		return true, nil
+	}
+	// the actors that are not users, e.g. the services using api keys, see the public profiles only
+	viewerID, err := uuid.Parse(actor.ID)
+	if err != nil {
+		return user.ProfileVisibility.OrDefault() == model.ProfileVisibilityPublic, nil
+	}
+	blocked, err := s.blockRepository.IsBlocked(ctx, viewerID, user.ID)
+	if err != nil {
+		return false, fmt.Errorf("error checking blocks: %w", err)
+	}
+	if blocked {
+		return false, model.ErrNotFound
+	}
+
+	switch user.ProfileVisibility.OrDefault() {
+	case model.ProfileVisibilityPublic:
+		return true, nil
+	case model.ProfileVisibilityFriends:
+		friendship, err := s.friendshipRepository.GetFriendship(ctx, viewerID, user.ID)
+		if errors.Is(err, model.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, fmt.Errorf("error getting friendship: %w", err)
+		}
+		return friendship.Status == model.FriendshipStatusAccepted, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_PublicProfiles(t *testing.T) {
+	jane, john, jim, gone := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	joinedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		jane: {ID: jane, FirstName: "Jane", LastName: "Doe", Nickname: "Jane", Email: "jane@x.com", Country: "BR",
+			CreatedAt: joinedAt, Status: model.UserStatusActive},
+		john: {ID: john, Nickname: "john", Email: "john@x.com", Status: model.UserStatusActive},
+		jim:  {ID: jim, Nickname: "jim", Email: "jim@x.com", Status: model.UserStatusActive},
+		gone: {ID: gone, Nickname: "gone", DeletedAt: joinedAt, Status: model.UserStatusDeleted},
+	}}
+	friendships := &MockFriendshipRepository{}
+	blocks := &MockBlockRepository{friendships: friendships}
+	svc := NewUserService(UserServiceArgs{
+		Repository:           repository,
+		AuditRepository:      &MockAuditRepository{},
+		LockoutRepository:    newMockLockoutRepository(),
+		FriendshipRepository: friendships,
+		BlockRepository:      blocks,
+	})
+	ctx := context.Background()
+	asJane := model.ContextWithActor(ctx, model.Actor{ID: jane.String()})
+	asJohn := model.ContextWithActor(ctx, model.Actor{ID: john.String()})
+	asJim := model.ContextWithActor(ctx, model.Actor{ID: jim.String()})
+	asSupport := model.ContextWithActor(ctx, model.Actor{ID: "anti-cheat", Roles: []model.Role{model.RoleSupport}})
+
+	_, err := svc.GetPublicProfile(asJohn, model.GetPublicProfileArgs{})
+	require.ErrorIs(t, err, model.ErrInvalidArgument)
+	_, err = svc.GetPublicProfile(asJohn, model.GetPublicProfileArgs{UserID: jane, Nickname: "jane"})
+	require.ErrorIs(t, err, model.ErrInvalidArgument)
+
+	// the profiles carry no personal data and are found by nickname ignoring the case and the confusables
+	resp, err := svc.GetPublicProfile(asJohn, model.GetPublicProfileArgs{Nickname: "J\u0410NE"})
+	require.NoError(t, err)
+	assert.Equal(t, model.PublicProfile{
+		UserID:     jane,
+		Nickname:   "Jane",
+		Visibility: model.ProfileVisibilityPublic,
+		Country:    "BR",
+		JoinedAt:   joinedAt,
+		Status:     model.UserStatusActive,
+	}, resp.Profile)
+
+	_, err = svc.GetPublicProfile(asJohn, model.GetPublicProfileArgs{UserID: gone})
+	require.ErrorIs(t, err, model.ErrNotFound)
+	_, err = svc.GetPublicProfile(asJohn, model.GetPublicProfileArgs{Nickname: "nobody"})
+	require.ErrorIs(t, err, model.ErrNotFound)
+
+	// the friends only profiles are restricted to the nickname for the other users
+	_, err = svc.UpdateUser(asJane, model.UpdateUserArgs{ID: jane, ProfileVisibility: "hidden"})
+	require.ErrorIs(t, err, model.ErrInvalidArgument)
+	_, err = svc.UpdateUser(asJane, model.UpdateUserArgs{ID: jane, ProfileVisibility: model.ProfileVisibilityFriends})
+	require.NoError(t, err)
+	_, err = svc.RequestFriendship(asJohn, model.RequestFriendshipArgs{UserID: john, FriendID: jane})
+	require.NoError(t, err)
+	resp, err = svc.GetPublicProfile(asJohn, model.GetPublicProfileArgs{UserID: jane})
+	require.NoError(t, err)
+	assert.Equal(t, model.PublicProfile{
+		UserID:     jane,
+		Nickname:   "Jane",
+		Visibility: model.ProfileVisibilityFriends,
+		Restricted: true,
+	}, resp.Profile)
+
+	require.NoError(t, svc.AcceptFriendship(asJane, model.AnswerFriendshipArgs{UserID: jane, FriendID: john}))
+	for name, viewer := range map[string]context.Context{"friend": asJohn, "self": asJane, "support": asSupport} {
+		resp, err = svc.GetPublicProfile(viewer, model.GetPublicProfileArgs{UserID: jane})
+		require.NoError(t, err, name)
+		assert.False(t, resp.Profile.Restricted, name)
+		assert.Equal(t, "BR", resp.Profile.Country, name)
+	}
+	resp, err = svc.GetPublicProfile(asJim, model.GetPublicProfileArgs{UserID: jane})
+	require.NoError(t, err)
+	assert.True(t, resp.Profile.Restricted)
+
+	// the private profiles are restricted for the friends too
+	_, err = svc.UpdateUser(asJane, model.UpdateUserArgs{ID: jane, ProfileVisibility: model.ProfileVisibilityPrivate})
+	require.NoError(t, err)
+	resp, err = svc.GetPublicProfile(asJohn, model.GetPublicProfileArgs{UserID: jane})
+	require.NoError(t, err)
+	assert.True(t, resp.Profile.Restricted)
+	resp, err = svc.GetPublicProfile(asSupport, model.GetPublicProfileArgs{UserID: jane})
+	require.NoError(t, err)
+	assert.False(t, resp.Profile.Restricted)
+
+	// users who blocked each other do not see their profiles, whichever blocked the other
+	_, err = svc.BlockUser(asJim, model.BlockUserArgs{UserID: jim, BlockedID: john})
+	require.NoError(t, err)
+	_, err = svc.GetPublicProfile(asJohn, model.GetPublicProfileArgs{UserID: jim})
+	require.ErrorIs(t, err, model.ErrNotFound)
+	_, err = svc.GetPublicProfile(asJim, model.GetPublicProfileArgs{Nickname: "john"})
+	require.ErrorIs(t, err, model.ErrNotFound)
+	_, err = svc.GetPublicProfile(asJane, model.GetPublicProfileArgs{UserID: jim})
+	require.NoError(t, err)
+}
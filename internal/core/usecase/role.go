@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// GrantRole grants a role to a user. The role is embedded in the access tokens issued from then on, at the latest on
+// the next refresh of the sessions of the user. It returns model.ErrNotFound if the user does not exist and an error
+// wrapping model.ErrFailedPrecondition if the role is granted already.
+func (s *UserService) GrantRole(ctx context.Context, args model.GrantRoleArgs) error {
+	actor, _ := model.ActorFromContext(ctx)
+	if err := s.roleRepository.GrantRole(ctx, ports.GrantRoleQuery{
+		UserID:    args.UserID,
+		Role:      args.Role,
+		GrantedBy: actor.ID,
+		GrantedAt: time.Now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("error granting role: %w", err)
+	}
+	return s.audit(ctx, args.UserID, model.AuditActionGrantRole, "roles")
+}
+
+// RevokeRole revokes a role of a user. The access tokens already issued keep the role until they expire. It returns
+// model.ErrNotFound if the user does not exist and an error wrapping model.ErrFailedPrecondition if the role is not
+// granted.
+func (s *UserService) RevokeRole(ctx context.Context, args model.RevokeRoleArgs) error {
+	if err := s.roleRepository.RevokeRole(ctx, args.UserID, args.Role, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error revoking role: %w", err)
+	}
+	return s.audit(ctx, args.UserID, model.AuditActionRevokeRole, "roles")
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRoleRepository is an in-memory implementation of the RoleRepository interface reflecting the roles in the users
+// of a MockRepository.
+type MockRoleRepository struct {
+	*MockRepository
+}
+
+func (m *MockRoleRepository) GrantRole(ctx context.Context, query ports.GrantRoleQuery) error {
+	user, ok := m.users[query.UserID]
+	if !ok || !user.DeletedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	for _, role := range user.Roles {
+		if role == query.Role {
+			return fmt.Errorf("%w: role already granted", model.ErrFailedPrecondition)
+		}
+	}
+	user.Roles = append(user.Roles, query.Role)
+	sort.Slice(user.Roles, func(i, j int) bool { return user.Roles[i] < user.Roles[j] })
+	m.users[query.UserID] = user
+	return nil
+}
+
+func (m *MockRoleRepository) RevokeRole(ctx context.Context, userID uuid.UUID, role model.Role, revokedAt time.Time) error {
+	user, ok := m.users[userID]
+	if !ok || !user.DeletedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	for i, granted := range user.Roles {
+		if granted == role {
+			user.Roles = append(user.Roles[:i:i], user.Roles[i+1:]...)
+			m.users[userID] = user
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: role not granted", model.ErrFailedPrecondition)
+}
+
+func TestUserService_GrantAndRevokeRoles(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		userID: {ID: userID, Email: "jane@example.com", PasswordHash: mustHash(t, "password")},
+	}}
+	auditRepository := &MockAuditRepository{}
+	tokenIssuer := &MockTokenIssuer{}
+	svc := NewUserService(UserServiceArgs{
+		Repository:        repository,
+		AuditRepository:   auditRepository,
+		LockoutRepository: newMockLockoutRepository(),
+		SessionRepository: newMockSessionRepository(),
+		TokenIssuer:       tokenIssuer,
+		RoleRepository:    &MockRoleRepository{MockRepository: repository},
+	})
+	ctx := model.ContextWithActor(context.Background(), model.Actor{ID: "admin", Roles: []model.Role{model.RoleAdmin}})
+
+	require.NoError(t, svc.GrantRole(ctx, model.GrantRoleArgs{UserID: userID, Role: model.RoleSupport}))
+	assert.Equal(t, model.AuditActionGrantRole, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	require.ErrorIs(t, svc.GrantRole(ctx, model.GrantRoleArgs{UserID: userID, Role: model.RoleSupport}), model.ErrFailedPrecondition)
+	require.ErrorIs(t, svc.GrantRole(ctx, model.GrantRoleArgs{UserID: uuid.New(), Role: model.RoleSupport}), model.ErrNotFound)
+
+	// the roles are embedded in the access tokens
+	tokens := login(t, svc, context.Background(), model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	assert.Equal(t, []model.Role{model.RoleSupport}, tokenIssuer.actor.Roles)
+
+	require.NoError(t, svc.RevokeRole(ctx, model.RevokeRoleArgs{UserID: userID, Role: model.RoleSupport}))
+	assert.Equal(t, model.AuditActionRevokeRole, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	require.ErrorIs(t, svc.RevokeRole(ctx, model.RevokeRoleArgs{UserID: userID, Role: model.RoleSupport}), model.ErrFailedPrecondition)
+
+	// the refreshed tokens hold the current roles
+	_, err := svc.RefreshSession(context.Background(), model.RefreshSessionArgs{RefreshToken: tokens.RefreshToken})
+	require.NoError(t, err)
+	assert.Empty(t, tokenIssuer.actor.Roles)
+}
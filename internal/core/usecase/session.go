@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+const (
+	// sessionIdleTimeout is how long a session stays alive without being refreshed.
+	sessionIdleTimeout = 30 * 24 * time.Hour
+
+	// loginChallengeTimeout is how long a user has to complete a login with their second factor.
+	loginChallengeTimeout = 5 * time.Minute
+)
+
+// Login checks the credentials of the user and opens a new session. Credential checks are protected against
+// brute-force as in ChangePassword. It returns model.ErrInvalidCredentials if the email is unknown, if the password
+// does not match or while the account or the source IP is locked, and model.ErrSuspended while the user is suspended.
+// Users with a second factor get a challenge instead of the session tokens, to pass to CompleteLogin along with a code.
+func (s *UserService) Login(ctx context.Context, args model.LoginArgs) (*model.LoginResponse, error) {
+	user, err := s.repository.GetUser(ctx, ports.GetUserQuery{Email: args.Email})
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, fmt.Errorf("error getting user from repository: %w", err)
+	}
+	if err := s.verifyPassword(ctx, args.Email, user, args.Password); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	// the suspension is only told to the users who know the password
+	if user.Suspended(now) {
+		return nil, model.ErrSuspended
+	}
+	if !user.TOTPEnabledAt.IsZero() {
+		return s.loginChallenge(ctx, user.ID, now)
+	}
+	if err := s.resetCredentialFailures(ctx, user); err != nil {
+		return nil, err
+	}
+	tokens, err := s.openSession(ctx, user, now)
+	if err != nil {
+		return nil, err
+	}
+	return &model.LoginResponse{Tokens: tokens}, nil
+}
+
+// CompleteLogin checks the second factor of a user that passed the password check of Login and opens a new session.
+// The code is either a TOTP code or an unused recovery code, its checks are protected against brute-force as the
+// password ones. It returns an error wrapping model.ErrUnauthenticated if the challenge is unknown or expired,
+// model.ErrInvalidCredentials if the code does not match or while the account or the source IP is locked and
+// model.ErrSuspended if the user was suspended meanwhile.
+func (s *UserService) CompleteLogin(ctx context.Context, args model.CompleteLoginArgs) (*model.SessionTokens, error) {
+	hash := hashToken(args.MFAChallenge)
+	challenge, err := s.sessionRepository.GetLoginChallenge(ctx, hash)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, fmt.Errorf("%w: unknown login challenge", model.ErrUnauthenticated)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting login challenge: %w", err)
+	}
+	now := time.Now().UTC()
+	if !challenge.ExpiresAt.After(now) {
+		return nil, fmt.Errorf("%w: login challenge expired", model.ErrUnauthenticated)
+	}
+	user, err := s.repository.GetUser(ctx, ports.GetUserQuery{ID: challenge.UserID})
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, fmt.Errorf("%w: user was deleted", model.ErrUnauthenticated)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting user from repository: %w", err)
+	}
+
+	if err := s.verifySecondFactor(ctx, user, args.Code); err != nil {
+		return nil, err
+	}
+	if user.Suspended(now) {
+		return nil, model.ErrSuspended
+	}
+	err = s.sessionRepository.DeleteLoginChallenge(ctx, hash)
+	if errors.Is(err, model.ErrNotFound) {
+		// the challenge was completed concurrently
+		return nil, fmt.Errorf("%w: login challenge completed already", model.ErrUnauthenticated)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error deleting login challenge: %w", err)
+	}
+	if err := s.resetCredentialFailures(ctx, user); err != nil {
+		return nil, err
+	}
+	return s.openSession(ctx, user, now)
+}
+
+// RefreshSession exchanges the refresh token for new session tokens. Every refresh token is valid once: presenting
+// one a second time means that it leaked, the whole session is then revoked. It returns an error wrapping
+// model.ErrUnauthenticated if the token is unknown or reused, if the session expired or was revoked, or if the user
+// was deleted, and model.ErrSuspended while the user is suspended.
+func (s *UserService) RefreshSession(ctx context.Context, args model.RefreshSessionArgs) (*model.SessionTokens, error) {
+	hash := hashToken(args.RefreshToken)
+	token, err := s.sessionRepository.GetRefreshToken(ctx, hash)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, fmt.Errorf("%w: unknown refresh token", model.ErrUnauthenticated)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting refresh token: %w", err)
+	}
+	session, err := s.sessionRepository.GetSession(ctx, token.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting session: %w", err)
+	}
+
+	now := time.Now().UTC()
+	if !session.Active(now) {
+		return nil, fmt.Errorf("%w: session expired or revoked", model.ErrUnauthenticated)
+	}
+	if !token.UsedAt.IsZero() {
+		return nil, s.revokeReusedSession(ctx, session)
+	}
+	user, err := s.repository.GetUser(ctx, ports.GetUserQuery{ID: session.UserID})
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, fmt.Errorf("%w: user was deleted", model.ErrUnauthenticated)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting user from repository: %w", err)
+	}
+	if user.Suspended(now) {
+		return nil, model.ErrSuspended
+	}
+
+	refreshToken, next, err := newRefreshToken(session.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	session.RefreshedAt, session.ExpiresAt = now, now.Add(sessionIdleTimeout)
+	err = s.sessionRepository.RotateRefreshToken(ctx, hash, next, session.ExpiresAt)
+	if errors.Is(err, model.ErrNotFound) {
+		// the token was used concurrently
+		return nil, s.revokeReusedSession(ctx, session)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error rotating refresh token: %w", err)
+	}
+	return s.sessionTokens(ctx, session, user.Actor(), refreshToken)
+}
+
+// ListSessions lists the active sessions of a user.
+func (s *UserService) ListSessions(ctx context.Context, args model.ListSessionsArgs) (*model.ListSessionsResponse, error) {
+	sessions, err := s.sessionRepository.ListSessions(ctx, args.UserID, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("error listing sessions: %w", err)
+	}
+	return &model.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+// RevokeSession revokes a session of a user, its refresh tokens are rejected from then on. The access tokens already
+// issued stay valid until they expire. It returns model.ErrNotFound if the user has no such active session.
+func (s *UserService) RevokeSession(ctx context.Context, args model.RevokeSessionArgs) error {
+	if err := s.sessionRepository.RevokeSession(ctx, args.UserID, args.SessionID); err != nil {
+		return fmt.Errorf("error revoking session: %w", err)
+	}
+	return s.audit(ctx, args.UserID, model.AuditActionRevokeSession)
+}
+
+// openSession opens a new session of the user and issues its tokens.
+func (s *UserService) openSession(ctx context.Context, user *model.User, now time.Time) (*model.SessionTokens, error) {
+	metadata := model.RequestMetadataFromContext(ctx)
+	session := &model.Session{
+		ID:          uuid.New(),
+		UserID:      user.ID,
+		UserAgent:   metadata.UserAgent,
+		SourceIP:    metadata.SourceIP,
+		CreatedAt:   now,
+		RefreshedAt: now,
+		ExpiresAt:   now.Add(sessionIdleTimeout),
+	}
+	refreshToken, token, err := newRefreshToken(session.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sessionRepository.SaveSession(ctx, session, token); err != nil {
+		return nil, fmt.Errorf("error saving session: %w", err)
+	}
+	return s.sessionTokens(ctx, session, user.Actor(), refreshToken)
+}
+
+// loginChallenge saves the pending second step of the login of the user and hands out its challenge.
+func (s *UserService) loginChallenge(ctx context.Context, userID uuid.UUID, now time.Time) (*model.LoginResponse, error) {
+	challenge, err := newToken()
+	if err != nil {
+		return nil, fmt.Errorf("error generating login challenge: %w", err)
+	}
+	expiresAt := now.Add(loginChallengeTimeout)
+	if err := s.sessionRepository.SaveLoginChallenge(ctx, &model.LoginChallenge{
+		Hash:      hashToken(challenge),
+		UserID:    userID,
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return nil, fmt.Errorf("error saving login challenge: %w", err)
+	}
+	return &model.LoginResponse{MFAChallenge: challenge, MFAChallengeExpiresAt: expiresAt}, nil
+}
+
+// revokeReusedSession revokes the session whose refresh token was presented twice. Either the client or an attacker
+// holds a stolen copy of the token, so none of the tokens of the session can be trusted anymore.
+func (s *UserService) revokeReusedSession(ctx context.Context, session *model.Session) error {
+	err := s.sessionRepository.RevokeSession(ctx, session.UserID, session.ID)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return fmt.Errorf("error revoking session: %w", err)
+	}
+	if err := s.audit(ctx, session.UserID, model.AuditActionRevokeSession); err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: refresh token reused, session revoked", model.ErrUnauthenticated)
+}
+
+// sessionTokens issues the access token of the session, embedding the current roles of the user, and hands it out along
+// with the refresh token.
+func (s *UserService) sessionTokens(ctx context.Context, session *model.Session, actor model.Actor, refreshToken string) (*model.SessionTokens, error) {
+	accessToken, expiresAt, err := s.tokenIssuer.IssueAccessToken(ctx, actor, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error issuing access token: %w", err)
+	}
+	return &model.SessionTokens{
+		SessionID:             session.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  expiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
+// newRefreshToken generates a refresh token of the session. It returns the token to hand out and its stored form.
+func newRefreshToken(sessionID uuid.UUID, now time.Time) (string, *model.RefreshToken, error) {
+	refreshToken, err := newToken()
+	if err != nil {
+		return "", nil, fmt.Errorf("error generating refresh token: %w", err)
+	}
+	return refreshToken, &model.RefreshToken{
+		Hash:      hashToken(refreshToken),
+		SessionID: sessionID,
+		CreatedAt: now,
+	}, nil
+}
+
+// newToken generates a random opaque token.
+func newToken() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashToken returns the digest a token is stored by. The tokens are random, a plain hash suffices.
+func hashToken(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
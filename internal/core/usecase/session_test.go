@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSessionRepository is an in-memory implementation of the SessionRepository interface.
+type MockSessionRepository struct {
+	sessions   map[uuid.UUID]model.Session
+	tokens     map[string]model.RefreshToken
+	challenges map[string]model.LoginChallenge
+}
+
+func newMockSessionRepository() *MockSessionRepository {
+	return &MockSessionRepository{
+		sessions:   map[uuid.UUID]model.Session{},
+		tokens:     map[string]model.RefreshToken{},
+		challenges: map[string]model.LoginChallenge{},
+	}
+}
+
+func (m *MockSessionRepository) SaveSession(ctx context.Context, session *model.Session, token *model.RefreshToken) error {
+	m.sessions[session.ID] = *session
+	m.tokens[token.Hash] = *token
+	return nil
+}
+
+func (m *MockSessionRepository) GetSession(ctx context.Context, id uuid.UUID) (*model.Session, error) {
+	session, ok := m.sessions[id]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return &session, nil
+}
+
+func (m *MockSessionRepository) GetRefreshToken(ctx context.Context, hash string) (*model.RefreshToken, error) {
+	token, ok := m.tokens[hash]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return &token, nil
+}
+
+func (m *MockSessionRepository) RotateRefreshToken(ctx context.Context, hash string, next *model.RefreshToken, expiresAt time.Time) error {
+	token, ok := m.tokens[hash]
+	if !ok || !token.UsedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	token.UsedAt = next.CreatedAt
+	m.tokens[hash] = token
+	m.tokens[next.Hash] = *next
+	session := m.sessions[token.SessionID]
+	session.RefreshedAt, session.ExpiresAt = next.CreatedAt, expiresAt
+	m.sessions[token.SessionID] = session
+	return nil
+}
+
+func (m *MockSessionRepository) ListSessions(ctx context.Context, userID uuid.UUID, activeAt time.Time) ([]model.Session, error) {
+	var sessions []model.Session
+	for _, session := range m.sessions {
+		if session.UserID == userID && session.Active(activeAt) {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].CreatedAt.After(sessions[j].CreatedAt) })
+	return sessions, nil
+}
+
+func (m *MockSessionRepository) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	session, ok := m.sessions[sessionID]
+	if !ok || session.UserID != userID || !session.RevokedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	session.RevokedAt = time.Now()
+	m.sessions[sessionID] = session
+	return nil
+}
+
+func (m *MockSessionRepository) SaveLoginChallenge(ctx context.Context, challenge *model.LoginChallenge) error {
+	m.challenges[challenge.Hash] = *challenge
+	return nil
+}
+
+func (m *MockSessionRepository) GetLoginChallenge(ctx context.Context, hash string) (*model.LoginChallenge, error) {
+	challenge, ok := m.challenges[hash]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return &challenge, nil
+}
+
+func (m *MockSessionRepository) DeleteLoginChallenge(ctx context.Context, hash string) error {
+	if _, ok := m.challenges[hash]; !ok {
+		return model.ErrNotFound
+	}
+	delete(m.challenges, hash)
+	return nil
+}
+
+// MockTokenIssuer is a mock implementation of the TokenIssuer interface.
+type MockTokenIssuer struct {
+	// actor is the actor of the last issued token.
+	actor model.Actor
+}
+
+func (m *MockTokenIssuer) IssueAccessToken(ctx context.Context, actor model.Actor, sessionID uuid.UUID) (string, time.Time, error) {
+	m.actor = actor
+	return actor.ID + "/" + sessionID.String(), time.Now().Add(15 * time.Minute), nil
+}
+
+func newSessionTestService(t *testing.T) (*UserService, *MockRepository, *MockSessionRepository, *MockAuditRepository) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		userID: {ID: userID, Email: "jane@example.com", PasswordHash: mustHash(t, "password")},
+	}}
+	sessionRepository := newMockSessionRepository()
+	auditRepository := &MockAuditRepository{}
+	svc := NewUserService(UserServiceArgs{
+		Repository:        repository,
+		AuditRepository:   auditRepository,
+		LockoutRepository: newMockLockoutRepository(),
+		SessionRepository: sessionRepository,
+		TokenIssuer:       &MockTokenIssuer{},
+	})
+	return svc, repository, sessionRepository, auditRepository
+}
+
+// login logs the user in, expecting no second factor.
+func login(t *testing.T, svc *UserService, ctx context.Context, args model.LoginArgs) *model.SessionTokens {
+	resp, err := svc.Login(ctx, args)
+	require.NoError(t, err)
+	require.NotNil(t, resp.Tokens)
+	return resp.Tokens
+}
+
+func TestUserService_Login(t *testing.T) {
+	svc, _, sessionRepository, _ := newSessionTestService(t)
+	ctx := model.ContextWithRequestMetadata(context.Background(), model.RequestMetadata{SourceIP: "10.0.0.1", UserAgent: "faceit-client/1.0"})
+
+	tokens := login(t, svc, ctx, model.LoginArgs{Email: "Jane@Example.com", Password: "password"})
+	assert.Equal(t, "3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de/"+tokens.SessionID.String(), tokens.AccessToken)
+	assert.NotEmpty(t, tokens.RefreshToken)
+	assert.WithinDuration(t, time.Now().Add(sessionIdleTimeout), tokens.RefreshTokenExpiresAt, time.Second)
+
+	session := sessionRepository.sessions[tokens.SessionID]
+	assert.Equal(t, "faceit-client/1.0", session.UserAgent)
+	assert.Equal(t, "10.0.0.1", session.SourceIP)
+	// only the digest of the refresh token is stored
+	assert.NotContains(t, sessionRepository.tokens, tokens.RefreshToken)
+	assert.Contains(t, sessionRepository.tokens, hashToken(tokens.RefreshToken))
+
+	_, err := svc.Login(ctx, model.LoginArgs{Email: "jane@example.com", Password: "wrong"})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+	_, err = svc.Login(ctx, model.LoginArgs{Email: "unknown@example.com", Password: "password"})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+	assert.Len(t, sessionRepository.sessions, 1)
+}
+
+func TestUserService_RefreshSession(t *testing.T) {
+	svc, repository, sessionRepository, auditRepository := newSessionTestService(t)
+	ctx := context.Background()
+	tokens := login(t, svc, ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+
+	refreshed, err := svc.RefreshSession(ctx, model.RefreshSessionArgs{RefreshToken: tokens.RefreshToken})
+	require.NoError(t, err)
+	assert.Equal(t, tokens.SessionID, refreshed.SessionID)
+	assert.NotEqual(t, tokens.RefreshToken, refreshed.RefreshToken)
+
+	refreshed, err = svc.RefreshSession(ctx, model.RefreshSessionArgs{RefreshToken: refreshed.RefreshToken})
+	require.NoError(t, err)
+
+	_, err = svc.RefreshSession(ctx, model.RefreshSessionArgs{RefreshToken: "unknown"})
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+
+	// the reuse of a rotated token revokes the whole session, including its latest token
+	_, err = svc.RefreshSession(ctx, model.RefreshSessionArgs{RefreshToken: tokens.RefreshToken})
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+	assert.False(t, sessionRepository.sessions[tokens.SessionID].RevokedAt.IsZero())
+	assert.Equal(t, model.AuditActionRevokeSession, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	_, err = svc.RefreshSession(ctx, model.RefreshSessionArgs{RefreshToken: refreshed.RefreshToken})
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+
+	// the sessions of deleted users cannot be refreshed
+	tokens = login(t, svc, ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	user := repository.users[userID]
+	user.DeletedAt = time.Now()
+	repository.users[userID] = user
+	_, err = svc.RefreshSession(ctx, model.RefreshSessionArgs{RefreshToken: tokens.RefreshToken})
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+}
+
+func TestUserService_RefreshExpiredSession(t *testing.T) {
+	svc, _, sessionRepository, _ := newSessionTestService(t)
+	ctx := context.Background()
+	tokens := login(t, svc, ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+
+	session := sessionRepository.sessions[tokens.SessionID]
+	session.ExpiresAt = time.Now().Add(-time.Second)
+	sessionRepository.sessions[tokens.SessionID] = session
+	_, err := svc.RefreshSession(ctx, model.RefreshSessionArgs{RefreshToken: tokens.RefreshToken})
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+}
+
+func TestUserService_ListAndRevokeSessions(t *testing.T) {
+	svc, _, _, auditRepository := newSessionTestService(t)
+	ctx := context.Background()
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	first := login(t, svc, ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	second := login(t, svc, ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+
+	res, err := svc.ListSessions(ctx, model.ListSessionsArgs{UserID: userID})
+	require.NoError(t, err)
+	assert.Len(t, res.Sessions, 2)
+
+	require.NoError(t, svc.RevokeSession(ctx, model.RevokeSessionArgs{UserID: userID, SessionID: first.SessionID}))
+	assert.Equal(t, model.AuditActionRevokeSession, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	res, err = svc.ListSessions(ctx, model.ListSessionsArgs{UserID: userID})
+	require.NoError(t, err)
+	require.Len(t, res.Sessions, 1)
+	assert.Equal(t, second.SessionID, res.Sessions[0].ID)
+
+	_, err = svc.RefreshSession(ctx, model.RefreshSessionArgs{RefreshToken: first.RefreshToken})
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+	require.ErrorIs(t, svc.RevokeSession(ctx, model.RevokeSessionArgs{UserID: userID, SessionID: first.SessionID}), model.ErrNotFound)
+	require.ErrorIs(t, svc.RevokeSession(ctx, model.RevokeSessionArgs{UserID: uuid.New(), SessionID: second.SessionID}), model.ErrNotFound)
+}
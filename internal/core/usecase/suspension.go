@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+// SuspendUser suspends a user until the given expiry, or bans them if there is none. Suspended users cannot log in nor
+// refresh their sessions, the access tokens already issued stay valid until they expire. It returns an error wrapping
+// model.ErrInvalidArgument if the reason is unknown or the expiry is past, model.ErrNotFound if the user does not
+// exist and an error wrapping model.ErrFailedPrecondition if the user is suspended already.
+func (s *UserService) SuspendUser(ctx context.Context, args model.SuspendUserArgs) (*model.SuspendUserResponse, error) {
+	if !args.Reason.Valid() {
+		return nil, fmt.Errorf("%w: unknown suspension reason %q", model.ErrInvalidArgument, args.Reason)
+	}
+	now := time.Now().UTC()
+	if !args.ExpiresAt.IsZero() && !args.ExpiresAt.After(now) {
+		return nil, fmt.Errorf("%w: suspension expiry is past", model.ErrInvalidArgument)
+	}
+	actor, _ := model.ActorFromContext(ctx)
+	suspension := &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    args.UserID,
+		Reason:    args.Reason,
+		Note:      args.Note,
+		IssuedBy:  actor.ID,
+		CreatedAt: now,
+	}
+	if !args.ExpiresAt.IsZero() {
+		suspension.ExpiresAt = args.ExpiresAt.UTC()
+	}
+	if err := s.suspensionRepository.SaveSuspension(ctx, suspension); err != nil {
+		return nil, fmt.Errorf("error saving suspension: %w", err)
+	}
+	if err := s.audit(ctx, args.UserID, model.AuditActionSuspend, "suspension", "status"); err != nil {
+		return nil, err
+	}
+	return &model.SuspendUserResponse{Suspension: *suspension}, nil
+}
+
+// LiftSuspension ends the suspension of a user before its expiry. It returns model.ErrNotFound if the user does not
+// exist and an error wrapping model.ErrFailedPrecondition if the user is not suspended.
+func (s *UserService) LiftSuspension(ctx context.Context, args model.LiftSuspensionArgs) error {
+	actor, _ := model.ActorFromContext(ctx)
+	if err := s.suspensionRepository.LiftSuspension(ctx, args.UserID, actor.ID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error lifting suspension: %w", err)
+	}
+	return s.audit(ctx, args.UserID, model.AuditActionLiftSuspension, "suspension", "status")
+}
+
+// ListSuspensions lists the suspensions of a user, in force and ended, most recent first.
+func (s *UserService) ListSuspensions(ctx context.Context, args model.ListSuspensionsArgs) (*model.ListSuspensionsResponse, error) {
+	suspensions, err := s.suspensionRepository.ListSuspensions(ctx, ports.ListSuspensionsQuery{
+		UserID: args.UserID,
+		Limit:  args.Limit,
+		Offset: args.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing suspensions: %w", err)
+	}
+	return &model.ListSuspensionsResponse{Suspensions: suspensions}, nil
+}
+
+// expireSuspensions ends the suspensions that expired at the given time and returns how many were ended. Every expiry
+// is audited, attributed to the actor carried by ctx.
+func (s *UserService) expireSuspensions(ctx context.Context, now time.Time) (int, error) {
+	userIDs, err := s.suspensionRepository.ExpireSuspensions(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("error expiring suspensions: %w", err)
+	}
+	for _, userID := range userIDs {
+		if err := s.audit(ctx, userID, model.AuditActionExpireSuspension, "suspension", "status"); err != nil {
+			return 0, err
+		}
+	}
+	return len(userIDs), nil
+}
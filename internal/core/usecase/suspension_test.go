@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSuspensionRepository is an in-memory implementation of the SuspensionRepository interface reflecting the
+// suspension in force in the users of a MockRepository.
+type MockSuspensionRepository struct {
+	*MockRepository
+	suspensions []model.Suspension
+}
+
+func (m *MockSuspensionRepository) SaveSuspension(ctx context.Context, suspension *model.Suspension) error {
+	user, ok := m.users[suspension.UserID]
+	if !ok || !user.DeletedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	if !user.SuspendedAt.IsZero() {
+		return fmt.Errorf("%w: user already suspended", model.ErrFailedPrecondition)
+	}
+	m.suspensions = append(m.suspensions, *suspension)
+	user.SuspensionReason, user.SuspendedAt, user.SuspendedUntil = suspension.Reason, suspension.CreatedAt, suspension.ExpiresAt
+	user.Status, user.DeletionScheduledFor = model.UserStatusSuspended, time.Time{}
+	m.users[suspension.UserID] = user
+	return nil
+}
+
+func (m *MockSuspensionRepository) LiftSuspension(ctx context.Context, userID uuid.UUID, liftedBy string, liftedAt time.Time) error {
+	user, ok := m.users[userID]
+	if !ok || !user.DeletedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	for i, suspension := range m.suspensions {
+		if suspension.UserID == userID && suspension.EndedAt.IsZero() {
+			m.suspensions[i].EndedAt, m.suspensions[i].LiftedBy = liftedAt, liftedBy
+			m.unsuspend(userID)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: user not suspended", model.ErrFailedPrecondition)
+}
+
+func (m *MockSuspensionRepository) ListSuspensions(ctx context.Context, query ports.ListSuspensionsQuery) ([]model.Suspension, error) {
+	var suspensions []model.Suspension
+	for i := len(m.suspensions) - 1; i >= 0; i-- {
+		if m.suspensions[i].UserID == query.UserID {
+			suspensions = append(suspensions, m.suspensions[i])
+		}
+	}
+	return suspensions, nil
+}
+
+func (m *MockSuspensionRepository) ExpireSuspensions(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	var expired []uuid.UUID
+	for i, suspension := range m.suspensions {
+		if suspension.EndedAt.IsZero() && !suspension.ExpiresAt.IsZero() && !suspension.ExpiresAt.After(now) {
+			m.suspensions[i].EndedAt = suspension.ExpiresAt
+			m.unsuspend(suspension.UserID)
+			expired = append(expired, suspension.UserID)
+		}
+	}
+	return expired, nil
+}
+
+func (m *MockSuspensionRepository) unsuspend(userID uuid.UUID) {
+	user := m.users[userID]
+	user.SuspensionReason, user.SuspendedAt, user.SuspendedUntil = "", time.Time{}, time.Time{}
+	if user.Status == model.UserStatusSuspended {
+		user.Status = user.SettledStatus()
+	}
+	m.users[userID] = user
+}
+
+func TestUserService_Suspensions(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		userID: {ID: userID, Email: "jane@example.com", PasswordHash: mustHash(t, "password"), VerifiedAt: time.Now()},
+	}}
+	auditRepository := &MockAuditRepository{}
+	suspensionRepository := &MockSuspensionRepository{MockRepository: repository}
+	svc := NewUserService(UserServiceArgs{
+		Repository:           repository,
+		AuditRepository:      auditRepository,
+		LockoutRepository:    newMockLockoutRepository(),
+		SessionRepository:    newMockSessionRepository(),
+		TokenIssuer:          &MockTokenIssuer{},
+		SuspensionRepository: suspensionRepository,
+	})
+	ctx := model.ContextWithActor(context.Background(), model.Actor{ID: "apikey:anti-cheat", Roles: []model.Role{model.RoleSupport}})
+	tokens := login(t, svc, context.Background(), model.LoginArgs{Email: "jane@example.com", Password: "password"})
+
+	_, err := svc.SuspendUser(ctx, model.SuspendUserArgs{UserID: userID, Reason: "bad"})
+	require.ErrorIs(t, err, model.ErrInvalidArgument)
+	_, err = svc.SuspendUser(ctx, model.SuspendUserArgs{UserID: userID, Reason: model.SuspensionReasonCheating, ExpiresAt: time.Now().Add(-time.Hour)})
+	require.ErrorIs(t, err, model.ErrInvalidArgument)
+
+	resp, err := svc.SuspendUser(ctx, model.SuspendUserArgs{UserID: userID, Reason: model.SuspensionReasonCheating, Note: "aimbot"})
+	require.NoError(t, err)
+	assert.Equal(t, "apikey:anti-cheat", resp.Suspension.IssuedBy)
+	assert.True(t, resp.Suspension.ExpiresAt.IsZero())
+	assert.Equal(t, model.AuditActionSuspend, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	assert.Equal(t, model.UserStatusSuspended, repository.users[userID].Status)
+	_, err = svc.SuspendUser(ctx, model.SuspendUserArgs{UserID: userID, Reason: model.SuspensionReasonFraud})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+
+	// suspended users can neither log in nor refresh their sessions
+	_, err = svc.Login(context.Background(), model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	require.ErrorIs(t, err, model.ErrSuspended)
+	_, err = svc.RefreshSession(context.Background(), model.RefreshSessionArgs{RefreshToken: tokens.RefreshToken})
+	require.ErrorIs(t, err, model.ErrSuspended)
+
+	require.NoError(t, svc.LiftSuspension(ctx, model.LiftSuspensionArgs{UserID: userID}))
+	assert.Equal(t, model.AuditActionLiftSuspension, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	require.ErrorIs(t, svc.LiftSuspension(ctx, model.LiftSuspensionArgs{UserID: userID}), model.ErrFailedPrecondition)
+	assert.Equal(t, model.UserStatusActive, repository.users[userID].Status)
+	login(t, svc, context.Background(), model.LoginArgs{Email: "jane@example.com", Password: "password"})
+
+	// the suspensions stop applying at their expiry, before the sweeper ends them
+	_, err = svc.SuspendUser(ctx, model.SuspendUserArgs{UserID: userID, Reason: model.SuspensionReasonAbusiveBehavior, ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	user := repository.users[userID]
+	user.SuspendedUntil = time.Now().Add(-time.Second)
+	repository.users[userID] = user
+	login(t, svc, context.Background(), model.LoginArgs{Email: "jane@example.com", Password: "password"})
+
+	suspensions, err := svc.ListSuspensions(ctx, model.ListSuspensionsArgs{UserID: userID})
+	require.NoError(t, err)
+	require.Len(t, suspensions.Suspensions, 2)
+	assert.Equal(t, model.SuspensionReasonAbusiveBehavior, suspensions.Suspensions[0].Reason)
+	assert.True(t, suspensions.Suspensions[0].EndedAt.IsZero())
+	assert.Equal(t, "apikey:anti-cheat", suspensions.Suspensions[1].LiftedBy)
+}
+
+func TestSweeper_Sweep(t *testing.T) {
+	userID := uuid.New()
+	repository := &MockRepository{users: map[uuid.UUID]model.User{userID: {ID: userID, VerifiedAt: time.Now()}}}
+	suspensionRepository := &MockSuspensionRepository{MockRepository: repository}
+	expiresAt := time.Now().Add(-time.Minute).UTC()
+	require.NoError(t, suspensionRepository.SaveSuspension(context.Background(), &model.Suspension{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Reason:    model.SuspensionReasonCheating,
+		CreatedAt: expiresAt.Add(-time.Hour),
+		ExpiresAt: expiresAt,
+	}))
+	auditRepository := &MockAuditRepository{}
+	sweeper := NewSweeper(SweeperArgs{
+		UserService: NewUserService(UserServiceArgs{
+			Repository:           repository,
+			AuditRepository:      auditRepository,
+			SuspensionRepository: suspensionRepository,
+			DeletionRepository:   &MockDeletionRepository{MockRepository: repository},
+		}),
+	})
+
+	res, err := sweeper.Sweep(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.ExpiredSuspensions)
+	assert.Equal(t, model.UserStatusActive, repository.users[userID].Status)
+	assert.Equal(t, expiresAt, suspensionRepository.suspensions[0].EndedAt)
+	require.Len(t, auditRepository.entries, 1)
+	assert.Equal(t, model.AuditActionExpireSuspension, auditRepository.entries[0].Action)
+	assert.Equal(t, "system:sweeper", auditRepository.entries[0].ActorID)
+
+	res, err = sweeper.Sweep(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, res.ExpiredSuspensions)
+}
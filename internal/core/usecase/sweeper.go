@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rbroggi/faceittha/internal/core/model"
+)
+
+// SweeperArgs gathers the dependencies of the Sweeper.
+type SweeperArgs struct {
+	// UserService ends the suspensions and carries out the deletions requested by the users, auditing them and
+	// destroying the data keys of the erased users.
+	UserService *UserService
+
+	// HardDeleteAccounts makes the deletions requested by the users erase the users, as EraseUser does, instead of
+	// soft-deleting them.
+	HardDeleteAccounts bool
+}
+
+// NewSweeper builds a new sweeper.
+func NewSweeper(args SweeperArgs) *Sweeper {
+	return &Sweeper{
+		userService:        args.UserService,
+		hardDeleteAccounts: args.HardDeleteAccounts,
+	}
+}
+
+// Sweeper processes the expiries of the state of the users, e.g. of their suspensions or of the grace period of the
+// deletion of their account. It is meant to run periodically in the worker; several instances can sweep concurrently.
+type Sweeper struct {
+	userService        *UserService
+	hardDeleteAccounts bool
+}
+
+// SweepResult counts what a sweep processed.
+type SweepResult struct {
+	// ExpiredSuspensions is the number of suspensions that ended with their expiry.
+	ExpiredSuspensions int
+
+	// DeletedAccounts is the number of users deleted at the end of their grace period.
+	DeletedAccounts int
+}
+
+// sweeperActor is the actor the changes made by the sweeps are attributed to in the audit trail.
+var sweeperActor = model.Actor{ID: "system:sweeper"}
+
+// Sweep processes the expiries that are due. The changes of the users are captured by CDC and published as user
+// events.
+func (s *Sweeper) Sweep(ctx context.Context) (*SweepResult, error) {
+	ctx = model.ContextWithActor(ctx, sweeperActor)
+	now := time.Now().UTC()
+	expired, err := s.userService.expireSuspensions(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := s.userService.deleteDueUsers(ctx, now, s.hardDeleteAccounts)
+	if err != nil {
+		return nil, fmt.Errorf("error deleting scheduled users: %w", err)
+	}
+	return &SweepResult{ExpiredSuspensions: expired, DeletedAccounts: deleted}, nil
+}
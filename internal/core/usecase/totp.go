@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+)
+
+const (
+	// totpIssuer names the service in the authenticator apps.
+	totpIssuer = "faceittha"
+
+	// totpPeriod is the duration of a TOTP time step.
+	totpPeriod = 30
+
+	// totpDigits is the number of digits of the TOTP codes.
+	totpDigits = 6
+
+	// totpSkew is the number of time steps the codes are accepted before and after the current one, tolerating
+	// clock drift and typing delays.
+	totpSkew = 1
+
+	// recoveryCodes is the number of recovery codes generated on confirmation of a TOTP enrollment.
+	recoveryCodes = 10
+)
+
+// totpEncoding encodes the TOTP secrets and the recovery codes.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EnrollTOTP starts the enrollment of TOTP as second factor of a user, replacing the pending one if any. The
+// enrollment takes effect once confirmed with ConfirmTOTP. It returns model.ErrNotFound if the user does not exist
+// and an error wrapping model.ErrFailedPrecondition if TOTP is enabled already.
+func (s *UserService) EnrollTOTP(ctx context.Context, args model.EnrollTOTPArgs) (*model.TOTPEnrollment, error) {
+	user, err := s.repository.GetUser(ctx, ports.GetUserQuery{ID: args.UserID})
+	if err != nil {
+		return nil, fmt.Errorf("error getting user from repository: %w", err)
+	}
+	if !user.TOTPEnabledAt.IsZero() {
+		return nil, fmt.Errorf("%w: totp already enabled", model.ErrFailedPrecondition)
+	}
+
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("error generating totp secret: %w", err)
+	}
+	credential := &model.TOTPCredential{
+		UserID:    user.ID,
+		Secret:    totpEncoding.EncodeToString(secret),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.totpRepository.SaveTOTPCredential(ctx, credential); err != nil {
+		return nil, fmt.Errorf("error saving totp credential: %w", err)
+	}
+	return &model.TOTPEnrollment{Secret: credential.Secret, URI: totpURI(user, credential.Secret)}, nil
+}
+
+// ConfirmTOTP enables TOTP as second factor of a user once they prove, with a code, that their authenticator app holds
+// the enrolled secret. It returns the recovery codes of the user, which are not retrievable afterwards. It returns an
+// error wrapping model.ErrFailedPrecondition if the user has no pending enrollment and model.ErrInvalidCredentials if
+// the code does not match.
+func (s *UserService) ConfirmTOTP(ctx context.Context, args model.ConfirmTOTPArgs) (*model.ConfirmTOTPResponse, error) {
+	credential, err := s.totpRepository.GetTOTPCredential(ctx, args.UserID)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, fmt.Errorf("error getting totp credential: %w", err)
+	}
+	if credential == nil || !credential.ConfirmedAt.IsZero() {
+		return nil, fmt.Errorf("%w: no pending totp enrollment", model.ErrFailedPrecondition)
+	}
+	now := time.Now().UTC()
+	step, ok := totpStep(credential.Secret, args.Code, now, 0)
+	if !ok {
+		return nil, model.ErrInvalidCredentials
+	}
+
+	codes := make([]string, recoveryCodes)
+	hashes := make([]string, recoveryCodes)
+	for i := range codes {
+		if codes[i], err = newRecoveryCode(); err != nil {
+			return nil, err
+		}
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	err = s.totpRepository.ConfirmTOTPCredential(ctx, args.UserID, step, hashes, now)
+	if errors.Is(err, model.ErrNotFound) {
+		// the enrollment was confirmed concurrently
+		return nil, fmt.Errorf("%w: no pending totp enrollment", model.ErrFailedPrecondition)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error confirming totp credential: %w", err)
+	}
+	if err := s.audit(ctx, args.UserID, model.AuditActionEnableTOTP, "totp_enabled_at"); err != nil {
+		return nil, err
+	}
+	return &model.ConfirmTOTPResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableTOTP disables the TOTP second factor of a user and discards their recovery codes. Users prove they hold the
+// second factor with a code, as in CompleteLogin, admins do not. It returns model.ErrNotFound if the user does not
+// exist, an error wrapping model.ErrFailedPrecondition if TOTP is not enabled and model.ErrInvalidCredentials if the
+// code does not match or while the account or the source IP is locked.
+func (s *UserService) DisableTOTP(ctx context.Context, args model.DisableTOTPArgs) error {
+	user, err := s.repository.GetUser(ctx, ports.GetUserQuery{ID: args.UserID})
+	if err != nil {
+		return fmt.Errorf("error getting user from repository: %w", err)
+	}
+	if user.TOTPEnabledAt.IsZero() {
+		return fmt.Errorf("%w: totp not enabled", model.ErrFailedPrecondition)
+	}
+	if actor, _ := model.ActorFromContext(ctx); !actor.HasRole(model.RoleAdmin) {
+		if err := s.verifySecondFactor(ctx, user, args.Code); err != nil {
+			return err
+		}
+		if err := s.resetCredentialFailures(ctx, user); err != nil {
+			return err
+		}
+	}
+
+	err = s.totpRepository.DeleteTOTPCredential(ctx, args.UserID)
+	if errors.Is(err, model.ErrNotFound) {
+		return fmt.Errorf("%w: totp not enabled", model.ErrFailedPrecondition)
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting totp credential: %w", err)
+	}
+	return s.audit(ctx, args.UserID, model.AuditActionDisableTOTP, "totp_enabled_at")
+}
+
+// verifySecondFactor checks the code of the second factor of the user, with the brute-force protection of
+// verifyPassword. TOTP codes are accepted once, as are recovery codes.
+func (s *UserService) verifySecondFactor(ctx context.Context, user *model.User, code string) error {
+	keys := credentialKeys(ctx, user.Email)
+	if err := s.checkCredentialLocks(ctx, keys); err != nil {
+		return err
+	}
+	ok, err := s.checkSecondFactor(ctx, user.ID, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return s.credentialFailure(ctx, user, keys)
+	}
+	return nil
+}
+
+// checkSecondFactor reports whether the code is a valid TOTP code or an unused recovery code of the user, consuming it.
+func (s *UserService) checkSecondFactor(ctx context.Context, userID uuid.UUID, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		err := s.totpRepository.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+		if errors.Is(err, model.ErrNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("error using recovery code: %w", err)
+		}
+		return true, nil
+	}
+
+	credential, err := s.totpRepository.GetTOTPCredential(ctx, userID)
+	if errors.Is(err, model.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error getting totp credential: %w", err)
+	}
+	if credential.ConfirmedAt.IsZero() {
+		return false, nil
+	}
+	step, ok := totpStep(credential.Secret, code, time.Now(), credential.LastUsedStep)
+	if !ok {
+		return false, nil
+	}
+	// the step is accepted atomically, a replayed code fails even if used concurrently
+	err = s.totpRepository.UseTOTPStep(ctx, userID, step)
+	if errors.Is(err, model.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error using totp code: %w", err)
+	}
+	return true, nil
+}
+
+// totpStep returns the time step, later than after, whose code (RFC 6238) matches the given one around the given time.
+func totpStep(secret, code string, at time.Time, after int64) (int64, bool) {
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+	current := at.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= after {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpCode computes the HOTP code (RFC 4226) of the time step.
+func totpCode(key []byte, step int64) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
+
+// totpURI is the otpauth:// URI of the secret, the de facto standard to provision authenticator apps.
+func totpURI(user *model.User, secret string) string {
+	account := user.Email
+	if account == "" {
+		account = user.Nickname
+	}
+	if account == "" {
+		account = user.ID.String()
+	}
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", totpIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriod))
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + account,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// newRecoveryCode generates a recovery code, formatted as four groups of four characters.
+func newRecoveryCode() (string, error) {
+	secret := make([]byte, 10)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("error generating recovery code: %w", err)
+	}
+	code := strings.ToLower(totpEncoding.EncodeToString(secret))
+	return code[0:4] + "-" + code[4:8] + "-" + code[8:12] + "-" + code[12:16], nil
+}
+
+// hashRecoveryCode returns the digest the recovery code is stored by. The code is normalized first, so that it can be
+// typed without its dashes and in any case.
+func hashRecoveryCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	digest := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(digest[:])
+}
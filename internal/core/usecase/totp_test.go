@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTOTPRepository is an in-memory implementation of the TOTPRepository interface. It enables TOTP on the users of
+// the repository.
+type MockTOTPRepository struct {
+	repository    *MockRepository
+	credentials   map[uuid.UUID]model.TOTPCredential
+	recoveryCodes map[uuid.UUID]map[string]bool
+}
+
+func newMockTOTPRepository(repository *MockRepository) *MockTOTPRepository {
+	return &MockTOTPRepository{
+		repository:    repository,
+		credentials:   map[uuid.UUID]model.TOTPCredential{},
+		recoveryCodes: map[uuid.UUID]map[string]bool{},
+	}
+}
+
+func (m *MockTOTPRepository) SaveTOTPCredential(ctx context.Context, credential *model.TOTPCredential) error {
+	if existing, ok := m.credentials[credential.UserID]; ok && !existing.ConfirmedAt.IsZero() {
+		return model.ErrFailedPrecondition
+	}
+	m.credentials[credential.UserID] = *credential
+	return nil
+}
+
+func (m *MockTOTPRepository) GetTOTPCredential(ctx context.Context, userID uuid.UUID) (*model.TOTPCredential, error) {
+	credential, ok := m.credentials[userID]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return &credential, nil
+}
+
+func (m *MockTOTPRepository) ConfirmTOTPCredential(ctx context.Context, userID uuid.UUID, step int64, recoveryCodeHashes []string, confirmedAt time.Time) error {
+	credential, ok := m.credentials[userID]
+	if !ok || !credential.ConfirmedAt.IsZero() {
+		return model.ErrNotFound
+	}
+	credential.ConfirmedAt, credential.LastUsedStep = confirmedAt, step
+	m.credentials[userID] = credential
+	m.recoveryCodes[userID] = map[string]bool{}
+	for _, hash := range recoveryCodeHashes {
+		m.recoveryCodes[userID][hash] = false
+	}
+	user := m.repository.users[userID]
+	user.TOTPEnabledAt = confirmedAt
+	m.repository.users[userID] = user
+	return nil
+}
+
+func (m *MockTOTPRepository) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	credential, ok := m.credentials[userID]
+	if !ok || credential.ConfirmedAt.IsZero() || credential.LastUsedStep >= step {
+		return model.ErrNotFound
+	}
+	credential.LastUsedStep = step
+	m.credentials[userID] = credential
+	return nil
+}
+
+func (m *MockTOTPRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) error {
+	used, ok := m.recoveryCodes[userID][hash]
+	if !ok || used {
+		return model.ErrNotFound
+	}
+	m.recoveryCodes[userID][hash] = true
+	return nil
+}
+
+func (m *MockTOTPRepository) DeleteTOTPCredential(ctx context.Context, userID uuid.UUID) error {
+	if _, ok := m.credentials[userID]; !ok {
+		return model.ErrNotFound
+	}
+	delete(m.credentials, userID)
+	delete(m.recoveryCodes, userID)
+	user := m.repository.users[userID]
+	user.TOTPEnabledAt = time.Time{}
+	m.repository.users[userID] = user
+	return nil
+}
+
+func newTOTPTestService(t *testing.T) (*UserService, *MockTOTPRepository, *MockSessionRepository, *MockAuditRepository) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		userID: {ID: userID, Email: "jane@example.com", PasswordHash: mustHash(t, "password")},
+	}}
+	totpRepository := newMockTOTPRepository(repository)
+	sessionRepository := newMockSessionRepository()
+	auditRepository := &MockAuditRepository{}
+	svc := NewUserService(UserServiceArgs{
+		Repository:        repository,
+		AuditRepository:   auditRepository,
+		LockoutRepository: newMockLockoutRepository(),
+		SessionRepository: sessionRepository,
+		TokenIssuer:       &MockTokenIssuer{},
+		TOTPRepository:    totpRepository,
+	})
+	return svc, totpRepository, sessionRepository, auditRepository
+}
+
+// enableTOTP enrolls and confirms TOTP for the user, returning the secret and the recovery codes.
+func enableTOTP(t *testing.T, svc *UserService, userID uuid.UUID) (string, []string) {
+	ctx := context.Background()
+	enrollment, err := svc.EnrollTOTP(ctx, model.EnrollTOTPArgs{UserID: userID})
+	require.NoError(t, err)
+	resp, err := svc.ConfirmTOTP(ctx, model.ConfirmTOTPArgs{UserID: userID, Code: totpCodeAt(t, enrollment.Secret, time.Now())})
+	require.NoError(t, err)
+	return enrollment.Secret, resp.RecoveryCodes
+}
+
+func totpCodeAt(t *testing.T, secret string, at time.Time) string {
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+	return totpCode(key, at.Unix()/totpPeriod)
+}
+
+func TestTOTPCode(t *testing.T) {
+	// test vectors of RFC 6238, appendix B, truncated to 6 digits
+	key := []byte("12345678901234567890")
+	tests := []struct {
+		at   int64
+		code string
+	}{
+		{at: 59, code: "287082"},
+		{at: 1111111109, code: "081804"},
+		{at: 1111111111, code: "050471"},
+		{at: 1234567890, code: "005924"},
+		{at: 2000000000, code: "279037"},
+		{at: 20000000000, code: "353130"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.code, totpCode(key, test.at/totpPeriod))
+	}
+
+	secret := totpEncoding.EncodeToString(key)
+	at := time.Unix(1111111111, 0)
+	step, ok := totpStep(secret, "050471", at, 0)
+	require.True(t, ok)
+	assert.Equal(t, int64(1111111111/totpPeriod), step)
+	// the codes of the adjacent steps are accepted, the older ones are not
+	_, ok = totpStep(secret, "050471", at.Add(totpPeriod*time.Second), 0)
+	assert.True(t, ok)
+	_, ok = totpStep(secret, "050471", at.Add(2*totpPeriod*time.Second), 0)
+	assert.False(t, ok)
+	// nor are the codes of the steps used already
+	_, ok = totpStep(secret, "050471", at, step)
+	assert.False(t, ok)
+}
+
+func TestUserService_EnrollTOTP(t *testing.T) {
+	svc, _, _, auditRepository := newTOTPTestService(t)
+	ctx := context.Background()
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+
+	_, err := svc.ConfirmTOTP(ctx, model.ConfirmTOTPArgs{UserID: userID, Code: "123456"})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+
+	enrollment, err := svc.EnrollTOTP(ctx, model.EnrollTOTPArgs{UserID: userID})
+	require.NoError(t, err)
+	uri, err := url.Parse(enrollment.URI)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "/faceittha:jane@example.com", uri.Path)
+	assert.Equal(t, enrollment.Secret, uri.Query().Get("secret"))
+
+	// a wrong code leaves the enrollment pending
+	code := totpCodeAt(t, enrollment.Secret, time.Now().Add(-time.Hour))
+	_, err = svc.ConfirmTOTP(ctx, model.ConfirmTOTPArgs{UserID: userID, Code: code})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+
+	resp, err := svc.ConfirmTOTP(ctx, model.ConfirmTOTPArgs{UserID: userID, Code: totpCodeAt(t, enrollment.Secret, time.Now())})
+	require.NoError(t, err)
+	assert.Len(t, resp.RecoveryCodes, recoveryCodes)
+	assert.Equal(t, model.AuditActionEnableTOTP, auditRepository.entries[len(auditRepository.entries)-1].Action)
+
+	_, err = svc.EnrollTOTP(ctx, model.EnrollTOTPArgs{UserID: userID})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+	_, err = svc.ConfirmTOTP(ctx, model.ConfirmTOTPArgs{UserID: userID, Code: totpCodeAt(t, enrollment.Secret, time.Now())})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+}
+
+func TestUserService_LoginWithTOTP(t *testing.T) {
+	svc, totpRepository, sessionRepository, _ := newTOTPTestService(t)
+	ctx := context.Background()
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	secret, codes := enableTOTP(t, svc, userID)
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+
+	resp, err := svc.Login(ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Tokens)
+	require.NotEmpty(t, resp.MFAChallenge)
+	assert.WithinDuration(t, time.Now().Add(loginChallengeTimeout), resp.MFAChallengeExpiresAt, time.Second)
+	assert.Empty(t, sessionRepository.sessions)
+
+	// the code used for the confirmation cannot be replayed
+	code := totpCode(key, totpRepository.credentials[userID].LastUsedStep)
+	_, err = svc.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: resp.MFAChallenge, Code: code})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+	_, err = svc.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: "unknown", Code: codes[0]})
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+
+	code = totpCodeAt(t, secret, time.Now().Add(totpPeriod*time.Second))
+	tokens, err := svc.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: resp.MFAChallenge, Code: code})
+	require.NoError(t, err)
+	assert.Contains(t, sessionRepository.sessions, tokens.SessionID)
+	// the challenge is consumed
+	_, err = svc.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: resp.MFAChallenge, Code: codes[0]})
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+
+	// the recovery codes are accepted once, typed in any case and without dashes
+	resp, err = svc.Login(ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	require.NoError(t, err)
+	recoveryCode := strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))
+	_, err = svc.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: resp.MFAChallenge, Code: recoveryCode})
+	require.NoError(t, err)
+	resp, err = svc.Login(ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	require.NoError(t, err)
+	_, err = svc.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: resp.MFAChallenge, Code: codes[0]})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+
+	// expired challenges are rejected
+	hash := hashToken(resp.MFAChallenge)
+	challenge := sessionRepository.challenges[hash]
+	challenge.ExpiresAt = time.Now().Add(-time.Second)
+	sessionRepository.challenges[hash] = challenge
+	_, err = svc.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: resp.MFAChallenge, Code: codes[1]})
+	require.ErrorIs(t, err, model.ErrUnauthenticated)
+}
+
+func TestUserService_CompleteLoginLocksAccount(t *testing.T) {
+	svc, _, _, _ := newTOTPTestService(t)
+	ctx := context.Background()
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	_, codes := enableTOTP(t, svc, userID)
+
+	resp, err := svc.Login(ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	require.NoError(t, err)
+	for i := 0; i < accountLockoutPolicy.maxFailures; i++ {
+		_, err = svc.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: resp.MFAChallenge, Code: "000000"})
+		require.ErrorIs(t, err, model.ErrInvalidCredentials)
+	}
+	_, err = svc.CompleteLogin(ctx, model.CompleteLoginArgs{MFAChallenge: resp.MFAChallenge, Code: codes[0]})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+	// a correct password does not lift the lock
+	_, err = svc.Login(ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+}
+
+func TestUserService_DisableTOTP(t *testing.T) {
+	svc, totpRepository, _, auditRepository := newTOTPTestService(t)
+	ctx := model.ContextWithActor(context.Background(), model.Actor{ID: "3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de"})
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+
+	err := svc.DisableTOTP(ctx, model.DisableTOTPArgs{UserID: userID})
+	require.ErrorIs(t, err, model.ErrFailedPrecondition)
+
+	_, codes := enableTOTP(t, svc, userID)
+	err = svc.DisableTOTP(ctx, model.DisableTOTPArgs{UserID: userID, Code: "000000"})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+	require.NoError(t, svc.DisableTOTP(ctx, model.DisableTOTPArgs{UserID: userID, Code: codes[0]}))
+	assert.Empty(t, totpRepository.credentials)
+	assert.Equal(t, model.AuditActionDisableTOTP, auditRepository.entries[len(auditRepository.entries)-1].Action)
+	login(t, svc, ctx, model.LoginArgs{Email: "jane@example.com", Password: "password"})
+
+	// admins disable the second factor without a code
+	enableTOTP(t, svc, userID)
+	adminCtx := model.ContextWithActor(context.Background(), model.Actor{ID: uuid.NewString(), Roles: []model.Role{model.RoleAdmin}})
+	require.NoError(t, svc.DisableTOTP(adminCtx, model.DisableTOTPArgs{UserID: userID}))
+	assert.Empty(t, totpRepository.credentials)
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/alexedwards/argon2id"
 	"github.com/google/uuid"
@@ -14,20 +16,176 @@ import (
 type UserServiceArgs struct {
 	// Repository is the repository for persistance operations.
 	Repository ports.Repository
+
+	// AuditRepository is the repository for the audit trail.
+	AuditRepository ports.AuditRepository
+
+	// Signer signs the data exports.
+	Signer ports.Signer
+
+	// Vault holds the data keys protecting the personal data of the users.
+	Vault ports.Vault
+
+	// LockoutRepository counts the failed credential checks.
+	LockoutRepository ports.LockoutRepository
+
+	// SessionRepository stores the sessions and their refresh tokens.
+	SessionRepository ports.SessionRepository
+
+	// TokenIssuer issues the access tokens of the sessions.
+	TokenIssuer ports.TokenIssuer
+
+	// TOTPRepository stores the TOTP second factors and their recovery codes.
+	TOTPRepository ports.TOTPRepository
+
+	// APIKeyRepository stores the API keys of the services.
+	APIKeyRepository ports.APIKeyRepository
+
+	// RoleRepository stores the roles granted to the users.
+	RoleRepository ports.RoleRepository
+
+	// AttributeSchemas is the attribute registry: the schemas of the custom profile attributes, by key. Attributes
+	// whose key is not registered are rejected. Optional.
+	AttributeSchemas map[string]model.AttributeSchema
+
+	// FriendshipRepository stores the friendships between users.
+	FriendshipRepository ports.FriendshipRepository
+
+	// BlockRepository stores the blocks between users.
+	BlockRepository ports.BlockRepository
+
+	// SuspensionRepository stores the suspensions of the users.
+	SuspensionRepository ports.SuspensionRepository
+
+	// DeletionRepository stores the deletions requested by the users.
+	DeletionRepository ports.DeletionRepository
+
+	// AccountDeletionGracePeriod is the time the users have to cancel the deletion of their account.
+	// DefaultAccountDeletionGracePeriod if zero.
+	AccountDeletionGracePeriod time.Duration
+
+	// NicknameRepository stores the nickname history and reservations of the users. Optional, the nickname changes are
+	// neither limited nor checked against the nicknames of the other users without it.
+	NicknameRepository ports.NicknameRepository
+
+	// NicknameChangeCooldown is the time the users wait between two changes of their nickname.
+	// DefaultNicknameChangeCooldown if zero.
+	NicknameChangeCooldown time.Duration
+
+	// NicknameReservation is the time the released nicknames are reserved to their previous holder.
+	// DefaultNicknameReservation if zero.
+	NicknameReservation time.Duration
+
+	// NicknameMinLength is the minimum length of the nicknames in user-perceived characters.
+	// DefaultNicknameMinLength if zero.
+	NicknameMinLength int
+
+	// NicknameMaxLength is the maximum length of the nicknames in user-perceived characters.
+	// DefaultNicknameMaxLength if zero.
+	NicknameMaxLength int
+
+	// ReservedNicknames are the nicknames given by the admins only, compared by model.NicknameKey.
+	// DefaultReservedNicknames if nil.
+	ReservedNicknames []string
+
+	// ProfaneWords are the words the nicknames must not contain, compared by model.NicknameKey. Optional.
+	ProfaneWords []string
+
+	// DisposableEmailDomains are the domains of the disposable email services, rejected along with their subdomains.
+	// Optional.
+	DisposableEmailDomains []string
 }
 
 // NewUserService creates a new UserService.
 func NewUserService(args UserServiceArgs) *UserService {
-	return &UserService{repository: args.Repository}
+	service := &UserService{
+		repository:           args.Repository,
+		auditRepository:      args.AuditRepository,
+		signer:               args.Signer,
+		vault:                args.Vault,
+		lockoutRepository:    args.LockoutRepository,
+		sessionRepository:    args.SessionRepository,
+		tokenIssuer:          args.TokenIssuer,
+		totpRepository:       args.TOTPRepository,
+		apiKeyRepository:     args.APIKeyRepository,
+		roleRepository:       args.RoleRepository,
+		attributeSchemas:     args.AttributeSchemas,
+		friendshipRepository: args.FriendshipRepository,
+		blockRepository:      args.BlockRepository,
+		suspensionRepository: args.SuspensionRepository,
+		deletionRepository:   args.DeletionRepository,
+
+		accountDeletionGracePeriod: args.AccountDeletionGracePeriod,
+		nicknameRepository:         args.NicknameRepository,
+		nicknameChangeCooldown:     args.NicknameChangeCooldown,
+		nicknameReservation:        args.NicknameReservation,
+		nicknameMinLength:          args.NicknameMinLength,
+		nicknameMaxLength:          args.NicknameMaxLength,
+		reservedNicknames:          nicknameKeys(args.ReservedNicknames),
+		profaneWords:               nicknameKeys(args.ProfaneWords),
+		disposableEmailDomains:     emailDomains(args.DisposableEmailDomains),
+	}
+	if service.accountDeletionGracePeriod == 0 {
+		service.accountDeletionGracePeriod = DefaultAccountDeletionGracePeriod
+	}
+	if service.nicknameChangeCooldown == 0 {
+		service.nicknameChangeCooldown = DefaultNicknameChangeCooldown
+	}
+	if service.nicknameReservation == 0 {
+		service.nicknameReservation = DefaultNicknameReservation
+	}
+	if service.nicknameMinLength == 0 {
+		service.nicknameMinLength = DefaultNicknameMinLength
+	}
+	if service.nicknameMaxLength == 0 {
+		service.nicknameMaxLength = DefaultNicknameMaxLength
+	}
+	if args.ReservedNicknames == nil {
+		service.reservedNicknames = nicknameKeys(DefaultReservedNicknames)
+	}
+	return service
 }
 
 // UserService gathers the functionality around the user-lifecycle
 type UserService struct {
-	repository ports.Repository
+	repository           ports.Repository
+	auditRepository      ports.AuditRepository
+	signer               ports.Signer
+	vault                ports.Vault
+	lockoutRepository    ports.LockoutRepository
+	sessionRepository    ports.SessionRepository
+	tokenIssuer          ports.TokenIssuer
+	totpRepository       ports.TOTPRepository
+	apiKeyRepository     ports.APIKeyRepository
+	roleRepository       ports.RoleRepository
+	attributeSchemas     map[string]model.AttributeSchema
+	friendshipRepository ports.FriendshipRepository
+	blockRepository      ports.BlockRepository
+	suspensionRepository ports.SuspensionRepository
+	deletionRepository   ports.DeletionRepository
+
+	accountDeletionGracePeriod time.Duration
+	nicknameRepository         ports.NicknameRepository
+	nicknameChangeCooldown     time.Duration
+	nicknameReservation        time.Duration
+	nicknameMinLength          int
+	nicknameMaxLength          int
+	reservedNicknames          []string
+	profaneWords               []string
+	disposableEmailDomains     []string
 }
 
-// CreateUser creates a user.
+// CreateUser creates a user with the normalized nickname. It returns a model.NicknameError if the nickname is rejected,
+// see CheckNicknameAvailability, an error wrapping model.ErrFailedPrecondition if the nickname reservation token holds
+// no live reservation of the nickname, an error wrapping model.ErrInvalidArgument if the email is disposable and one
+// wrapping model.ErrAlreadyExists if it is taken. The email is kept as typed, the users are told apart by their
+// canonical email, see model.CanonicalEmail.
 func (s *UserService) CreateUser(ctx context.Context, args model.CreateUserArgs) (*model.CreateUserResponse, error) {
+	nickname, err := s.validateNickname(ctx, args.Nickname)
+	if err != nil {
+		return nil, err
+	}
+
 	// CreateHash returns a Argon2id hash of a plain-text password using the
 	// provided algorithm parameters. The returned hash follows the format used
 	// by the Argon2 reference C implementation and looks like this:
@@ -41,52 +199,156 @@ func (s *UserService) CreateUser(ctx context.Context, args model.CreateUserArgs)
 		ID:           uuid.New(),
 		FirstName:    args.FirstName,
 		LastName:     args.LastName,
-		Nickname:     args.Nickname,
+		Nickname:     nickname,
 		Email:        args.Email,
 		PasswordHash: hash,
 		Country:      args.Country,
+		Status:       model.UserStatusPendingVerification,
 	}
-
-	if err := s.repository.SaveUser(ctx, user); err != nil {
-		return nil, fmt.Errorf("error saving user in repository: %w", err)
+	if err := s.checkEmail(ctx, user.ID, user.Email); err != nil {
+		return nil, err
+	}
+	if err := s.saveUser(ctx, user, args.NicknameReservationToken); err != nil {
+		return nil, err
+	}
+	if err := s.audit(ctx, user.ID, model.AuditActionCreate, changedFields(model.User{}, *user)...); err != nil {
+		return nil, err
 	}
 
 	return &model.CreateUserResponse{User: *user}, nil
 }
 
-// UpdateUser updates a user. It returns model.ErrNotFound if the ID does not correspond to an existing user.
+// UpdateUser updates a user. It returns model.ErrNotFound if the ID does not correspond to an existing user, an error
+// wrapping model.ErrInvalidArgument if the attributes are rejected by the attribute registry, an error wrapping
+// model.ErrFailedPrecondition if the nickname is changed again before the end of the cooldown, a model.NicknameError
+// if the new nickname is rejected and the errors of CreateUser if the new email is rejected.
 func (s *UserService) UpdateUser(ctx context.Context, args model.UpdateUserArgs) (*model.UpdateUserResponse, error) {
+	if err := s.validateAttributes(args.Attributes); err != nil {
+		return nil, err
+	}
+	if args.ProfileVisibility != "" && !args.ProfileVisibility.Valid() {
+		return nil, fmt.Errorf("%w: unknown profile visibility %q", model.ErrInvalidArgument, args.ProfileVisibility)
+	}
 	user := &model.User{
-		ID:           args.ID,
-		FirstName:    args.FirstName,
-		LastName:     args.LastName,
-		Nickname:     args.Nickname,
-		Email:        args.Email,
-		Country:      args.Country,
+		ID:                args.ID,
+		FirstName:         args.FirstName,
+		LastName:          args.LastName,
+		Nickname:          args.Nickname,
+		Email:             args.Email,
+		Country:           args.Country,
+		Attributes:        args.Attributes,
+		ProfileVisibility: args.ProfileVisibility,
+	}
+	if user.Nickname != "" {
+		nickname, err := s.validateNickname(ctx, user.Nickname)
+		if err != nil {
+			return nil, err
+		}
+		user.Nickname = nickname
+	}
+	before, err := s.repository.GetUser(ctx, ports.GetUserQuery{ID: args.ID, IncludeDeleted: true})
+	if err != nil {
+		return nil, fmt.Errorf("error getting user from repository: %w", err)
+	}
+	if user.Nickname != "" && user.Nickname != before.Nickname {
+		if err := s.checkNicknameChange(ctx, user.ID, user.Nickname); err != nil {
+			return nil, err
+		}
 	}
-	if err := s.repository.UpdateUser(ctx, user); err != nil {
+	if user.Email != "" && model.CanonicalEmail(user.Email) != model.CanonicalEmail(before.Email) {
+		if err := s.checkEmail(ctx, user.ID, user.Email); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repository.UpdateUser(ctx, user, ports.UpdateUserQuery{NicknameChangeCooldown: s.nicknameCooldown(ctx)}); err != nil {
 		return nil, fmt.Errorf("error updating user: %w", err)
 	}
+	if err := s.audit(ctx, user.ID, model.AuditActionUpdate, changedFields(*before, *user)...); err != nil {
+		return nil, err
+	}
 	return &model.UpdateUserResponse{User: *user}, nil
 }
 
-// ListUsers lists users matching the arguments.
+// ChangePassword replaces the password of a user. It returns model.ErrNotFound if the ID does not correspond to an
+// existing user and model.ErrInvalidCredentials if the current password does not match or while the account or the
+// source IP is locked after too many failed attempts.
+func (s *UserService) ChangePassword(ctx context.Context, args model.ChangePasswordArgs) error {
+	user, err := s.repository.GetUser(ctx, ports.GetUserQuery{ID: args.ID})
+	if err != nil {
+		return fmt.Errorf("error getting user from repository: %w", err)
+	}
+	if err := s.verifyPassword(ctx, user.Email, user, args.CurrentPassword); err != nil {
+		return err
+	}
+	if err := s.resetCredentialFailures(ctx, user); err != nil {
+		return err
+	}
+
+	hash, err := argon2id.CreateHash(args.NewPassword, argon2id.DefaultParams)
+	if err != nil {
+		return fmt.Errorf("error creating password hash: %w", err)
+	}
+	if err := s.repository.UpdateUser(ctx, &model.User{ID: args.ID, PasswordHash: hash}, ports.UpdateUserQuery{}); err != nil {
+		return fmt.Errorf("error updating user: %w", err)
+	}
+	return s.audit(ctx, args.ID, model.AuditActionPasswordChange, "password")
+}
+
+// ListUsers lists users matching the arguments. It returns an error wrapping model.ErrInvalidArgument if the users are
+// filtered by an attribute that is not registered or by an unknown status.
 func (s *UserService) ListUsers(ctx context.Context, args model.ListUsersArgs) (*model.ListUsersResponse, error) {
+	if err := s.validateAttributeFilters(args.Attributes); err != nil {
+		return nil, err
+	}
+	for _, status := range args.Statuses {
+		if !status.Valid() {
+			return nil, fmt.Errorf("%w: unknown status %q", model.ErrInvalidArgument, status)
+		}
+	}
 	res, err := s.repository.ListUsers(ctx, ports.ListUsersQuery{
-		ID:            args.ID,
-		Countries:     args.Countries,
-		CreatedAfter:  args.CreatedAfter,
-		CreatedBefore: args.CreatedBefore,
-		Limit:         args.Limit,
-		Offset:        args.Offset,
+		ID:             args.ID,
+		Countries:      args.Countries,
+		Statuses:       args.Statuses,
+		CreatedAfter:   args.CreatedAfter,
+		CreatedBefore:  args.CreatedBefore,
+		Limit:          args.Limit,
+		Offset:         args.Offset,
+		IncludeDeleted: args.IncludeDeleted,
+		Attributes:     args.Attributes,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("erro listing users on the repository: %w", err)
 	}
-
 	return &model.ListUsersResponse{Users: res.Users}, nil
 }
 
+// GetUser returns a user. When args.AsOf is set the version of the user valid at that time is returned.
+// It returns model.ErrNotFound if the user does not exist (or did not exist at that time).
+func (s *UserService) GetUser(ctx context.Context, args model.GetUserArgs) (*model.GetUserResponse, error) {
+	user, err := s.repository.GetUser(ctx, ports.GetUserQuery{
+		ID:             args.ID,
+		AsOf:           args.AsOf,
+		IncludeDeleted: args.IncludeDeleted,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting user from repository: %w", err)
+	}
+	return &model.GetUserResponse{User: *user}, nil
+}
+
+// GetUserHistory lists the versions of a user, oldest first.
+func (s *UserService) GetUserHistory(ctx context.Context, args model.GetUserHistoryArgs) (*model.GetUserHistoryResponse, error) {
+	res, err := s.repository.ListUserVersions(ctx, ports.ListUserVersionsQuery{
+		UserID: args.UserID,
+		Limit:  args.Limit,
+		Offset: args.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing user versions on the repository: %w", err)
+	}
+	return &model.GetUserHistoryResponse{Versions: res.Versions}, nil
+}
+
 // DeleteUser deletes a user matching the input arguments.
 func (s *UserService) DeleteUser(ctx context.Context, args model.DeleteUserArgs) error {
 	if err := s.repository.DeleteUser(ctx, ports.DeleteUserQuery{
@@ -95,5 +357,161 @@ func (s *UserService) DeleteUser(ctx context.Context, args model.DeleteUserArgs)
 	}); err != nil {
 		return fmt.Errorf("error deleting user from repository: %w", err)
 	}
+	if args.HardDelete {
+		return s.audit(ctx, args.ID, model.AuditActionHardDelete)
+	}
+	return s.audit(ctx, args.ID, model.AuditActionDelete, "deleted_at", "status")
+}
+
+// RestoreUser reverts the soft-deletion of a user, who settles back in their status. It returns model.ErrNotFound if
+// the ID does not correspond to an existing user and an error wrapping model.ErrFailedPrecondition if the user is not
+// deleted. Erased users cannot be restored.
+func (s *UserService) RestoreUser(ctx context.Context, args model.RestoreUserArgs) (*model.RestoreUserResponse, error) {
+	user, err := s.repository.RestoreUser(ctx, args.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error restoring user in repository: %w", err)
+	}
+	if err := s.audit(ctx, args.ID, model.AuditActionRestore, "deleted_at", "status"); err != nil {
+		return nil, err
+	}
+	return &model.RestoreUserResponse{User: *user}, nil
+}
+
+// VerifyUser records the verification of the email of a user pending verification, activating their account. It
+// returns model.ErrNotFound if the ID does not correspond to an existing user and an error wrapping
+// model.ErrFailedPrecondition if the user is not pending verification.
+func (s *UserService) VerifyUser(ctx context.Context, args model.VerifyUserArgs) error {
+	if err := s.repository.VerifyUser(ctx, args.ID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error verifying user: %w", err)
+	}
+	return s.audit(ctx, args.ID, model.AuditActionVerify, "verified_at", "status")
+}
+
+// ExportUserData exports all the data held about a user as a signed JSON archive.
+// Only the user themselves or an admin are allowed to export the data and every call is recorded in the audit trail.
+// It returns model.ErrUnauthenticated if ctx carries no actor, model.ErrPermissionDenied if the actor is not
+// allowed to export the data and model.ErrNotFound if the user does not exist. The user is redacted by args.FieldPolicy
+// before the archive is signed.
+func (s *UserService) ExportUserData(ctx context.Context, args model.ExportUserDataArgs) (*model.ExportUserDataResponse, error) {
+	actor, ok := model.ActorFromContext(ctx)
+	if !ok {
+		return nil, model.ErrUnauthenticated
+	}
+	if !actor.IsUser(args.UserID) && !actor.HasRole(model.RoleAdmin) {
+		if err := s.audit(ctx, args.UserID, model.AuditActionExportDenied); err != nil {
+			return nil, err
+		}
+		return nil, model.ErrPermissionDenied
+	}
+
+	user, err := s.repository.GetUser(ctx, ports.GetUserQuery{ID: args.UserID, IncludeDeleted: true})
+	if err != nil {
+		return nil, fmt.Errorf("error getting user from repository: %w", err)
+	}
+
+	// the export is audited before the trail is read so that the archive accounts for itself.
+	if err := s.audit(ctx, args.UserID, model.AuditActionExport); err != nil {
+		return nil, err
+	}
+	trail, err := s.auditRepository.ListAuditEntries(ctx, ports.ListAuditEntriesQuery{UserID: args.UserID})
+	if err != nil {
+		return nil, fmt.Errorf("error listing audit entries: %w", err)
+	}
+
+	user.PasswordHash = ""
+	args.FieldPolicy.Redact(actor, user)
+	for i, entry := range trail.Entries {
+		trail.Entries[i] = entry.Redact(actor)
+	}
+	archive, err := json.Marshal(model.DataExport{
+		GeneratedAt: time.Now().UTC(),
+		User:        *user,
+		AuditTrail:  trail.Entries,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding data export: %w", err)
+	}
+
+	signature, err := s.signer.Sign(ctx, archive)
+	if err != nil {
+		return nil, fmt.Errorf("error signing data export: %w", err)
+	}
+
+	return &model.ExportUserDataResponse{Archive: archive, Signature: *signature}, nil
+}
+
+// EraseUser irreversibly erases the personal data of a user. The data key of the user is destroyed first, making every
+// encrypted copy of the data unreadable (CDC payloads, backups), then the user is replaced by an anonymized tombstone.
+// The key is destroyed even if the user does not exist anymore (e.g. hard-deleted), in which case model.ErrNotFound
+// is returned.
+func (s *UserService) EraseUser(ctx context.Context, args model.EraseUserArgs) error {
+	if err := s.vault.DestroyKey(ctx, args.ID); err != nil {
+		return fmt.Errorf("error destroying user data key: %w", err)
+	}
+	if err := s.repository.EraseUser(ctx, args.ID); err != nil {
+		return fmt.Errorf("error erasing user from repository: %w", err)
+	}
+	return s.audit(ctx, args.ID, model.AuditActionErase)
+}
+
+// ListAuditEntries lists the audit trail of a user, oldest first.
+func (s *UserService) ListAuditEntries(ctx context.Context, args model.ListAuditEntriesArgs) (*model.ListAuditEntriesResponse, error) {
+	res, err := s.auditRepository.ListAuditEntries(ctx, ports.ListAuditEntriesQuery{
+		UserID:         args.UserID,
+		Actions:        args.Actions,
+		ActorID:        args.ActorID,
+		OccurredAfter:  args.OccurredAfter,
+		OccurredBefore: args.OccurredBefore,
+		Limit:          args.Limit,
+		Offset:         args.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing audit entries: %w", err)
+	}
+	return &model.ListAuditEntriesResponse{Entries: res.Entries}, nil
+}
+
+// audit appends an entry to the audit trail of the user, attributing it to the actor and request carried by ctx.
+func (s *UserService) audit(ctx context.Context, userID uuid.UUID, action model.AuditAction, changedFields ...string) error {
+	actor, _ := model.ActorFromContext(ctx)
+	metadata := model.RequestMetadataFromContext(ctx)
+	if err := s.auditRepository.SaveAuditEntry(ctx, &model.AuditEntry{
+		UserID:        userID,
+		ActorID:       actor.ID,
+		Action:        action,
+		RequestID:     metadata.RequestID,
+		SourceIP:      metadata.SourceIP,
+		ChangedFields: changedFields,
+	}); err != nil {
+		return fmt.Errorf("error saving audit entry: %w", err)
+	}
 	return nil
-}
\ No newline at end of file
+}
+
+// changedFields returns the names of the profile fields that differ between before and after.
+func changedFields(before, after model.User) []string {
+	var fields []string
+	if before.FirstName != after.FirstName {
+		fields = append(fields, "first_name")
+	}
+	if before.LastName != after.LastName {
+		fields = append(fields, "last_name")
+	}
+	if before.Nickname != after.Nickname {
+		fields = append(fields, "nickname")
+	}
+	if before.Email != after.Email {
+		fields = append(fields, "email")
+	}
+	if before.PasswordHash != after.PasswordHash {
+		fields = append(fields, "password")
+	}
+	if before.Country != after.Country {
+		fields = append(fields, "country")
+	}
+	if before.ProfileVisibility.OrDefault() != after.ProfileVisibility.OrDefault() {
+		fields = append(fields, "profile_visibility")
+	}
+	fields = append(fields, changedAttributes(before.Attributes, after.Attributes)...)
+	return fields
+}
@@ -0,0 +1,660 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/argon2id"
+	"github.com/google/uuid"
+	"github.com/rbroggi/faceittha/internal/core/model"
+	"github.com/rbroggi/faceittha/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is an in-memory implementation of the Repository interface.
+type MockRepository struct {
+	ports.Repository
+	users map[uuid.UUID]model.User
+}
+
+func (m *MockRepository) GetUser(ctx context.Context, query ports.GetUserQuery) (*model.User, error) {
+	user, ok := m.users[query.ID]
+	if query.Email != "" {
+		ok = false
+		for _, candidate := range m.users {
+			if model.CanonicalEmail(candidate.Email) == model.CanonicalEmail(query.Email) {
+				user, ok = candidate, true
+			}
+		}
+	}
+	if query.Nickname != "" {
+		ok = false
+		for _, candidate := range m.users {
+			if model.NicknameKey(candidate.Nickname) == model.NicknameKey(query.Nickname) {
+				user, ok = candidate, true
+			}
+		}
+	}
+	if !ok || (!query.IncludeDeleted && !user.DeletedAt.IsZero()) {
+		return nil, model.ErrNotFound
+	}
+	return &user, nil
+}
+
+func (m *MockRepository) ListUsers(ctx context.Context, query ports.ListUsersQuery) (*ports.ListUsersResult, error) {
+	var users []model.User
+	for _, user := range m.users {
+		if query.IncludeDeleted || user.DeletedAt.IsZero() {
+			users = append(users, user)
+		}
+	}
+	return &ports.ListUsersResult{Users: users}, nil
+}
+
+func (m *MockRepository) ListUserVersions(ctx context.Context, query ports.ListUserVersionsQuery) (*ports.ListUserVersionsResult, error) {
+	var versions []model.UserVersion
+	if user, ok := m.users[query.UserID]; ok {
+		versions = append(versions, model.UserVersion{User: user, ValidFrom: user.UpdatedAt})
+	}
+	return &ports.ListUserVersionsResult{Versions: versions}, nil
+}
+
+func (m *MockRepository) SaveUser(ctx context.Context, user *model.User) error {
+	m.users[user.ID] = *user
+	return nil
+}
+
+func (m *MockRepository) UpdateUser(ctx context.Context, user *model.User, query ports.UpdateUserQuery) error {
+	existing, ok := m.users[user.ID]
+	if !ok {
+		return model.ErrNotFound
+	}
+	if user.FirstName != "" {
+		existing.FirstName = user.FirstName
+	}
+	if user.LastName != "" {
+		existing.LastName = user.LastName
+	}
+	if user.Nickname != "" {
+		existing.Nickname = user.Nickname
+	}
+	if user.Email != "" {
+		existing.Email = user.Email
+	}
+	if user.PasswordHash != "" {
+		existing.PasswordHash = user.PasswordHash
+	}
+	if user.Country != "" {
+		existing.Country = user.Country
+	}
+	if user.ProfileVisibility != "" {
+		existing.ProfileVisibility = user.ProfileVisibility
+	}
+	if len(user.Attributes) > 0 {
+		attributes := map[string]interface{}{}
+		for key, value := range existing.Attributes {
+			attributes[key] = value
+		}
+		for key, value := range user.Attributes {
+			if value == nil {
+				delete(attributes, key)
+			} else {
+				attributes[key] = value
+			}
+		}
+		existing.Attributes = attributes
+	}
+	m.users[user.ID] = existing
+	*user = existing
+	return nil
+}
+
+func (m *MockRepository) DeleteUser(ctx context.Context, query ports.DeleteUserQuery) error {
+	if query.HardDelete {
+		delete(m.users, query.ID)
+		return nil
+	}
+	if user, ok := m.users[query.ID]; ok {
+		user.DeletedAt = time.Now()
+		user.Status = model.UserStatusDeleted
+		m.users[query.ID] = user
+	}
+	return nil
+}
+
+func (m *MockRepository) RestoreUser(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	user, ok := m.users[id]
+	if !ok || user.DeletedAt.IsZero() {
+		return nil, model.ErrNotFound
+	}
+	user.DeletedAt = time.Time{}
+	user.Status = user.SettledStatus()
+	m.users[id] = user
+	return &user, nil
+}
+
+func (m *MockRepository) VerifyUser(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error {
+	user, ok := m.users[id]
+	if !ok {
+		return model.ErrNotFound
+	}
+	if user.Status != model.UserStatusPendingVerification {
+		return fmt.Errorf("%w: user is %s, not pending verification", model.ErrFailedPrecondition, user.Status)
+	}
+	user.VerifiedAt, user.Status = verifiedAt, model.UserStatusActive
+	m.users[id] = user
+	return nil
+}
+
+func (m *MockRepository) EraseUser(ctx context.Context, id uuid.UUID) error {
+	if _, ok := m.users[id]; !ok {
+		return model.ErrNotFound
+	}
+	m.users[id] = model.User{ID: id}
+	return nil
+}
+
+// MockVault is a mock implementation of the Vault interface.
+type MockVault struct {
+	ports.Vault
+	destroyed []uuid.UUID
+}
+
+func (m *MockVault) DestroyKey(ctx context.Context, userID uuid.UUID) error {
+	m.destroyed = append(m.destroyed, userID)
+	return nil
+}
+
+// MockAuditRepository is an in-memory implementation of the AuditRepository interface.
+type MockAuditRepository struct {
+	entries []model.AuditEntry
+}
+
+func (m *MockAuditRepository) SaveAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	entry.ID = uuid.New()
+	m.entries = append(m.entries, *entry)
+	return nil
+}
+
+func (m *MockAuditRepository) ListAuditEntries(ctx context.Context, query ports.ListAuditEntriesQuery) (*ports.ListAuditEntriesResult, error) {
+	var entries []model.AuditEntry
+	for _, entry := range m.entries {
+		if entry.UserID == query.UserID {
+			entries = append(entries, entry)
+		}
+	}
+	return &ports.ListAuditEntriesResult{Entries: entries}, nil
+}
+
+// MockSigner is a mock implementation of the Signer interface.
+type MockSigner struct{}
+
+func (m *MockSigner) Sign(ctx context.Context, payload []byte) (*model.Signature, error) {
+	return &model.Signature{Algorithm: "mock", KeyID: "key", Value: []byte("signature")}, nil
+}
+
+func TestUserService_ExportUserData(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		userID          uuid.UUID
+		expectedErr     error
+		expectedActions []model.AuditAction
+	}{
+		{
+			name:        "anonymous caller",
+			ctx:         context.Background(),
+			userID:      userID,
+			expectedErr: model.ErrUnauthenticated,
+		},
+		{
+			name:            "another user is denied",
+			ctx:             model.ContextWithActor(context.Background(), model.Actor{ID: uuid.NewString()}),
+			userID:          userID,
+			expectedErr:     model.ErrPermissionDenied,
+			expectedActions: []model.AuditAction{model.AuditActionExportDenied},
+		},
+		{
+			name:            "the user exports their own data",
+			ctx:             model.ContextWithActor(context.Background(), model.Actor{ID: userID.String()}),
+			userID:          userID,
+			expectedActions: []model.AuditAction{model.AuditActionExport},
+		},
+		{
+			name:            "an admin exports the data of a user",
+			ctx:             model.ContextWithActor(context.Background(), model.Actor{ID: "admin", Roles: []model.Role{model.RoleAdmin}}),
+			userID:          userID,
+			expectedActions: []model.AuditAction{model.AuditActionExport},
+		},
+		{
+			name:        "non-existing user",
+			ctx:         model.ContextWithActor(context.Background(), model.Actor{ID: "admin", Roles: []model.Role{model.RoleAdmin}}),
+			userID:      uuid.New(),
+			expectedErr: model.ErrNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			auditRepository := &MockAuditRepository{}
+			svc := NewUserService(UserServiceArgs{
+				Repository: &MockRepository{users: map[uuid.UUID]model.User{
+					userID: {ID: userID, Nickname: "nick", PasswordHash: "hash"},
+				}},
+				AuditRepository: auditRepository,
+				Signer:          &MockSigner{},
+			})
+
+			resp, err := svc.ExportUserData(test.ctx, model.ExportUserDataArgs{UserID: test.userID})
+			var actions []model.AuditAction
+			for _, entry := range auditRepository.entries {
+				actions = append(actions, entry.Action)
+			}
+			assert.Equal(t, test.expectedActions, actions)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var export model.DataExport
+			require.NoError(t, json.Unmarshal(resp.Archive, &export))
+			assert.Equal(t, userID, export.User.ID)
+			assert.Empty(t, export.User.PasswordHash)
+			require.Len(t, export.AuditTrail, 1)
+			assert.Equal(t, model.AuditActionExport, export.AuditTrail[0].Action)
+			assert.Equal(t, []byte("signature"), resp.Signature.Value)
+
+			// the sections of the archive are pinned, there are no consents to export
+			var sections map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(resp.Archive, &sections))
+			var keys []string
+			for key := range sections {
+				keys = append(keys, key)
+			}
+			assert.ElementsMatch(t, []string{"generated_at", "user", "audit_trail"}, keys)
+		})
+	}
+}
+
+func TestUserService_ExportUserDataIsRedacted(t *testing.T) {
+	jane := uuid.New()
+	svc := NewUserService(UserServiceArgs{
+		Repository: &MockRepository{users: map[uuid.UUID]model.User{
+			jane: {ID: jane, FirstName: "Jane", Email: "jane@x.com", Country: "BR", Roles: []model.Role{model.RoleSupport}},
+		}},
+		AuditRepository: &MockAuditRepository{},
+		Signer:          &MockSigner{},
+	})
+	policy := model.FieldPolicy{"email": {model.RoleAdmin}, "country": {model.RoleSupport}}
+
+	// the user is redacted by the policy of the caller before the archive is signed
+	asAdmin := model.ContextWithActor(context.Background(), model.Actor{ID: "admin", Roles: []model.Role{model.RoleAdmin}})
+	resp, err := svc.ExportUserData(asAdmin, model.ExportUserDataArgs{UserID: jane, FieldPolicy: policy})
+	require.NoError(t, err)
+	var export model.DataExport
+	require.NoError(t, json.Unmarshal(resp.Archive, &export))
+	assert.Equal(t, "jane@x.com", export.User.Email)
+	assert.Empty(t, export.User.Country)
+	assert.Equal(t, []string{"country"}, export.User.MaskedFields)
+	assert.Equal(t, []model.Role{model.RoleSupport}, export.User.Roles)
+
+	asJane := model.ContextWithActor(context.Background(), model.Actor{ID: jane.String()})
+	resp, err = svc.ExportUserData(asJane, model.ExportUserDataArgs{UserID: jane, FieldPolicy: policy})
+	require.NoError(t, err)
+	export = model.DataExport{}
+	require.NoError(t, json.Unmarshal(resp.Archive, &export))
+	assert.Equal(t, "BR", export.User.Country)
+	assert.Empty(t, export.User.MaskedFields)
+	assert.Empty(t, export.User.Roles)
+	// the user does not see who else exported their data
+	require.Len(t, export.AuditTrail, 2)
+	assert.Empty(t, export.AuditTrail[0].ActorID)
+	assert.Equal(t, jane.String(), export.AuditTrail[1].ActorID)
+}
+
+func TestUserService_EraseUser(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	admin := model.Actor{ID: "admin", Roles: []model.Role{model.RoleAdmin}}
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		userID          uuid.UUID
+		expectedErr     error
+		expectDestroyed bool
+		expectedActions []model.AuditAction
+	}{
+		{
+			name:            "the user erases their own data",
+			ctx:             model.ContextWithActor(context.Background(), model.Actor{ID: userID.String()}),
+			userID:          userID,
+			expectDestroyed: true,
+			expectedActions: []model.AuditAction{model.AuditActionErase},
+		},
+		{
+			name:            "an admin erases the data of a user",
+			ctx:             model.ContextWithActor(context.Background(), admin),
+			userID:          userID,
+			expectDestroyed: true,
+			expectedActions: []model.AuditAction{model.AuditActionErase},
+		},
+		{
+			name:            "the key of a user that does not exist anymore is destroyed",
+			ctx:             model.ContextWithActor(context.Background(), admin),
+			userID:          uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-5e60a5b5d5de"),
+			expectedErr:     model.ErrNotFound,
+			expectDestroyed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			auditRepository := &MockAuditRepository{}
+			vault := &MockVault{}
+			svc := NewUserService(UserServiceArgs{
+				Repository: &MockRepository{users: map[uuid.UUID]model.User{
+					userID: {ID: userID, Nickname: "nick", PasswordHash: "hash"},
+				}},
+				AuditRepository: auditRepository,
+				Vault:           vault,
+			})
+
+			err := svc.EraseUser(test.ctx, model.EraseUserArgs{ID: test.userID})
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if test.expectDestroyed {
+				assert.Equal(t, []uuid.UUID{test.userID}, vault.destroyed)
+			} else {
+				assert.Empty(t, vault.destroyed)
+			}
+			var actions []model.AuditAction
+			for _, entry := range auditRepository.entries {
+				actions = append(actions, entry.Action)
+			}
+			assert.Equal(t, test.expectedActions, actions)
+		})
+	}
+}
+
+func TestUserService_AuditsMutations(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	ctx := model.ContextWithRequestMetadata(
+		model.ContextWithActor(context.Background(), model.Actor{ID: "admin", Roles: []model.Role{model.RoleAdmin}}),
+		model.RequestMetadata{RequestID: "request-1", SourceIP: "10.0.0.1"},
+	)
+	tests := []struct {
+		name          string
+		existing      *model.User
+		mutate        func(svc *UserService) error
+		expectedEntry model.AuditEntry
+	}{
+		{
+			name: "create",
+			mutate: func(svc *UserService) error {
+				_, err := svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "nick", Email: "e@mail.com", Password: "password"})
+				return err
+			},
+			expectedEntry: model.AuditEntry{
+				Action:        model.AuditActionCreate,
+				ChangedFields: []string{"nickname", "email", "password"},
+			},
+		},
+		{
+			name:     "update records only the fields that changed",
+			existing: &model.User{ID: userID, FirstName: "fn", Nickname: "nick", Country: "IT"},
+			mutate: func(svc *UserService) error {
+				_, err := svc.UpdateUser(ctx, model.UpdateUserArgs{ID: userID, FirstName: "fn2", Nickname: "nick", Country: "FR"})
+				return err
+			},
+			expectedEntry: model.AuditEntry{
+				UserID:        userID,
+				Action:        model.AuditActionUpdate,
+				ChangedFields: []string{"first_name", "country"},
+			},
+		},
+		{
+			name:     "soft-delete",
+			existing: &model.User{ID: userID},
+			mutate: func(svc *UserService) error {
+				return svc.DeleteUser(ctx, model.DeleteUserArgs{ID: userID})
+			},
+			expectedEntry: model.AuditEntry{
+				UserID:        userID,
+				Action:        model.AuditActionDelete,
+				ChangedFields: []string{"deleted_at", "status"},
+			},
+		},
+		{
+			name:     "hard-delete",
+			existing: &model.User{ID: userID},
+			mutate: func(svc *UserService) error {
+				return svc.DeleteUser(ctx, model.DeleteUserArgs{ID: userID, HardDelete: true})
+			},
+			expectedEntry: model.AuditEntry{
+				UserID: userID,
+				Action: model.AuditActionHardDelete,
+			},
+		},
+		{
+			name:     "restore",
+			existing: &model.User{ID: userID, DeletedAt: time.Now()},
+			mutate: func(svc *UserService) error {
+				_, err := svc.RestoreUser(ctx, model.RestoreUserArgs{ID: userID})
+				return err
+			},
+			expectedEntry: model.AuditEntry{
+				UserID:        userID,
+				Action:        model.AuditActionRestore,
+				ChangedFields: []string{"deleted_at", "status"},
+			},
+		},
+		{
+			name:     "verify",
+			existing: &model.User{ID: userID, Status: model.UserStatusPendingVerification},
+			mutate: func(svc *UserService) error {
+				return svc.VerifyUser(ctx, model.VerifyUserArgs{ID: userID})
+			},
+			expectedEntry: model.AuditEntry{
+				UserID:        userID,
+				Action:        model.AuditActionVerify,
+				ChangedFields: []string{"verified_at", "status"},
+			},
+		},
+		{
+			name:     "password change",
+			existing: &model.User{ID: userID, PasswordHash: mustHash(t, "current-password")},
+			mutate: func(svc *UserService) error {
+				return svc.ChangePassword(ctx, model.ChangePasswordArgs{ID: userID, CurrentPassword: "current-password", NewPassword: "new-password"})
+			},
+			expectedEntry: model.AuditEntry{
+				UserID:        userID,
+				Action:        model.AuditActionPasswordChange,
+				ChangedFields: []string{"password"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repository := &MockRepository{users: map[uuid.UUID]model.User{}}
+			if test.existing != nil {
+				repository.users[test.existing.ID] = *test.existing
+			}
+			auditRepository := &MockAuditRepository{}
+			svc := NewUserService(UserServiceArgs{
+				Repository:        repository,
+				AuditRepository:   auditRepository,
+				LockoutRepository: newMockLockoutRepository(),
+			})
+
+			require.NoError(t, test.mutate(svc))
+
+			require.Len(t, auditRepository.entries, 1)
+			got := auditRepository.entries[0]
+			if test.expectedEntry.UserID == uuid.Nil {
+				assert.NotEqual(t, uuid.Nil, got.UserID)
+				test.expectedEntry.UserID = got.UserID
+			}
+			test.expectedEntry.ID = got.ID
+			test.expectedEntry.ActorID = "admin"
+			test.expectedEntry.RequestID = "request-1"
+			test.expectedEntry.SourceIP = "10.0.0.1"
+			assert.Equal(t, test.expectedEntry, got)
+		})
+	}
+}
+
+func TestUserService_Status(t *testing.T) {
+	repository := &MockRepository{users: map[uuid.UUID]model.User{}}
+	svc := NewUserService(UserServiceArgs{
+		Repository:        repository,
+		AuditRepository:   &MockAuditRepository{},
+		LockoutRepository: newMockLockoutRepository(),
+	})
+	ctx := context.Background()
+
+	resp, err := svc.CreateUser(ctx, model.CreateUserArgs{Nickname: "nick", Email: "e@mail.com", Password: "password"})
+	require.NoError(t, err)
+	userID := resp.User.ID
+	assert.Equal(t, model.UserStatusPendingVerification, repository.users[userID].Status)
+
+	require.NoError(t, svc.VerifyUser(ctx, model.VerifyUserArgs{ID: userID}))
+	assert.Equal(t, model.UserStatusActive, repository.users[userID].Status)
+	assert.False(t, repository.users[userID].VerifiedAt.IsZero())
+	require.ErrorIs(t, svc.VerifyUser(ctx, model.VerifyUserArgs{ID: userID}), model.ErrFailedPrecondition)
+	require.ErrorIs(t, svc.VerifyUser(ctx, model.VerifyUserArgs{ID: uuid.New()}), model.ErrNotFound)
+
+	// restored users settle back as verified
+	require.NoError(t, svc.DeleteUser(ctx, model.DeleteUserArgs{ID: userID}))
+	assert.Equal(t, model.UserStatusDeleted, repository.users[userID].Status)
+	restored, err := svc.RestoreUser(ctx, model.RestoreUserArgs{ID: userID})
+	require.NoError(t, err)
+	assert.Equal(t, model.UserStatusActive, restored.User.Status)
+
+	_, err = svc.ListUsers(ctx, model.ListUsersArgs{Statuses: []model.UserStatus{"banned"}})
+	require.ErrorIs(t, err, model.ErrInvalidArgument)
+}
+
+func TestUserService_ChangePassword(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	repository := &MockRepository{users: map[uuid.UUID]model.User{
+		userID: {ID: userID, PasswordHash: mustHash(t, "current-password")},
+	}}
+	auditRepository := &MockAuditRepository{}
+	svc := NewUserService(UserServiceArgs{
+		Repository:        repository,
+		AuditRepository:   auditRepository,
+		LockoutRepository: newMockLockoutRepository(),
+	})
+
+	err := svc.ChangePassword(context.Background(), model.ChangePasswordArgs{ID: userID, CurrentPassword: "wrong-password", NewPassword: "new-password"})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+	assert.Empty(t, auditRepository.entries)
+
+	err = svc.ChangePassword(context.Background(), model.ChangePasswordArgs{ID: uuid.New(), CurrentPassword: "current-password", NewPassword: "new-password"})
+	require.ErrorIs(t, err, model.ErrNotFound)
+
+	require.NoError(t, svc.ChangePassword(context.Background(), model.ChangePasswordArgs{ID: userID, CurrentPassword: "current-password", NewPassword: "new-password"}))
+	match, err := argon2id.ComparePasswordAndHash("new-password", repository.users[userID].PasswordHash)
+	require.NoError(t, err)
+	assert.True(t, match)
+}
+
+func TestUserService_ListAuditEntries(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	auditRepository := &MockAuditRepository{entries: []model.AuditEntry{
+		{ID: uuid.New(), UserID: userID, Action: model.AuditActionCreate},
+		{ID: uuid.New(), UserID: uuid.New(), Action: model.AuditActionCreate},
+	}}
+	svc := NewUserService(UserServiceArgs{AuditRepository: auditRepository})
+
+	resp, err := svc.ListAuditEntries(context.Background(), model.ListAuditEntriesArgs{UserID: userID})
+	require.NoError(t, err)
+	assert.Equal(t, auditRepository.entries[:1], resp.Entries)
+}
+
+func TestUserService_GetUser(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	deletedUserID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-5e60a5b5d5de")
+	deletedAt := time.Now()
+	tests := []struct {
+		name         string
+		args         model.GetUserArgs
+		expectedUser model.User
+		expectedErr  error
+	}{
+		{
+			name:         "current state",
+			args:         model.GetUserArgs{ID: userID},
+			expectedUser: model.User{ID: userID, Nickname: "nick"},
+		},
+		{
+			name:         "past version",
+			args:         model.GetUserArgs{ID: userID, AsOf: time.Now()},
+			expectedUser: model.User{ID: userID, Nickname: "nick"},
+		},
+		{
+			name:        "deleted user",
+			args:        model.GetUserArgs{ID: deletedUserID},
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			name:         "deleted user including deleted",
+			args:         model.GetUserArgs{ID: deletedUserID, IncludeDeleted: true},
+			expectedUser: model.User{ID: deletedUserID, DeletedAt: deletedAt},
+		},
+		{
+			name:        "non-existing user",
+			args:        model.GetUserArgs{ID: uuid.New()},
+			expectedErr: model.ErrNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := NewUserService(UserServiceArgs{
+				Repository: &MockRepository{users: map[uuid.UUID]model.User{
+					userID:        {ID: userID, Nickname: "nick"},
+					deletedUserID: {ID: deletedUserID, DeletedAt: deletedAt},
+				}},
+			})
+
+			ctx := model.ContextWithActor(context.Background(), model.Actor{ID: "backoffice", Roles: []model.Role{model.RoleSupport}})
+			resp, err := svc.GetUser(ctx, test.args)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedUser, resp.User)
+		})
+	}
+}
+
+func TestUserService_GetUserHistory(t *testing.T) {
+	userID := uuid.MustParse("3b3e9e2a-13d5-4a68-b5c5-8e60a5b5d5de")
+	svc := NewUserService(UserServiceArgs{
+		Repository: &MockRepository{users: map[uuid.UUID]model.User{
+			userID: {ID: userID, Nickname: "nick"},
+		}},
+	})
+
+	ctx := model.ContextWithActor(context.Background(), model.Actor{ID: userID.String()})
+	resp, err := svc.GetUserHistory(ctx, model.GetUserHistoryArgs{UserID: userID})
+	require.NoError(t, err)
+	assert.Equal(t, []model.UserVersion{{User: model.User{ID: userID, Nickname: "nick"}}}, resp.Versions)
+}
+
+func mustHash(t *testing.T, password string) string {
+	hash, err := argon2id.CreateHash(password, argon2id.DefaultParams)
+	require.NoError(t, err)
+	return hash
+}
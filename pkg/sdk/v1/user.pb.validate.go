@@ -185,6 +185,130 @@ func (m *User) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TotpEnabled
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for SuspensionReason
+
+	if all {
+		switch v := interface{}(m.GetSuspendedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "SuspendedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "SuspendedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSuspendedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "SuspendedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSuspendedUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "SuspendedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "SuspendedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSuspendedUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "SuspendedUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletionScheduledFor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeletionScheduledFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeletionScheduledFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletionScheduledFor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "DeletionScheduledFor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ProfileVisibility
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}
@@ -660,6 +784,138 @@ func (m *UserEvent) validate(all bool) error {
 
 	}
 
+	if m.Erasure != nil {
+
+		if all {
+			switch v := interface{}(m.GetErasure()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "Erasure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "Erasure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetErasure()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserEventValidationError{
+					field:  "Erasure",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Security != nil {
+
+		if all {
+			switch v := interface{}(m.GetSecurity()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "Security",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "Security",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSecurity()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserEventValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Friendship != nil {
+
+		if all {
+			switch v := interface{}(m.GetFriendship()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "Friendship",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "Friendship",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFriendship()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserEventValidationError{
+					field:  "Friendship",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.AccountDeletion != nil {
+
+		if all {
+			switch v := interface{}(m.GetAccountDeletion()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "AccountDeletion",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserEventValidationError{
+						field:  "AccountDeletion",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccountDeletion()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserEventValidationError{
+					field:  "AccountDeletion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UserEventMultiError(errors)
 	}
@@ -737,159 +993,338 @@ var _ interface {
 	ErrorName() string
 } = UserEventValidationError{}
 
-// Validate checks the field values on CreateUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateUserRequest) Validate() error {
+// Validate checks the field values on UserErasure with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserErasure) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateUserRequestMultiError, or nil if none found.
-func (m *CreateUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on UserErasure with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserErasureMultiError, or
+// nil if none found.
+func (m *UserErasure) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateUserRequest) validate(all bool) error {
+func (m *UserErasure) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetFirstName()) > 256 {
-		err := CreateUserRequestValidationError{
-			field:  "FirstName",
-			reason: "value length must be at most 256 bytes",
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetErasedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserErasureValidationError{
+					field:  "ErasedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserErasureValidationError{
+					field:  "ErasedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetErasedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserErasureValidationError{
+				field:  "ErasedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if len(m.GetLastName()) > 256 {
-		err := CreateUserRequestValidationError{
-			field:  "LastName",
-			reason: "value length must be at most 256 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return UserErasureMultiError(errors)
 	}
 
-	if len(m.GetNickname()) > 256 {
-		err := CreateUserRequestValidationError{
-			field:  "Nickname",
-			reason: "value length must be at most 256 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// UserErasureMultiError is an error wrapping multiple validation errors
+// returned by UserErasure.ValidateAll() if the designated constraints aren't met.
+type UserErasureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserErasureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if l := utf8.RuneCountInString(m.GetPassword()); l < 8 || l > 20 {
-		err := CreateUserRequestValidationError{
-			field:  "Password",
-			reason: "value length must be between 8 and 20 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+// AllErrors returns a list of validation violation errors.
+func (m UserErasureMultiError) AllErrors() []error { return m }
+
+// UserErasureValidationError is the validation error returned by
+// UserErasure.Validate if the designated constraints aren't met.
+type UserErasureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserErasureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserErasureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserErasureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserErasureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserErasureValidationError) ErrorName() string { return "UserErasureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserErasureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = CreateUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if _, ok := _CreateUserRequest_Country_InLookup[m.GetCountry()]; !ok {
-		err := CreateUserRequestValidationError{
-			field:  "Country",
-			reason: "value must be in list [AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW]",
+	return fmt.Sprintf(
+		"invalid %sUserErasure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserErasureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserErasureValidationError{}
+
+// Validate checks the field values on SecurityEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecurityEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecurityEventMultiError, or
+// nil if none found.
+func (m *SecurityEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetLockedUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecurityEventValidationError{
+					field:  "LockedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecurityEventValidationError{
+					field:  "LockedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetLockedUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecurityEventValidationError{
+				field:  "LockedUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateUserRequestMultiError(errors)
+		return SecurityEventMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *CreateUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
+// SecurityEventMultiError is an error wrapping multiple validation errors
+// returned by SecurityEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SecurityEventMultiError []error
 
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecurityEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
+// AllErrors returns a list of validation violation errors.
+func (m SecurityEventMultiError) AllErrors() []error { return m }
 
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+// SecurityEventValidationError is the validation error returned by
+// SecurityEvent.Validate if the designated constraints aren't met.
+type SecurityEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+// Field function returns field value.
+func (e SecurityEventValidationError) Field() string { return e.field }
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+// Reason function returns reason value.
+func (e SecurityEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityEventValidationError) ErrorName() string { return "SecurityEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecurityEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	return nil
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
 }
 
-func (m *CreateUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
+var _ error = SecurityEventValidationError{}
 
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityEventValidationError{}
+
+// Validate checks the field values on AccountDeletionEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccountDeletionEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountDeletionEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccountDeletionEventMultiError, or nil if none found.
+func (m *AccountDeletionEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountDeletionEvent) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	var errors []error
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	// no validation rules for UserId
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetScheduledFor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountDeletionEventValidationError{
+					field:  "ScheduledFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountDeletionEventValidationError{
+					field:  "ScheduledFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledFor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountDeletionEventValidationError{
+				field:  "ScheduledFor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	return m._validateHostname(parts[1])
+	if len(errors) > 0 {
+		return AccountDeletionEventMultiError(errors)
+	}
+
+	return nil
 }
 
-// CreateUserRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateUserRequestMultiError []error
+// AccountDeletionEventMultiError is an error wrapping multiple validation
+// errors returned by AccountDeletionEvent.ValidateAll() if the designated
+// constraints aren't met.
+type AccountDeletionEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateUserRequestMultiError) Error() string {
+func (m AccountDeletionEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -898,11 +1333,11 @@ func (m CreateUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateUserRequestMultiError) AllErrors() []error { return m }
+func (m AccountDeletionEventMultiError) AllErrors() []error { return m }
 
-// CreateUserRequestValidationError is the validation error returned by
-// CreateUserRequest.Validate if the designated constraints aren't met.
-type CreateUserRequestValidationError struct {
+// AccountDeletionEventValidationError is the validation error returned by
+// AccountDeletionEvent.Validate if the designated constraints aren't met.
+type AccountDeletionEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -910,24 +1345,24 @@ type CreateUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateUserRequestValidationError) Field() string { return e.field }
+func (e AccountDeletionEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateUserRequestValidationError) Reason() string { return e.reason }
+func (e AccountDeletionEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateUserRequestValidationError) Cause() error { return e.cause }
+func (e AccountDeletionEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateUserRequestValidationError) Key() bool { return e.key }
+func (e AccountDeletionEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateUserRequestValidationError) ErrorName() string {
-	return "CreateUserRequestValidationError"
+func (e AccountDeletionEventValidationError) ErrorName() string {
+	return "AccountDeletionEventValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateUserRequestValidationError) Error() string {
+func (e AccountDeletionEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -939,14 +1374,14 @@ func (e CreateUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateUserRequest.%s: %s%s",
+		"invalid %sAccountDeletionEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateUserRequestValidationError{}
+var _ error = AccountDeletionEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -954,325 +1389,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateUserRequestValidationError{}
+} = AccountDeletionEventValidationError{}
 
-var _CreateUserRequest_Country_InLookup = map[string]struct{}{
-	"AD": {},
-	"AE": {},
-	"AF": {},
-	"AG": {},
-	"AI": {},
-	"AL": {},
-	"AM": {},
-	"AO": {},
-	"AQ": {},
-	"AR": {},
-	"AS": {},
-	"AT": {},
-	"AU": {},
-	"AW": {},
-	"AX": {},
-	"AZ": {},
-	"BA": {},
-	"BB": {},
-	"BD": {},
-	"BE": {},
-	"BF": {},
-	"BG": {},
-	"BH": {},
-	"BI": {},
-	"BJ": {},
-	"BL": {},
-	"BM": {},
-	"BN": {},
-	"BO": {},
-	"BQ": {},
-	"BR": {},
-	"BS": {},
-	"BT": {},
-	"BV": {},
-	"BW": {},
-	"BY": {},
-	"BZ": {},
-	"CA": {},
-	"CC": {},
-	"CD": {},
-	"CF": {},
-	"CG": {},
-	"CH": {},
-	"CI": {},
-	"CK": {},
-	"CL": {},
-	"CM": {},
-	"CN": {},
-	"CO": {},
-	"CR": {},
-	"CU": {},
-	"CV": {},
-	"CW": {},
-	"CX": {},
-	"CY": {},
-	"CZ": {},
-	"DE": {},
-	"DJ": {},
-	"DK": {},
-	"DM": {},
-	"DO": {},
-	"DZ": {},
-	"EC": {},
-	"EE": {},
-	"EG": {},
-	"EH": {},
-	"ER": {},
-	"ES": {},
-	"ET": {},
-	"FI": {},
-	"FJ": {},
-	"FK": {},
-	"FM": {},
-	"FO": {},
-	"FR": {},
-	"GA": {},
-	"GB": {},
-	"GD": {},
-	"GE": {},
-	"GF": {},
-	"GG": {},
-	"GH": {},
-	"GI": {},
-	"GL": {},
-	"GM": {},
-	"GN": {},
-	"GP": {},
-	"GQ": {},
-	"GR": {},
-	"GS": {},
-	"GT": {},
-	"GU": {},
-	"GW": {},
-	"GY": {},
-	"HK": {},
-	"HM": {},
-	"HN": {},
-	"HR": {},
-	"HT": {},
-	"HU": {},
-	"ID": {},
-	"IE": {},
-	"IL": {},
-	"IM": {},
-	"IN": {},
-	"IO": {},
-	"IQ": {},
-	"IR": {},
-	"IS": {},
-	"IT": {},
-	"JE": {},
-	"JM": {},
-	"JO": {},
-	"JP": {},
-	"KE": {},
-	"KG": {},
-	"KH": {},
-	"KI": {},
-	"KM": {},
-	"KN": {},
-	"KP": {},
-	"KR": {},
-	"KW": {},
-	"KY": {},
-	"KZ": {},
-	"LA": {},
-	"LB": {},
-	"LC": {},
-	"LI": {},
-	"LK": {},
-	"LR": {},
-	"LS": {},
-	"LT": {},
-	"LU": {},
-	"LV": {},
-	"LY": {},
-	"MA": {},
-	"MC": {},
-	"MD": {},
-	"ME": {},
-	"MF": {},
-	"MG": {},
-	"MH": {},
-	"MK": {},
-	"ML": {},
-	"MM": {},
-	"MN": {},
-	"MO": {},
-	"MP": {},
-	"MQ": {},
-	"MR": {},
-	"MS": {},
-	"MT": {},
-	"MU": {},
-	"MV": {},
-	"MW": {},
-	"MX": {},
-	"MY": {},
-	"MZ": {},
-	"NA": {},
-	"NC": {},
-	"NE": {},
-	"NF": {},
-	"NG": {},
-	"NI": {},
-	"NL": {},
-	"NO": {},
-	"NP": {},
-	"NR": {},
-	"NU": {},
-	"NZ": {},
-	"OM": {},
-	"PA": {},
-	"PE": {},
-	"PF": {},
-	"PG": {},
-	"PH": {},
-	"PK": {},
-	"PL": {},
-	"PM": {},
-	"PN": {},
-	"PR": {},
-	"PS": {},
-	"PT": {},
-	"PW": {},
-	"PY": {},
-	"QA": {},
-	"RE": {},
-	"RO": {},
-	"RS": {},
-	"RU": {},
-	"RW": {},
-	"SA": {},
-	"SB": {},
-	"SC": {},
-	"SD": {},
-	"SE": {},
-	"SG": {},
-	"SH": {},
-	"SI": {},
-	"SJ": {},
-	"SK": {},
-	"SL": {},
-	"SM": {},
-	"SN": {},
-	"SO": {},
-	"SR": {},
-	"SS": {},
-	"ST": {},
-	"SV": {},
-	"SX": {},
-	"SY": {},
-	"SZ": {},
-	"TC": {},
-	"TD": {},
-	"TF": {},
-	"TG": {},
-	"TH": {},
-	"TJ": {},
-	"TK": {},
-	"TL": {},
-	"TM": {},
-	"TN": {},
-	"TO": {},
-	"TR": {},
-	"TT": {},
-	"TV": {},
-	"TW": {},
-	"TZ": {},
-	"UA": {},
-	"UG": {},
-	"UM": {},
-	"US": {},
-	"UY": {},
-	"UZ": {},
-	"VA": {},
-	"VC": {},
-	"VE": {},
-	"VG": {},
-	"VI": {},
-	"VN": {},
-	"VU": {},
-	"WF": {},
-	"WS": {},
-	"YE": {},
-	"YT": {},
-	"ZA": {},
-	"ZM": {},
-	"ZW": {},
-}
-
-// Validate checks the field values on CreateUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateUserResponse) Validate() error {
+// Validate checks the field values on FriendshipEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FriendshipEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateUserResponse with the rules
+// ValidateAll checks the field values on FriendshipEvent with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateUserResponseMultiError, or nil if none found.
-func (m *CreateUserResponse) ValidateAll() error {
+// FriendshipEventMultiError, or nil if none found.
+func (m *FriendshipEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateUserResponse) validate(all bool) error {
+func (m *FriendshipEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Type
+
+	// no validation rules for RequesterId
+
+	// no validation rules for AddresseeId
 
 	if len(errors) > 0 {
-		return CreateUserResponseMultiError(errors)
+		return FriendshipEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateUserResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateUserResponse.ValidateAll() if the designated constraints
+// FriendshipEventMultiError is an error wrapping multiple validation errors
+// returned by FriendshipEvent.ValidateAll() if the designated constraints
 // aren't met.
-type CreateUserResponseMultiError []error
+type FriendshipEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateUserResponseMultiError) Error() string {
+func (m FriendshipEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1281,11 +1441,11 @@ func (m CreateUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateUserResponseMultiError) AllErrors() []error { return m }
+func (m FriendshipEventMultiError) AllErrors() []error { return m }
 
-// CreateUserResponseValidationError is the validation error returned by
-// CreateUserResponse.Validate if the designated constraints aren't met.
-type CreateUserResponseValidationError struct {
+// FriendshipEventValidationError is the validation error returned by
+// FriendshipEvent.Validate if the designated constraints aren't met.
+type FriendshipEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1293,24 +1453,22 @@ type CreateUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateUserResponseValidationError) Field() string { return e.field }
+func (e FriendshipEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateUserResponseValidationError) Reason() string { return e.reason }
+func (e FriendshipEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateUserResponseValidationError) Cause() error { return e.cause }
+func (e FriendshipEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateUserResponseValidationError) Key() bool { return e.key }
+func (e FriendshipEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateUserResponseValidationError) ErrorName() string {
-	return "CreateUserResponseValidationError"
-}
+func (e FriendshipEventValidationError) ErrorName() string { return "FriendshipEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateUserResponseValidationError) Error() string {
+func (e FriendshipEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1322,14 +1480,14 @@ func (e CreateUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateUserResponse.%s: %s%s",
+		"invalid %sFriendshipEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateUserResponseValidationError{}
+var _ error = FriendshipEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1337,35 +1495,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateUserResponseValidationError{}
+} = FriendshipEventValidationError{}
 
-// Validate checks the field values on UpdateUserRequest with the rules defined
+// Validate checks the field values on CreateUserRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserRequest) Validate() error {
+func (m *CreateUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserRequest with the rules
+// ValidateAll checks the field values on CreateUserRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateUserRequestMultiError, or nil if none found.
-func (m *UpdateUserRequest) ValidateAll() error {
+// CreateUserRequestMultiError, or nil if none found.
+func (m *CreateUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserRequest) validate(all bool) error {
+func (m *CreateUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetId()); err != nil {
-		err = UpdateUserRequestValidationError{
-			field:  "Id",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if len(m.GetFirstName()) > 256 {
+		err := CreateUserRequestValidationError{
+			field:  "FirstName",
+			reason: "value length must be at most 256 bytes",
 		}
 		if !all {
 			return err
@@ -1373,90 +1530,72 @@ func (m *UpdateUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetFirstName() != "" {
-
-		if len(m.GetFirstName()) > 256 {
-			err := UpdateUserRequestValidationError{
-				field:  "FirstName",
-				reason: "value length must be at most 256 bytes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if len(m.GetLastName()) > 256 {
+		err := CreateUserRequestValidationError{
+			field:  "LastName",
+			reason: "value length must be at most 256 bytes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.GetLastName() != "" {
-
-		if len(m.GetLastName()) > 256 {
-			err := UpdateUserRequestValidationError{
-				field:  "LastName",
-				reason: "value length must be at most 256 bytes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if len(m.GetNickname()) > 256 {
+		err := CreateUserRequestValidationError{
+			field:  "Nickname",
+			reason: "value length must be at most 256 bytes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.GetNickname() != "" {
-
-		if len(m.GetNickname()) > 256 {
-			err := UpdateUserRequestValidationError{
-				field:  "Nickname",
-				reason: "value length must be at most 256 bytes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 8 || l > 20 {
+		err := CreateUserRequestValidationError{
+			field:  "Password",
+			reason: "value length must be between 8 and 20 runes, inclusive",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.GetEmail() != "" {
-
-		if err := m._validateEmail(m.GetEmail()); err != nil {
-			err = UpdateUserRequestValidationError{
-				field:  "Email",
-				reason: "value must be a valid email address",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = CreateUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.GetCountry() != "" {
-
-		if _, ok := _UpdateUserRequest_Country_InLookup[m.GetCountry()]; !ok {
-			err := UpdateUserRequestValidationError{
-				field:  "Country",
-				reason: "value must be in list [AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW]",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if _, ok := _CreateUserRequest_Country_InLookup[m.GetCountry()]; !ok {
+		err := CreateUserRequestValidationError{
+			field:  "Country",
+			reason: "value must be in list [AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW]",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for NicknameReservationToken
+
 	if len(errors) > 0 {
-		return UpdateUserRequestMultiError(errors)
+		return CreateUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *UpdateUserRequest) _validateHostname(host string) error {
+func (m *CreateUserRequest) _validateHostname(host string) error {
 	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
 	if len(host) > 253 {
@@ -1486,7 +1625,7 @@ func (m *UpdateUserRequest) _validateHostname(host string) error {
 	return nil
 }
 
-func (m *UpdateUserRequest) _validateEmail(addr string) error {
+func (m *CreateUserRequest) _validateEmail(addr string) error {
 	a, err := mail.ParseAddress(addr)
 	if err != nil {
 		return err
@@ -1506,21 +1645,13 @@ func (m *UpdateUserRequest) _validateEmail(addr string) error {
 	return m._validateHostname(parts[1])
 }
 
-func (m *UpdateUserRequest) _validateUuid(uuid string) error {
-	if matched := _user_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
-	}
-
-	return nil
-}
-
-// UpdateUserRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateUserRequest.ValidateAll() if the designated constraints
+// CreateUserRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateUserRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UpdateUserRequestMultiError []error
+type CreateUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserRequestMultiError) Error() string {
+func (m CreateUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1529,11 +1660,11 @@ func (m UpdateUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserRequestMultiError) AllErrors() []error { return m }
+func (m CreateUserRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUserRequestValidationError is the validation error returned by
-// UpdateUserRequest.Validate if the designated constraints aren't met.
-type UpdateUserRequestValidationError struct {
+// CreateUserRequestValidationError is the validation error returned by
+// CreateUserRequest.Validate if the designated constraints aren't met.
+type CreateUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1541,24 +1672,24 @@ type UpdateUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserRequestValidationError) Field() string { return e.field }
+func (e CreateUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserRequestValidationError) Reason() string { return e.reason }
+func (e CreateUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserRequestValidationError) Cause() error { return e.cause }
+func (e CreateUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserRequestValidationError) Key() bool { return e.key }
+func (e CreateUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserRequestValidationError) ErrorName() string {
-	return "UpdateUserRequestValidationError"
+func (e CreateUserRequestValidationError) ErrorName() string {
+	return "CreateUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserRequestValidationError) Error() string {
+func (e CreateUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1570,14 +1701,14 @@ func (e UpdateUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserRequest.%s: %s%s",
+		"invalid %sCreateUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserRequestValidationError{}
+var _ error = CreateUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1585,9 +1716,9 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserRequestValidationError{}
+} = CreateUserRequestValidationError{}
 
-var _UpdateUserRequest_Country_InLookup = map[string]struct{}{
+var _CreateUserRequest_Country_InLookup = map[string]struct{}{
 	"AD": {},
 	"AE": {},
 	"AF": {},
@@ -1839,71 +1970,12239 @@ var _UpdateUserRequest_Country_InLookup = map[string]struct{}{
 	"ZW": {},
 }
 
-// Validate checks the field values on UpdateUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUserResponseMultiError, or nil if none found.
+func (m *CreateUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUserResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserResponseMultiError) AllErrors() []error { return m }
+
+// CreateUserResponseValidationError is the validation error returned by
+// CreateUserResponse.Validate if the designated constraints aren't met.
+type CreateUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUserResponseValidationError) ErrorName() string {
+	return "CreateUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUserResponseValidationError{}
+
+// Validate checks the field values on UpdateUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserRequestMultiError, or nil if none found.
+func (m *UpdateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFirstName() != "" {
+
+		if len(m.GetFirstName()) > 256 {
+			err := UpdateUserRequestValidationError{
+				field:  "FirstName",
+				reason: "value length must be at most 256 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetLastName() != "" {
+
+		if len(m.GetLastName()) > 256 {
+			err := UpdateUserRequestValidationError{
+				field:  "LastName",
+				reason: "value length must be at most 256 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetNickname() != "" {
+
+		if len(m.GetNickname()) > 256 {
+			err := UpdateUserRequestValidationError{
+				field:  "Nickname",
+				reason: "value length must be at most 256 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEmail() != "" {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = UpdateUserRequestValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetCountry() != "" {
+
+		if _, ok := _UpdateUserRequest_Country_InLookup[m.GetCountry()]; !ok {
+			err := UpdateUserRequestValidationError{
+				field:  "Country",
+				reason: "value must be in list [AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetProfileVisibility() != "" {
+
+		if _, ok := _UpdateUserRequest_ProfileVisibility_InLookup[m.GetProfileVisibility()]; !ok {
+			err := UpdateUserRequestValidationError{
+				field:  "ProfileVisibility",
+				reason: "value must be in list [public friends private]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+func (m *UpdateUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUserRequestValidationError is the validation error returned by
+// UpdateUserRequest.Validate if the designated constraints aren't met.
+type UpdateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserRequestValidationError) ErrorName() string {
+	return "UpdateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserRequestValidationError{}
+
+var _UpdateUserRequest_Country_InLookup = map[string]struct{}{
+	"AD": {},
+	"AE": {},
+	"AF": {},
+	"AG": {},
+	"AI": {},
+	"AL": {},
+	"AM": {},
+	"AO": {},
+	"AQ": {},
+	"AR": {},
+	"AS": {},
+	"AT": {},
+	"AU": {},
+	"AW": {},
+	"AX": {},
+	"AZ": {},
+	"BA": {},
+	"BB": {},
+	"BD": {},
+	"BE": {},
+	"BF": {},
+	"BG": {},
+	"BH": {},
+	"BI": {},
+	"BJ": {},
+	"BL": {},
+	"BM": {},
+	"BN": {},
+	"BO": {},
+	"BQ": {},
+	"BR": {},
+	"BS": {},
+	"BT": {},
+	"BV": {},
+	"BW": {},
+	"BY": {},
+	"BZ": {},
+	"CA": {},
+	"CC": {},
+	"CD": {},
+	"CF": {},
+	"CG": {},
+	"CH": {},
+	"CI": {},
+	"CK": {},
+	"CL": {},
+	"CM": {},
+	"CN": {},
+	"CO": {},
+	"CR": {},
+	"CU": {},
+	"CV": {},
+	"CW": {},
+	"CX": {},
+	"CY": {},
+	"CZ": {},
+	"DE": {},
+	"DJ": {},
+	"DK": {},
+	"DM": {},
+	"DO": {},
+	"DZ": {},
+	"EC": {},
+	"EE": {},
+	"EG": {},
+	"EH": {},
+	"ER": {},
+	"ES": {},
+	"ET": {},
+	"FI": {},
+	"FJ": {},
+	"FK": {},
+	"FM": {},
+	"FO": {},
+	"FR": {},
+	"GA": {},
+	"GB": {},
+	"GD": {},
+	"GE": {},
+	"GF": {},
+	"GG": {},
+	"GH": {},
+	"GI": {},
+	"GL": {},
+	"GM": {},
+	"GN": {},
+	"GP": {},
+	"GQ": {},
+	"GR": {},
+	"GS": {},
+	"GT": {},
+	"GU": {},
+	"GW": {},
+	"GY": {},
+	"HK": {},
+	"HM": {},
+	"HN": {},
+	"HR": {},
+	"HT": {},
+	"HU": {},
+	"ID": {},
+	"IE": {},
+	"IL": {},
+	"IM": {},
+	"IN": {},
+	"IO": {},
+	"IQ": {},
+	"IR": {},
+	"IS": {},
+	"IT": {},
+	"JE": {},
+	"JM": {},
+	"JO": {},
+	"JP": {},
+	"KE": {},
+	"KG": {},
+	"KH": {},
+	"KI": {},
+	"KM": {},
+	"KN": {},
+	"KP": {},
+	"KR": {},
+	"KW": {},
+	"KY": {},
+	"KZ": {},
+	"LA": {},
+	"LB": {},
+	"LC": {},
+	"LI": {},
+	"LK": {},
+	"LR": {},
+	"LS": {},
+	"LT": {},
+	"LU": {},
+	"LV": {},
+	"LY": {},
+	"MA": {},
+	"MC": {},
+	"MD": {},
+	"ME": {},
+	"MF": {},
+	"MG": {},
+	"MH": {},
+	"MK": {},
+	"ML": {},
+	"MM": {},
+	"MN": {},
+	"MO": {},
+	"MP": {},
+	"MQ": {},
+	"MR": {},
+	"MS": {},
+	"MT": {},
+	"MU": {},
+	"MV": {},
+	"MW": {},
+	"MX": {},
+	"MY": {},
+	"MZ": {},
+	"NA": {},
+	"NC": {},
+	"NE": {},
+	"NF": {},
+	"NG": {},
+	"NI": {},
+	"NL": {},
+	"NO": {},
+	"NP": {},
+	"NR": {},
+	"NU": {},
+	"NZ": {},
+	"OM": {},
+	"PA": {},
+	"PE": {},
+	"PF": {},
+	"PG": {},
+	"PH": {},
+	"PK": {},
+	"PL": {},
+	"PM": {},
+	"PN": {},
+	"PR": {},
+	"PS": {},
+	"PT": {},
+	"PW": {},
+	"PY": {},
+	"QA": {},
+	"RE": {},
+	"RO": {},
+	"RS": {},
+	"RU": {},
+	"RW": {},
+	"SA": {},
+	"SB": {},
+	"SC": {},
+	"SD": {},
+	"SE": {},
+	"SG": {},
+	"SH": {},
+	"SI": {},
+	"SJ": {},
+	"SK": {},
+	"SL": {},
+	"SM": {},
+	"SN": {},
+	"SO": {},
+	"SR": {},
+	"SS": {},
+	"ST": {},
+	"SV": {},
+	"SX": {},
+	"SY": {},
+	"SZ": {},
+	"TC": {},
+	"TD": {},
+	"TF": {},
+	"TG": {},
+	"TH": {},
+	"TJ": {},
+	"TK": {},
+	"TL": {},
+	"TM": {},
+	"TN": {},
+	"TO": {},
+	"TR": {},
+	"TT": {},
+	"TV": {},
+	"TW": {},
+	"TZ": {},
+	"UA": {},
+	"UG": {},
+	"UM": {},
+	"US": {},
+	"UY": {},
+	"UZ": {},
+	"VA": {},
+	"VC": {},
+	"VE": {},
+	"VG": {},
+	"VI": {},
+	"VN": {},
+	"VU": {},
+	"WF": {},
+	"WS": {},
+	"YE": {},
+	"YT": {},
+	"ZA": {},
+	"ZM": {},
+	"ZW": {},
+}
+
+var _UpdateUserRequest_ProfileVisibility_InLookup = map[string]struct{}{
+	"public":  {},
+	"friends": {},
+	"private": {},
+}
+
+// Validate checks the field values on UpdateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserResponseMultiError, or nil if none found.
+func (m *UpdateUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserResponseMultiError) AllErrors() []error { return m }
+
+// UpdateUserResponseValidationError is the validation error returned by
+// UpdateUserResponse.Validate if the designated constraints aren't met.
+type UpdateUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserResponseValidationError) ErrorName() string {
+	return "UpdateUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserResponseValidationError{}
+
+// Validate checks the field values on RemoveUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RemoveUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveUserRequestMultiError, or nil if none found.
+func (m *RemoveUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RemoveUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HardDelete
+
+	if len(errors) > 0 {
+		return RemoveUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveUserRequestMultiError is an error wrapping multiple validation errors
+// returned by RemoveUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RemoveUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUserRequestMultiError) AllErrors() []error { return m }
+
+// RemoveUserRequestValidationError is the validation error returned by
+// RemoveUserRequest.Validate if the designated constraints aren't met.
+type RemoveUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUserRequestValidationError) ErrorName() string {
+	return "RemoveUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUserRequestValidationError{}
+
+// Validate checks the field values on RemoveUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveUserResponseMultiError, or nil if none found.
+func (m *RemoveUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveUserResponseMultiError is an error wrapping multiple validation errors
+// returned by RemoveUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RemoveUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUserResponseMultiError) AllErrors() []error { return m }
+
+// RemoveUserResponseValidationError is the validation error returned by
+// RemoveUserResponse.Validate if the designated constraints aren't met.
+type RemoveUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUserResponseValidationError) ErrorName() string {
+	return "RemoveUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUserResponseValidationError{}
+
+// Validate checks the field values on ListUsersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersRequestMultiError, or nil if none found.
+func (m *ListUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	_ListUsersRequest_Countries_Unique := make(map[string]struct{}, len(m.GetCountries()))
+
+	for idx, item := range m.GetCountries() {
+		_, _ = idx, item
+
+		if _, exists := _ListUsersRequest_Countries_Unique[item]; exists {
+			err := ListUsersRequestValidationError{
+				field:  fmt.Sprintf("Countries[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ListUsersRequest_Countries_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Countries[idx]
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUsersRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUsersRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUsersRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUsersRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUsersRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUsersRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ShowDeleted
+
+	// no validation rules for Attributes
+
+	_ListUsersRequest_Statuses_Unique := make(map[string]struct{}, len(m.GetStatuses()))
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if _, exists := _ListUsersRequest_Statuses_Unique[item]; exists {
+			err := ListUsersRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ListUsersRequest_Statuses_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _ListUsersRequest_Statuses_InLookup[item]; !ok {
+			err := ListUsersRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must be in list [pending_verification active suspended scheduled_for_deletion deleted]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListUsersRequestValidationError is the validation error returned by
+// ListUsersRequest.Validate if the designated constraints aren't met.
+type ListUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersRequestValidationError) ErrorName() string { return "ListUsersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersRequestValidationError{}
+
+var _ListUsersRequest_Statuses_InLookup = map[string]struct{}{
+	"pending_verification":   {},
+	"active":                 {},
+	"suspended":              {},
+	"scheduled_for_deletion": {},
+	"deleted":                {},
+}
+
+// Validate checks the field values on ListUsersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersResponseMultiError, or nil if none found.
+func (m *ListUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListUsersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListUsersResponseValidationError is the validation error returned by
+// ListUsersResponse.Validate if the designated constraints aren't met.
+type ListUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersResponseValidationError) ErrorName() string {
+	return "ListUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersResponseValidationError{}
+
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserRequestValidationError{
+				field:  "AsOf",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ShowDeleted
+
+	if len(errors) > 0 {
+		return GetUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
+
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRequestValidationError{}
+
+// Validate checks the field values on GetUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserResponseMultiError, or nil if none found.
+func (m *GetUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserResponseMultiError) AllErrors() []error { return m }
+
+// GetUserResponseValidationError is the validation error returned by
+// GetUserResponse.Validate if the designated constraints aren't met.
+type GetUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserResponseValidationError{}
+
+// Validate checks the field values on UserVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserVersionMultiError, or
+// nil if none found.
+func (m *UserVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserVersionValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserVersionValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserVersionValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserVersionValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserVersionValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserVersionValidationError{
+				field:  "ValidFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserVersionValidationError{
+					field:  "ValidTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserVersionValidationError{
+					field:  "ValidTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserVersionValidationError{
+				field:  "ValidTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserVersionMultiError is an error wrapping multiple validation errors
+// returned by UserVersion.ValidateAll() if the designated constraints aren't met.
+type UserVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserVersionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserVersionMultiError) AllErrors() []error { return m }
+
+// UserVersionValidationError is the validation error returned by
+// UserVersion.Validate if the designated constraints aren't met.
+type UserVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserVersionValidationError) ErrorName() string { return "UserVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserVersionValidationError{}
+
+// Validate checks the field values on GetUserHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserHistoryRequestMultiError, or nil if none found.
+func (m *GetUserHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetUserHistoryRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return GetUserHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUserHistoryRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetUserHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetUserHistoryRequestValidationError is the validation error returned by
+// GetUserHistoryRequest.Validate if the designated constraints aren't met.
+type GetUserHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserHistoryRequestValidationError) ErrorName() string {
+	return "GetUserHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserHistoryRequestValidationError{}
+
+// Validate checks the field values on GetUserHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserHistoryResponseMultiError, or nil if none found.
+func (m *GetUserHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserHistoryResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserHistoryResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserHistoryResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetUserHistoryResponseValidationError is the validation error returned by
+// GetUserHistoryResponse.Validate if the designated constraints aren't met.
+type GetUserHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserHistoryResponseValidationError) ErrorName() string {
+	return "GetUserHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserHistoryResponseValidationError{}
+
+// Validate checks the field values on ExportMyDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMyDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMyDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMyDataRequestMultiError, or nil if none found.
+func (m *ExportMyDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMyDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ExportMyDataRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportMyDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ExportMyDataRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ExportMyDataRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportMyDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportMyDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMyDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMyDataRequestMultiError) AllErrors() []error { return m }
+
+// ExportMyDataRequestValidationError is the validation error returned by
+// ExportMyDataRequest.Validate if the designated constraints aren't met.
+type ExportMyDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMyDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMyDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMyDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMyDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMyDataRequestValidationError) ErrorName() string {
+	return "ExportMyDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMyDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMyDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMyDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMyDataRequestValidationError{}
+
+// Validate checks the field values on ExportMyDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMyDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMyDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMyDataResponseMultiError, or nil if none found.
+func (m *ExportMyDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMyDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Archive
+
+	// no validation rules for Signature
+
+	// no validation rules for SignatureAlgorithm
+
+	// no validation rules for KeyId
+
+	if len(errors) > 0 {
+		return ExportMyDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMyDataResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportMyDataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportMyDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMyDataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMyDataResponseMultiError) AllErrors() []error { return m }
+
+// ExportMyDataResponseValidationError is the validation error returned by
+// ExportMyDataResponse.Validate if the designated constraints aren't met.
+type ExportMyDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMyDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMyDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMyDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMyDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMyDataResponseValidationError) ErrorName() string {
+	return "ExportMyDataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMyDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMyDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMyDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMyDataResponseValidationError{}
+
+// Validate checks the field values on EraseUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EraseUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUserRequestMultiError, or nil if none found.
+func (m *EraseUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = EraseUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EraseUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EraseUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// EraseUserRequestMultiError is an error wrapping multiple validation errors
+// returned by EraseUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EraseUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserRequestMultiError) AllErrors() []error { return m }
+
+// EraseUserRequestValidationError is the validation error returned by
+// EraseUserRequest.Validate if the designated constraints aren't met.
+type EraseUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserRequestValidationError) ErrorName() string { return "EraseUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EraseUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserRequestValidationError{}
+
+// Validate checks the field values on EraseUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EraseUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUserResponseMultiError, or nil if none found.
+func (m *EraseUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EraseUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUserResponseMultiError is an error wrapping multiple validation errors
+// returned by EraseUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EraseUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserResponseMultiError) AllErrors() []error { return m }
+
+// EraseUserResponseValidationError is the validation error returned by
+// EraseUserResponse.Validate if the designated constraints aren't met.
+type EraseUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserResponseValidationError) ErrorName() string {
+	return "EraseUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserResponseValidationError{}
+
+// Validate checks the field values on RestoreUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserRequestMultiError, or nil if none found.
+func (m *RestoreUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RestoreUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreUserRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserRequestMultiError) AllErrors() []error { return m }
+
+// RestoreUserRequestValidationError is the validation error returned by
+// RestoreUserRequest.Validate if the designated constraints aren't met.
+type RestoreUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreUserRequestValidationError) ErrorName() string {
+	return "RestoreUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreUserRequestValidationError{}
+
+// Validate checks the field values on RestoreUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserResponseMultiError, or nil if none found.
+func (m *RestoreUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreUserResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserResponseMultiError) AllErrors() []error { return m }
+
+// RestoreUserResponseValidationError is the validation error returned by
+// RestoreUserResponse.Validate if the designated constraints aren't met.
+type RestoreUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreUserResponseValidationError) ErrorName() string {
+	return "RestoreUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreUserResponseValidationError{}
+
+// Validate checks the field values on UnlockUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnlockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockUserRequestMultiError, or nil if none found.
+func (m *UnlockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UnlockUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnlockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnlockUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnlockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UnlockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockUserRequestMultiError) AllErrors() []error { return m }
+
+// UnlockUserRequestValidationError is the validation error returned by
+// UnlockUserRequest.Validate if the designated constraints aren't met.
+type UnlockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockUserRequestValidationError) ErrorName() string {
+	return "UnlockUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockUserRequestValidationError{}
+
+// Validate checks the field values on UnlockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlockUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockUserResponseMultiError, or nil if none found.
+func (m *UnlockUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnlockUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockUserResponseMultiError is an error wrapping multiple validation errors
+// returned by UnlockUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockUserResponseMultiError) AllErrors() []error { return m }
+
+// UnlockUserResponseValidationError is the validation error returned by
+// UnlockUserResponse.Validate if the designated constraints aren't met.
+type UnlockUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockUserResponseValidationError) ErrorName() string {
+	return "UnlockUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockUserResponseValidationError{}
+
+// Validate checks the field values on VerifyUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyUserRequestMultiError, or nil if none found.
+func (m *VerifyUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = VerifyUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VerifyUserRequestMultiError is an error wrapping multiple validation errors
+// returned by VerifyUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyUserRequestMultiError) AllErrors() []error { return m }
+
+// VerifyUserRequestValidationError is the validation error returned by
+// VerifyUserRequest.Validate if the designated constraints aren't met.
+type VerifyUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyUserRequestValidationError) ErrorName() string {
+	return "VerifyUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyUserRequestValidationError{}
+
+// Validate checks the field values on VerifyUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyUserResponseMultiError, or nil if none found.
+func (m *VerifyUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyUserResponseMultiError is an error wrapping multiple validation errors
+// returned by VerifyUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyUserResponseMultiError) AllErrors() []error { return m }
+
+// VerifyUserResponseValidationError is the validation error returned by
+// VerifyUserResponse.Validate if the designated constraints aren't met.
+type VerifyUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyUserResponseValidationError) ErrorName() string {
+	return "VerifyUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyUserResponseValidationError{}
+
+// Validate checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordRequestMultiError, or nil if none found.
+func (m *ChangePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ChangePasswordRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCurrentPassword()) < 1 {
+		err := ChangePasswordRequestValidationError{
+			field:  "CurrentPassword",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 8 || l > 20 {
+		err := ChangePasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 8 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ChangePasswordRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ChangePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordRequestMultiError) AllErrors() []error { return m }
+
+// ChangePasswordRequestValidationError is the validation error returned by
+// ChangePasswordRequest.Validate if the designated constraints aren't met.
+type ChangePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordRequestValidationError) ErrorName() string {
+	return "ChangePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordRequestValidationError{}
+
+// Validate checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordResponseMultiError, or nil if none found.
+func (m *ChangePasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ChangePasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordResponseMultiError) AllErrors() []error { return m }
+
+// ChangePasswordResponseValidationError is the validation error returned by
+// ChangePasswordResponse.Validate if the designated constraints aren't met.
+type ChangePasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordResponseValidationError) ErrorName() string {
+	return "ChangePasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordResponseValidationError{}
+
+// Validate checks the field values on AuditEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEntryMultiError, or
+// nil if none found.
+func (m *AuditEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for ActorId
+
+	// no validation rules for Action
+
+	// no validation rules for RequestId
+
+	// no validation rules for SourceIp
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEntryValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEntryValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEntryValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEntryMultiError is an error wrapping multiple validation errors
+// returned by AuditEntry.ValidateAll() if the designated constraints aren't met.
+type AuditEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEntryMultiError) AllErrors() []error { return m }
+
+// AuditEntryValidationError is the validation error returned by
+// AuditEntry.Validate if the designated constraints aren't met.
+type AuditEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEntryValidationError) ErrorName() string { return "AuditEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEntryValidationError{}
+
+// Validate checks the field values on ListUserAuditEntriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserAuditEntriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserAuditEntriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserAuditEntriesRequestMultiError, or nil if none found.
+func (m *ListUserAuditEntriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserAuditEntriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListUserAuditEntriesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	_ListUserAuditEntriesRequest_Actions_Unique := make(map[string]struct{}, len(m.GetActions()))
+
+	for idx, item := range m.GetActions() {
+		_, _ = idx, item
+
+		if _, exists := _ListUserAuditEntriesRequest_Actions_Unique[item]; exists {
+			err := ListUserAuditEntriesRequestValidationError{
+				field:  fmt.Sprintf("Actions[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ListUserAuditEntriesRequest_Actions_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _ListUserAuditEntriesRequest_Actions_InLookup[item]; !ok {
+			err := ListUserAuditEntriesRequestValidationError{
+				field:  fmt.Sprintf("Actions[%v]", idx),
+				reason: "value must be in list [create update delete hard_delete restore password_change export export_denied erase lock unlock revoke_session enable_totp disable_totp grant_role revoke_role suspend lift_suspension verify request_deletion cancel_deletion expire_suspension block unblock]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for ActorId
+
+	if all {
+		switch v := interface{}(m.GetOccurredAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUserAuditEntriesRequestValidationError{
+					field:  "OccurredAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUserAuditEntriesRequestValidationError{
+					field:  "OccurredAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUserAuditEntriesRequestValidationError{
+				field:  "OccurredAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOccurredBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUserAuditEntriesRequestValidationError{
+					field:  "OccurredBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUserAuditEntriesRequestValidationError{
+					field:  "OccurredBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUserAuditEntriesRequestValidationError{
+				field:  "OccurredBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListUserAuditEntriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListUserAuditEntriesRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListUserAuditEntriesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListUserAuditEntriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListUserAuditEntriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserAuditEntriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserAuditEntriesRequestMultiError) AllErrors() []error { return m }
+
+// ListUserAuditEntriesRequestValidationError is the validation error returned
+// by ListUserAuditEntriesRequest.Validate if the designated constraints
+// aren't met.
+type ListUserAuditEntriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserAuditEntriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserAuditEntriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserAuditEntriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserAuditEntriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserAuditEntriesRequestValidationError) ErrorName() string {
+	return "ListUserAuditEntriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserAuditEntriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserAuditEntriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserAuditEntriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserAuditEntriesRequestValidationError{}
+
+var _ListUserAuditEntriesRequest_Actions_InLookup = map[string]struct{}{
+	"create":            {},
+	"update":            {},
+	"delete":            {},
+	"hard_delete":       {},
+	"restore":           {},
+	"password_change":   {},
+	"export":            {},
+	"export_denied":     {},
+	"erase":             {},
+	"lock":              {},
+	"unlock":            {},
+	"revoke_session":    {},
+	"enable_totp":       {},
+	"disable_totp":      {},
+	"grant_role":        {},
+	"revoke_role":       {},
+	"suspend":           {},
+	"lift_suspension":   {},
+	"verify":            {},
+	"request_deletion":  {},
+	"cancel_deletion":   {},
+	"expire_suspension": {},
+	"block":             {},
+	"unblock":           {},
+}
+
+// Validate checks the field values on ListUserAuditEntriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserAuditEntriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserAuditEntriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserAuditEntriesResponseMultiError, or nil if none found.
+func (m *ListUserAuditEntriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserAuditEntriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserAuditEntriesResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserAuditEntriesResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserAuditEntriesResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUserAuditEntriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserAuditEntriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListUserAuditEntriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListUserAuditEntriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserAuditEntriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserAuditEntriesResponseMultiError) AllErrors() []error { return m }
+
+// ListUserAuditEntriesResponseValidationError is the validation error returned
+// by ListUserAuditEntriesResponse.Validate if the designated constraints
+// aren't met.
+type ListUserAuditEntriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserAuditEntriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserAuditEntriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserAuditEntriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserAuditEntriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserAuditEntriesResponseValidationError) ErrorName() string {
+	return "ListUserAuditEntriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserAuditEntriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserAuditEntriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserAuditEntriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserAuditEntriesResponseValidationError{}
+
+// Validate checks the field values on SessionTokens with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SessionTokens) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SessionTokens with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SessionTokensMultiError, or
+// nil if none found.
+func (m *SessionTokens) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SessionTokens) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	// no validation rules for AccessToken
+
+	if all {
+		switch v := interface{}(m.GetAccessTokenExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionTokensValidationError{
+					field:  "AccessTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionTokensValidationError{
+					field:  "AccessTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccessTokenExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionTokensValidationError{
+				field:  "AccessTokenExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RefreshToken
+
+	if all {
+		switch v := interface{}(m.GetRefreshTokenExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionTokensValidationError{
+					field:  "RefreshTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionTokensValidationError{
+					field:  "RefreshTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshTokenExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionTokensValidationError{
+				field:  "RefreshTokenExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SessionTokensMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionTokensMultiError is an error wrapping multiple validation errors
+// returned by SessionTokens.ValidateAll() if the designated constraints
+// aren't met.
+type SessionTokensMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionTokensMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionTokensMultiError) AllErrors() []error { return m }
+
+// SessionTokensValidationError is the validation error returned by
+// SessionTokens.Validate if the designated constraints aren't met.
+type SessionTokensValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionTokensValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionTokensValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionTokensValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionTokensValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionTokensValidationError) ErrorName() string { return "SessionTokensValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionTokensValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessionTokens.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionTokensValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionTokensValidationError{}
+
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Session) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Session) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserAgent
+
+	// no validation rules for SourceIp
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefreshedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "RefreshedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "RefreshedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "RefreshedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionMultiError) AllErrors() []error { return m }
+
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionValidationError{}
+
+// Validate checks the field values on LoginRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginRequestMultiError, or
+// nil if none found.
+func (m *LoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = LoginRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := LoginRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *LoginRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *LoginRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// LoginRequestMultiError is an error wrapping multiple validation errors
+// returned by LoginRequest.ValidateAll() if the designated constraints aren't met.
+type LoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginRequestMultiError) AllErrors() []error { return m }
+
+// LoginRequestValidationError is the validation error returned by
+// LoginRequest.Validate if the designated constraints aren't met.
+type LoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginRequestValidationError) ErrorName() string { return "LoginRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginRequestValidationError{}
+
+// Validate checks the field values on LoginResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginResponseMultiError, or
+// nil if none found.
+func (m *LoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTokens()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "Tokens",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "Tokens",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTokens()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoginResponseValidationError{
+				field:  "Tokens",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MfaChallenge
+
+	if all {
+		switch v := interface{}(m.GetMfaChallengeExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "MfaChallengeExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "MfaChallengeExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMfaChallengeExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoginResponseValidationError{
+				field:  "MfaChallengeExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginResponseMultiError is an error wrapping multiple validation errors
+// returned by LoginResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginResponseMultiError) AllErrors() []error { return m }
+
+// LoginResponseValidationError is the validation error returned by
+// LoginResponse.Validate if the designated constraints aren't met.
+type LoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginResponseValidationError) ErrorName() string { return "LoginResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginResponseValidationError{}
+
+// Validate checks the field values on CompleteLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteLoginRequestMultiError, or nil if none found.
+func (m *CompleteLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMfaChallenge()) < 1 {
+		err := CompleteLoginRequestValidationError{
+			field:  "MfaChallenge",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := CompleteLoginRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CompleteLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by CompleteLoginRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CompleteLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteLoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteLoginRequestMultiError) AllErrors() []error { return m }
+
+// CompleteLoginRequestValidationError is the validation error returned by
+// CompleteLoginRequest.Validate if the designated constraints aren't met.
+type CompleteLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteLoginRequestValidationError) ErrorName() string {
+	return "CompleteLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteLoginRequestValidationError{}
+
+// Validate checks the field values on CompleteLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteLoginResponseMultiError, or nil if none found.
+func (m *CompleteLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTokens()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompleteLoginResponseValidationError{
+					field:  "Tokens",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompleteLoginResponseValidationError{
+					field:  "Tokens",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTokens()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompleteLoginResponseValidationError{
+				field:  "Tokens",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CompleteLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by CompleteLoginResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CompleteLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteLoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteLoginResponseMultiError) AllErrors() []error { return m }
+
+// CompleteLoginResponseValidationError is the validation error returned by
+// CompleteLoginResponse.Validate if the designated constraints aren't met.
+type CompleteLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteLoginResponseValidationError) ErrorName() string {
+	return "CompleteLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteLoginResponseValidationError{}
+
+// Validate checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionRequestMultiError, or nil if none found.
+func (m *RefreshSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 1 {
+		err := RefreshSessionRequestValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefreshSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionRequestMultiError) AllErrors() []error { return m }
+
+// RefreshSessionRequestValidationError is the validation error returned by
+// RefreshSessionRequest.Validate if the designated constraints aren't met.
+type RefreshSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionRequestValidationError) ErrorName() string {
+	return "RefreshSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionRequestValidationError{}
+
+// Validate checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionResponseMultiError, or nil if none found.
+func (m *RefreshSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTokens()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshSessionResponseValidationError{
+					field:  "Tokens",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefreshSessionResponseValidationError{
+					field:  "Tokens",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTokens()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshSessionResponseValidationError{
+				field:  "Tokens",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RefreshSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionResponseMultiError) AllErrors() []error { return m }
+
+// RefreshSessionResponseValidationError is the validation error returned by
+// RefreshSessionResponse.Validate if the designated constraints aren't met.
+type RefreshSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionResponseValidationError) ErrorName() string {
+	return "RefreshSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionResponseValidationError{}
+
+// Validate checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsRequestMultiError, or nil if none found.
+func (m *ListSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListSessionsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListSessionsRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsRequestMultiError) AllErrors() []error { return m }
+
+// ListSessionsRequestValidationError is the validation error returned by
+// ListSessionsRequest.Validate if the designated constraints aren't met.
+type ListSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsRequestValidationError) ErrorName() string {
+	return "ListSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsRequestValidationError{}
+
+// Validate checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsResponseMultiError, or nil if none found.
+func (m *ListSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSessionsResponseValidationError is the validation error returned by
+// ListSessionsResponse.Validate if the designated constraints aren't met.
+type ListSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsResponseValidationError) ErrorName() string {
+	return "ListSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsResponseValidationError{}
+
+// Validate checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionRequestMultiError, or nil if none found.
+func (m *RevokeSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RevokeSessionRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetSessionId()); err != nil {
+		err = RevokeSessionRequestValidationError{
+			field:  "SessionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeSessionRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionRequestValidationError is the validation error returned by
+// RevokeSessionRequest.Validate if the designated constraints aren't met.
+type RevokeSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionRequestValidationError) ErrorName() string {
+	return "RevokeSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionRequestValidationError{}
+
+// Validate checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionResponseMultiError, or nil if none found.
+func (m *RevokeSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionResponseMultiError) AllErrors() []error { return m }
+
+// RevokeSessionResponseValidationError is the validation error returned by
+// RevokeSessionResponse.Validate if the designated constraints aren't met.
+type RevokeSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionResponseValidationError) ErrorName() string {
+	return "RevokeSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionResponseValidationError{}
+
+// Validate checks the field values on EnrollTOTPRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTOTPRequestMultiError, or nil if none found.
+func (m *EnrollTOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = EnrollTOTPRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EnrollTOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EnrollTOTPRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// EnrollTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTPRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTPRequestMultiError) AllErrors() []error { return m }
+
+// EnrollTOTPRequestValidationError is the validation error returned by
+// EnrollTOTPRequest.Validate if the designated constraints aren't met.
+type EnrollTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTPRequestValidationError) ErrorName() string {
+	return "EnrollTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTPRequestValidationError{}
+
+// Validate checks the field values on EnrollTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTOTPResponseMultiError, or nil if none found.
+func (m *EnrollTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for Uri
+
+	if len(errors) > 0 {
+		return EnrollTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTOTPResponseMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTPResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTPResponseMultiError) AllErrors() []error { return m }
+
+// EnrollTOTPResponseValidationError is the validation error returned by
+// EnrollTOTPResponse.Validate if the designated constraints aren't met.
+type EnrollTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTPResponseValidationError) ErrorName() string {
+	return "EnrollTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTPResponseValidationError{}
+
+// Validate checks the field values on ConfirmTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTPRequestMultiError, or nil if none found.
+func (m *ConfirmTOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ConfirmTOTPRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) != 6 {
+		err := ConfirmTOTPRequestValidationError{
+			field:  "Code",
+			reason: "value length must be 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return ConfirmTOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ConfirmTOTPRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ConfirmTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by ConfirmTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmTOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPRequestValidationError is the validation error returned by
+// ConfirmTOTPRequest.Validate if the designated constraints aren't met.
+type ConfirmTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPRequestValidationError) ErrorName() string {
+	return "ConfirmTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPRequestValidationError{}
+
+// Validate checks the field values on ConfirmTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTPResponseMultiError, or nil if none found.
+func (m *ConfirmTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTPResponseMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTOTPResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPResponseValidationError is the validation error returned by
+// ConfirmTOTPResponse.Validate if the designated constraints aren't met.
+type ConfirmTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPResponseValidationError) ErrorName() string {
+	return "ConfirmTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPResponseValidationError{}
+
+// Validate checks the field values on DisableTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTOTPRequestMultiError, or nil if none found.
+func (m *DisableTOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = DisableTOTPRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return DisableTOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DisableTOTPRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DisableTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by DisableTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DisableTOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTOTPRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTOTPRequestMultiError) AllErrors() []error { return m }
+
+// DisableTOTPRequestValidationError is the validation error returned by
+// DisableTOTPRequest.Validate if the designated constraints aren't met.
+type DisableTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTOTPRequestValidationError) ErrorName() string {
+	return "DisableTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTOTPRequestValidationError{}
+
+// Validate checks the field values on DisableTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTOTPResponseMultiError, or nil if none found.
+func (m *DisableTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTOTPResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableTOTPResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTOTPResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTOTPResponseMultiError) AllErrors() []error { return m }
+
+// DisableTOTPResponseValidationError is the validation error returned by
+// DisableTOTPResponse.Validate if the designated constraints aren't met.
+type DisableTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTOTPResponseValidationError) ErrorName() string {
+	return "DisableTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTOTPResponseValidationError{}
+
+// Validate checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ApiKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ApiKeyMultiError, or nil if none found.
+func (m *ApiKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyMultiError is an error wrapping multiple validation errors returned by
+// ApiKey.ValidateAll() if the designated constraints aren't met.
+type ApiKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyMultiError) AllErrors() []error { return m }
+
+// ApiKeyValidationError is the validation error returned by ApiKey.Validate if
+// the designated constraints aren't met.
+type ApiKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyValidationError) ErrorName() string { return "ApiKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApiKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyValidationError{}
+
+// Validate checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyRequestMultiError, or nil if none found.
+func (m *CreateAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateAPIKeyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetScopes()) < 1 {
+		err := CreateAPIKeyRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateAPIKeyRequest_Scopes_Unique := make(map[string]struct{}, len(m.GetScopes()))
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if _, exists := _CreateAPIKeyRequest_Scopes_Unique[item]; exists {
+			err := CreateAPIKeyRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateAPIKeyRequest_Scopes_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _CreateAPIKeyRequest_Scopes_InLookup[item]; !ok {
+			err := CreateAPIKeyRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value must be in list [support signup block_check suspension verification admin]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if t := m.GetExpiresAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = CreateAPIKeyRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) <= 0 {
+				err := CreateAPIKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "value must be greater than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyRequestValidationError is the validation error returned by
+// CreateAPIKeyRequest.Validate if the designated constraints aren't met.
+type CreateAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyRequestValidationError) ErrorName() string {
+	return "CreateAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyRequestValidationError{}
+
+var _CreateAPIKeyRequest_Scopes_InLookup = map[string]struct{}{
+	"support":      {},
+	"signup":       {},
+	"block_check":  {},
+	"suspension":   {},
+	"verification": {},
+	"admin":        {},
+}
+
+// Validate checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyResponseMultiError, or nil if none found.
+func (m *CreateAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CreateAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyResponseValidationError is the validation error returned by
+// CreateAPIKeyResponse.Validate if the designated constraints aren't met.
+type CreateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyResponseValidationError) ErrorName() string {
+	return "CreateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on ListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAPIKeysRequestMultiError, or nil if none found.
+func (m *ListAPIKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListAPIKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAPIKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAPIKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAPIKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysRequestValidationError is the validation error returned by
+// ListAPIKeysRequest.Validate if the designated constraints aren't met.
+type ListAPIKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysRequestValidationError) ErrorName() string {
+	return "ListAPIKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysRequestValidationError{}
+
+// Validate checks the field values on ListAPIKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAPIKeysResponseMultiError, or nil if none found.
+func (m *ListAPIKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApiKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("ApiKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("ApiKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAPIKeysResponseValidationError{
+					field:  fmt.Sprintf("ApiKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAPIKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAPIKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAPIKeysResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAPIKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysResponseValidationError is the validation error returned by
+// ListAPIKeysResponse.Validate if the designated constraints aren't met.
+type ListAPIKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysResponseValidationError) ErrorName() string {
+	return "ListAPIKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysResponseValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyRequestMultiError, or nil if none found.
+func (m *RevokeAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RevokeAPIKeyRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeAPIKeyRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKeyRequestValidationError is the validation error returned by
+// RevokeAPIKeyRequest.Validate if the designated constraints aren't met.
+type RevokeAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyRequestValidationError) ErrorName() string {
+	return "RevokeAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyRequestValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyResponseMultiError, or nil if none found.
+func (m *RevokeAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKeyResponseValidationError is the validation error returned by
+// RevokeAPIKeyResponse.Validate if the designated constraints aren't met.
+type RevokeAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyResponseValidationError) ErrorName() string {
+	return "RevokeAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyResponseValidationError{}
+
+// Validate checks the field values on GrantRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GrantRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GrantRoleRequestMultiError, or nil if none found.
+func (m *GrantRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GrantRoleRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GrantRoleRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := GrantRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [support admin]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GrantRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GrantRoleRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GrantRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by GrantRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GrantRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantRoleRequestMultiError) AllErrors() []error { return m }
+
+// GrantRoleRequestValidationError is the validation error returned by
+// GrantRoleRequest.Validate if the designated constraints aren't met.
+type GrantRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantRoleRequestValidationError) ErrorName() string { return "GrantRoleRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GrantRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantRoleRequestValidationError{}
+
+var _GrantRoleRequest_Role_InLookup = map[string]struct{}{
+	"support": {},
+	"admin":   {},
+}
+
+// Validate checks the field values on GrantRoleResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GrantRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GrantRoleResponseMultiError, or nil if none found.
+func (m *GrantRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GrantRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GrantRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by GrantRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GrantRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantRoleResponseMultiError) AllErrors() []error { return m }
+
+// GrantRoleResponseValidationError is the validation error returned by
+// GrantRoleResponse.Validate if the designated constraints aren't met.
+type GrantRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantRoleResponseValidationError) ErrorName() string {
+	return "GrantRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantRoleResponseValidationError{}
+
+// Validate checks the field values on RevokeRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RevokeRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeRoleRequestMultiError, or nil if none found.
+func (m *RevokeRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RevokeRoleRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _RevokeRoleRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := RevokeRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [support admin]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeRoleRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by RevokeRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRoleRequestMultiError) AllErrors() []error { return m }
+
+// RevokeRoleRequestValidationError is the validation error returned by
+// RevokeRoleRequest.Validate if the designated constraints aren't met.
+type RevokeRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRoleRequestValidationError) ErrorName() string {
+	return "RevokeRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRoleRequestValidationError{}
+
+var _RevokeRoleRequest_Role_InLookup = map[string]struct{}{
+	"support": {},
+	"admin":   {},
+}
+
+// Validate checks the field values on RevokeRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeRoleResponseMultiError, or nil if none found.
+func (m *RevokeRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by RevokeRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRoleResponseMultiError) AllErrors() []error { return m }
+
+// RevokeRoleResponseValidationError is the validation error returned by
+// RevokeRoleResponse.Validate if the designated constraints aren't met.
+type RevokeRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRoleResponseValidationError) ErrorName() string {
+	return "RevokeRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRoleResponseValidationError{}
+
+// Validate checks the field values on Friendship with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Friendship) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Friendship with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FriendshipMultiError, or
+// nil if none found.
+func (m *Friendship) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Friendship) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequesterId
+
+	// no validation rules for AddresseeId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FriendshipValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FriendshipValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FriendshipValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAcceptedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FriendshipValidationError{
+					field:  "AcceptedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FriendshipValidationError{
+					field:  "AcceptedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcceptedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FriendshipValidationError{
+				field:  "AcceptedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FriendshipMultiError(errors)
+	}
+
+	return nil
+}
+
+// FriendshipMultiError is an error wrapping multiple validation errors
+// returned by Friendship.ValidateAll() if the designated constraints aren't met.
+type FriendshipMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FriendshipMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FriendshipMultiError) AllErrors() []error { return m }
+
+// FriendshipValidationError is the validation error returned by
+// Friendship.Validate if the designated constraints aren't met.
+type FriendshipValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FriendshipValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FriendshipValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FriendshipValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FriendshipValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FriendshipValidationError) ErrorName() string { return "FriendshipValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FriendshipValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFriendship.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FriendshipValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FriendshipValidationError{}
+
+// Validate checks the field values on RequestFriendshipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestFriendshipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestFriendshipRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestFriendshipRequestMultiError, or nil if none found.
+func (m *RequestFriendshipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestFriendshipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RequestFriendshipRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetFriendId()); err != nil {
+		err = RequestFriendshipRequestValidationError{
+			field:  "FriendId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestFriendshipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RequestFriendshipRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RequestFriendshipRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestFriendshipRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestFriendshipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestFriendshipRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestFriendshipRequestMultiError) AllErrors() []error { return m }
+
+// RequestFriendshipRequestValidationError is the validation error returned by
+// RequestFriendshipRequest.Validate if the designated constraints aren't met.
+type RequestFriendshipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestFriendshipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestFriendshipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestFriendshipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestFriendshipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestFriendshipRequestValidationError) ErrorName() string {
+	return "RequestFriendshipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestFriendshipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestFriendshipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestFriendshipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestFriendshipRequestValidationError{}
+
+// Validate checks the field values on RequestFriendshipResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestFriendshipResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestFriendshipResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestFriendshipResponseMultiError, or nil if none found.
+func (m *RequestFriendshipResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestFriendshipResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFriendship()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestFriendshipResponseValidationError{
+					field:  "Friendship",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestFriendshipResponseValidationError{
+					field:  "Friendship",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFriendship()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestFriendshipResponseValidationError{
+				field:  "Friendship",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestFriendshipResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestFriendshipResponseMultiError is an error wrapping multiple validation
+// errors returned by RequestFriendshipResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RequestFriendshipResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestFriendshipResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestFriendshipResponseMultiError) AllErrors() []error { return m }
+
+// RequestFriendshipResponseValidationError is the validation error returned by
+// RequestFriendshipResponse.Validate if the designated constraints aren't met.
+type RequestFriendshipResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestFriendshipResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestFriendshipResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestFriendshipResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestFriendshipResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestFriendshipResponseValidationError) ErrorName() string {
+	return "RequestFriendshipResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestFriendshipResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestFriendshipResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestFriendshipResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestFriendshipResponseValidationError{}
+
+// Validate checks the field values on AcceptFriendshipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptFriendshipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptFriendshipRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptFriendshipRequestMultiError, or nil if none found.
+func (m *AcceptFriendshipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptFriendshipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = AcceptFriendshipRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetFriendId()); err != nil {
+		err = AcceptFriendshipRequestValidationError{
+			field:  "FriendId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AcceptFriendshipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AcceptFriendshipRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AcceptFriendshipRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptFriendshipRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptFriendshipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptFriendshipRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptFriendshipRequestMultiError) AllErrors() []error { return m }
+
+// AcceptFriendshipRequestValidationError is the validation error returned by
+// AcceptFriendshipRequest.Validate if the designated constraints aren't met.
+type AcceptFriendshipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptFriendshipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptFriendshipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptFriendshipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptFriendshipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptFriendshipRequestValidationError) ErrorName() string {
+	return "AcceptFriendshipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptFriendshipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptFriendshipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptFriendshipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptFriendshipRequestValidationError{}
+
+// Validate checks the field values on AcceptFriendshipResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptFriendshipResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptFriendshipResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptFriendshipResponseMultiError, or nil if none found.
+func (m *AcceptFriendshipResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptFriendshipResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AcceptFriendshipResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptFriendshipResponseMultiError is an error wrapping multiple validation
+// errors returned by AcceptFriendshipResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptFriendshipResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptFriendshipResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptFriendshipResponseMultiError) AllErrors() []error { return m }
+
+// AcceptFriendshipResponseValidationError is the validation error returned by
+// AcceptFriendshipResponse.Validate if the designated constraints aren't met.
+type AcceptFriendshipResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptFriendshipResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptFriendshipResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptFriendshipResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptFriendshipResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptFriendshipResponseValidationError) ErrorName() string {
+	return "AcceptFriendshipResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptFriendshipResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptFriendshipResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptFriendshipResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptFriendshipResponseValidationError{}
+
+// Validate checks the field values on DeclineFriendshipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeclineFriendshipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeclineFriendshipRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeclineFriendshipRequestMultiError, or nil if none found.
+func (m *DeclineFriendshipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeclineFriendshipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = DeclineFriendshipRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetFriendId()); err != nil {
+		err = DeclineFriendshipRequestValidationError{
+			field:  "FriendId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeclineFriendshipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeclineFriendshipRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeclineFriendshipRequestMultiError is an error wrapping multiple validation
+// errors returned by DeclineFriendshipRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeclineFriendshipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeclineFriendshipRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeclineFriendshipRequestMultiError) AllErrors() []error { return m }
+
+// DeclineFriendshipRequestValidationError is the validation error returned by
+// DeclineFriendshipRequest.Validate if the designated constraints aren't met.
+type DeclineFriendshipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeclineFriendshipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeclineFriendshipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeclineFriendshipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeclineFriendshipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeclineFriendshipRequestValidationError) ErrorName() string {
+	return "DeclineFriendshipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeclineFriendshipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeclineFriendshipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeclineFriendshipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeclineFriendshipRequestValidationError{}
+
+// Validate checks the field values on DeclineFriendshipResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeclineFriendshipResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeclineFriendshipResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeclineFriendshipResponseMultiError, or nil if none found.
+func (m *DeclineFriendshipResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeclineFriendshipResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeclineFriendshipResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeclineFriendshipResponseMultiError is an error wrapping multiple validation
+// errors returned by DeclineFriendshipResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeclineFriendshipResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeclineFriendshipResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeclineFriendshipResponseMultiError) AllErrors() []error { return m }
+
+// DeclineFriendshipResponseValidationError is the validation error returned by
+// DeclineFriendshipResponse.Validate if the designated constraints aren't met.
+type DeclineFriendshipResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeclineFriendshipResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeclineFriendshipResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeclineFriendshipResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeclineFriendshipResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeclineFriendshipResponseValidationError) ErrorName() string {
+	return "DeclineFriendshipResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeclineFriendshipResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeclineFriendshipResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeclineFriendshipResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeclineFriendshipResponseValidationError{}
+
+// Validate checks the field values on RemoveFriendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFriendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFriendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFriendRequestMultiError, or nil if none found.
+func (m *RemoveFriendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFriendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RemoveFriendRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetFriendId()); err != nil {
+		err = RemoveFriendRequestValidationError{
+			field:  "FriendId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveFriendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveFriendRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveFriendRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveFriendRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveFriendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFriendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFriendRequestMultiError) AllErrors() []error { return m }
+
+// RemoveFriendRequestValidationError is the validation error returned by
+// RemoveFriendRequest.Validate if the designated constraints aren't met.
+type RemoveFriendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFriendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFriendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFriendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFriendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFriendRequestValidationError) ErrorName() string {
+	return "RemoveFriendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFriendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFriendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFriendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFriendRequestValidationError{}
+
+// Validate checks the field values on RemoveFriendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFriendResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFriendResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFriendResponseMultiError, or nil if none found.
+func (m *RemoveFriendResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFriendResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveFriendResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveFriendResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveFriendResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveFriendResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFriendResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFriendResponseMultiError) AllErrors() []error { return m }
+
+// RemoveFriendResponseValidationError is the validation error returned by
+// RemoveFriendResponse.Validate if the designated constraints aren't met.
+type RemoveFriendResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFriendResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFriendResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFriendResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFriendResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFriendResponseValidationError) ErrorName() string {
+	return "RemoveFriendResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFriendResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFriendResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFriendResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFriendResponseValidationError{}
+
+// Validate checks the field values on ListFriendsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFriendsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFriendsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFriendsRequestMultiError, or nil if none found.
+func (m *ListFriendsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFriendsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListFriendsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	if m.GetStatus() != "" {
+
+		if _, ok := _ListFriendsRequest_Status_InLookup[m.GetStatus()]; !ok {
+			err := ListFriendsRequestValidationError{
+				field:  "Status",
+				reason: "value must be in list [pending accepted]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFriendsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListFriendsRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListFriendsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListFriendsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListFriendsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFriendsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFriendsRequestMultiError) AllErrors() []error { return m }
+
+// ListFriendsRequestValidationError is the validation error returned by
+// ListFriendsRequest.Validate if the designated constraints aren't met.
+type ListFriendsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFriendsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFriendsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFriendsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFriendsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFriendsRequestValidationError) ErrorName() string {
+	return "ListFriendsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFriendsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFriendsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFriendsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFriendsRequestValidationError{}
+
+var _ListFriendsRequest_Status_InLookup = map[string]struct{}{
+	"pending":  {},
+	"accepted": {},
+}
+
+// Validate checks the field values on ListFriendsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFriendsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFriendsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFriendsResponseMultiError, or nil if none found.
+func (m *ListFriendsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFriendsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFriendships() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFriendsResponseValidationError{
+						field:  fmt.Sprintf("Friendships[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFriendsResponseValidationError{
+						field:  fmt.Sprintf("Friendships[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFriendsResponseValidationError{
+					field:  fmt.Sprintf("Friendships[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFriendsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFriendsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFriendsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFriendsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFriendsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFriendsResponseMultiError) AllErrors() []error { return m }
+
+// ListFriendsResponseValidationError is the validation error returned by
+// ListFriendsResponse.Validate if the designated constraints aren't met.
+type ListFriendsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFriendsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFriendsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFriendsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFriendsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFriendsResponseValidationError) ErrorName() string {
+	return "ListFriendsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFriendsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFriendsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFriendsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFriendsResponseValidationError{}
+
+// Validate checks the field values on Block with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Block) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Block with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BlockMultiError, or nil if none found.
+func (m *Block) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Block) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlockerId
+
+	// no validation rules for BlockedId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlockValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlockValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlockValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BlockMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockMultiError is an error wrapping multiple validation errors returned by
+// Block.ValidateAll() if the designated constraints aren't met.
+type BlockMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockMultiError) AllErrors() []error { return m }
+
+// BlockValidationError is the validation error returned by Block.Validate if
+// the designated constraints aren't met.
+type BlockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockValidationError) ErrorName() string { return "BlockValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockValidationError{}
+
+// Validate checks the field values on BlockUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockUserRequestMultiError, or nil if none found.
+func (m *BlockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = BlockUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetBlockedId()); err != nil {
+		err = BlockUserRequestValidationError{
+			field:  "BlockedId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BlockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BlockUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BlockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by BlockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BlockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockUserRequestMultiError) AllErrors() []error { return m }
+
+// BlockUserRequestValidationError is the validation error returned by
+// BlockUserRequest.Validate if the designated constraints aren't met.
+type BlockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockUserRequestValidationError) ErrorName() string { return "BlockUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockUserRequestValidationError{}
+
+// Validate checks the field values on BlockUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockUserResponseMultiError, or nil if none found.
+func (m *BlockUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlockUserResponseValidationError{
+					field:  "Block",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlockUserResponseValidationError{
+					field:  "Block",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlockUserResponseValidationError{
+				field:  "Block",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BlockUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockUserResponseMultiError is an error wrapping multiple validation errors
+// returned by BlockUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BlockUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockUserResponseMultiError) AllErrors() []error { return m }
+
+// BlockUserResponseValidationError is the validation error returned by
+// BlockUserResponse.Validate if the designated constraints aren't met.
+type BlockUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockUserResponseValidationError) ErrorName() string {
+	return "BlockUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockUserResponseValidationError{}
+
+// Validate checks the field values on UnblockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnblockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockUserRequestMultiError, or nil if none found.
+func (m *UnblockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UnblockUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetBlockedId()); err != nil {
+		err = UnblockUserRequestValidationError{
+			field:  "BlockedId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnblockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnblockUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnblockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UnblockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnblockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockUserRequestMultiError) AllErrors() []error { return m }
+
+// UnblockUserRequestValidationError is the validation error returned by
+// UnblockUserRequest.Validate if the designated constraints aren't met.
+type UnblockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockUserRequestValidationError) ErrorName() string {
+	return "UnblockUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnblockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockUserRequestValidationError{}
+
+// Validate checks the field values on UnblockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnblockUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockUserResponseMultiError, or nil if none found.
+func (m *UnblockUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnblockUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockUserResponseMultiError is an error wrapping multiple validation
+// errors returned by UnblockUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnblockUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockUserResponseMultiError) AllErrors() []error { return m }
+
+// UnblockUserResponseValidationError is the validation error returned by
+// UnblockUserResponse.Validate if the designated constraints aren't met.
+type UnblockUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockUserResponseValidationError) ErrorName() string {
+	return "UnblockUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnblockUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockUserResponseValidationError{}
+
+// Validate checks the field values on ListBlockedUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBlockedUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlockedUsersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBlockedUsersRequestMultiError, or nil if none found.
+func (m *ListBlockedUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlockedUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListBlockedUsersRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListBlockedUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListBlockedUsersRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListBlockedUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBlockedUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBlockedUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlockedUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlockedUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListBlockedUsersRequestValidationError is the validation error returned by
+// ListBlockedUsersRequest.Validate if the designated constraints aren't met.
+type ListBlockedUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlockedUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlockedUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlockedUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlockedUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlockedUsersRequestValidationError) ErrorName() string {
+	return "ListBlockedUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBlockedUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlockedUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlockedUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlockedUsersRequestValidationError{}
+
+// Validate checks the field values on ListBlockedUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBlockedUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlockedUsersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBlockedUsersResponseMultiError, or nil if none found.
+func (m *ListBlockedUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlockedUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBlocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBlockedUsersResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBlockedUsersResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBlockedUsersResponseValidationError{
+					field:  fmt.Sprintf("Blocks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBlockedUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBlockedUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBlockedUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBlockedUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlockedUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlockedUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListBlockedUsersResponseValidationError is the validation error returned by
+// ListBlockedUsersResponse.Validate if the designated constraints aren't met.
+type ListBlockedUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlockedUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlockedUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlockedUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlockedUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlockedUsersResponseValidationError) ErrorName() string {
+	return "ListBlockedUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBlockedUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlockedUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlockedUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlockedUsersResponseValidationError{}
+
+// Validate checks the field values on IsBlockedRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IsBlockedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsBlockedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsBlockedRequestMultiError, or nil if none found.
+func (m *IsBlockedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsBlockedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = IsBlockedRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetOtherUserId()); err != nil {
+		err = IsBlockedRequestValidationError{
+			field:  "OtherUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IsBlockedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *IsBlockedRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// IsBlockedRequestMultiError is an error wrapping multiple validation errors
+// returned by IsBlockedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IsBlockedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsBlockedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsBlockedRequestMultiError) AllErrors() []error { return m }
+
+// IsBlockedRequestValidationError is the validation error returned by
+// IsBlockedRequest.Validate if the designated constraints aren't met.
+type IsBlockedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsBlockedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsBlockedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsBlockedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsBlockedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsBlockedRequestValidationError) ErrorName() string { return "IsBlockedRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IsBlockedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsBlockedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsBlockedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsBlockedRequestValidationError{}
+
+// Validate checks the field values on IsBlockedResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IsBlockedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsBlockedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsBlockedResponseMultiError, or nil if none found.
+func (m *IsBlockedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsBlockedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Blocked
+
+	if len(errors) > 0 {
+		return IsBlockedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsBlockedResponseMultiError is an error wrapping multiple validation errors
+// returned by IsBlockedResponse.ValidateAll() if the designated constraints
+// aren't met.
+type IsBlockedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsBlockedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsBlockedResponseMultiError) AllErrors() []error { return m }
+
+// IsBlockedResponseValidationError is the validation error returned by
+// IsBlockedResponse.Validate if the designated constraints aren't met.
+type IsBlockedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsBlockedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsBlockedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsBlockedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsBlockedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsBlockedResponseValidationError) ErrorName() string {
+	return "IsBlockedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsBlockedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsBlockedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsBlockedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsBlockedResponseValidationError{}
+
+// Validate checks the field values on Suspension with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Suspension) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Suspension with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SuspensionMultiError, or
+// nil if none found.
+func (m *Suspension) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Suspension) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Reason
+
+	// no validation rules for Note
+
+	// no validation rules for IssuedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuspensionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuspensionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuspensionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuspensionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuspensionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuspensionValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuspensionValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuspensionValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuspensionValidationError{
+				field:  "EndedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LiftedBy
+
+	if len(errors) > 0 {
+		return SuspensionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuspensionMultiError is an error wrapping multiple validation errors
+// returned by Suspension.ValidateAll() if the designated constraints aren't met.
+type SuspensionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuspensionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuspensionMultiError) AllErrors() []error { return m }
+
+// SuspensionValidationError is the validation error returned by
+// Suspension.Validate if the designated constraints aren't met.
+type SuspensionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuspensionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuspensionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuspensionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuspensionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuspensionValidationError) ErrorName() string { return "SuspensionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SuspensionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuspension.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuspensionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuspensionValidationError{}
+
+// Validate checks the field values on SuspendUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuspendUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuspendUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuspendUserRequestMultiError, or nil if none found.
+func (m *SuspendUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuspendUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = SuspendUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SuspendUserRequest_Reason_InLookup[m.GetReason()]; !ok {
+		err := SuspendUserRequestValidationError{
+			field:  "Reason",
+			reason: "value must be in list [cheating abusive_behavior account_sharing fraud other]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 2000 {
+		err := SuspendUserRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuspendUserRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuspendUserRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuspendUserRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SuspendUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SuspendUserRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SuspendUserRequestMultiError is an error wrapping multiple validation errors
+// returned by SuspendUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SuspendUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuspendUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuspendUserRequestMultiError) AllErrors() []error { return m }
+
+// SuspendUserRequestValidationError is the validation error returned by
+// SuspendUserRequest.Validate if the designated constraints aren't met.
+type SuspendUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuspendUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuspendUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuspendUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuspendUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuspendUserRequestValidationError) ErrorName() string {
+	return "SuspendUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuspendUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuspendUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuspendUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuspendUserRequestValidationError{}
+
+var _SuspendUserRequest_Reason_InLookup = map[string]struct{}{
+	"cheating":         {},
+	"abusive_behavior": {},
+	"account_sharing":  {},
+	"fraud":            {},
+	"other":            {},
+}
+
+// Validate checks the field values on SuspendUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuspendUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuspendUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuspendUserResponseMultiError, or nil if none found.
+func (m *SuspendUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuspendUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSuspension()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuspendUserResponseValidationError{
+					field:  "Suspension",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuspendUserResponseValidationError{
+					field:  "Suspension",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSuspension()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuspendUserResponseValidationError{
+				field:  "Suspension",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SuspendUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuspendUserResponseMultiError is an error wrapping multiple validation
+// errors returned by SuspendUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SuspendUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuspendUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuspendUserResponseMultiError) AllErrors() []error { return m }
+
+// SuspendUserResponseValidationError is the validation error returned by
+// SuspendUserResponse.Validate if the designated constraints aren't met.
+type SuspendUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuspendUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuspendUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuspendUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuspendUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuspendUserResponseValidationError) ErrorName() string {
+	return "SuspendUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuspendUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuspendUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuspendUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuspendUserResponseValidationError{}
+
+// Validate checks the field values on LiftSuspensionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LiftSuspensionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LiftSuspensionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LiftSuspensionRequestMultiError, or nil if none found.
+func (m *LiftSuspensionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LiftSuspensionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = LiftSuspensionRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LiftSuspensionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *LiftSuspensionRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// LiftSuspensionRequestMultiError is an error wrapping multiple validation
+// errors returned by LiftSuspensionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LiftSuspensionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LiftSuspensionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LiftSuspensionRequestMultiError) AllErrors() []error { return m }
+
+// LiftSuspensionRequestValidationError is the validation error returned by
+// LiftSuspensionRequest.Validate if the designated constraints aren't met.
+type LiftSuspensionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LiftSuspensionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LiftSuspensionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LiftSuspensionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LiftSuspensionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LiftSuspensionRequestValidationError) ErrorName() string {
+	return "LiftSuspensionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LiftSuspensionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLiftSuspensionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LiftSuspensionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LiftSuspensionRequestValidationError{}
+
+// Validate checks the field values on LiftSuspensionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LiftSuspensionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LiftSuspensionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LiftSuspensionResponseMultiError, or nil if none found.
+func (m *LiftSuspensionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LiftSuspensionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LiftSuspensionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LiftSuspensionResponseMultiError is an error wrapping multiple validation
+// errors returned by LiftSuspensionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type LiftSuspensionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LiftSuspensionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LiftSuspensionResponseMultiError) AllErrors() []error { return m }
+
+// LiftSuspensionResponseValidationError is the validation error returned by
+// LiftSuspensionResponse.Validate if the designated constraints aren't met.
+type LiftSuspensionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LiftSuspensionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LiftSuspensionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LiftSuspensionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LiftSuspensionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LiftSuspensionResponseValidationError) ErrorName() string {
+	return "LiftSuspensionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LiftSuspensionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLiftSuspensionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LiftSuspensionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LiftSuspensionResponseValidationError{}
+
+// Validate checks the field values on ListSuspensionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSuspensionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSuspensionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSuspensionsRequestMultiError, or nil if none found.
+func (m *ListSuspensionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSuspensionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListSuspensionsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListSuspensionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListSuspensionsRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListSuspensionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSuspensionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSuspensionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSuspensionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSuspensionsRequestMultiError) AllErrors() []error { return m }
+
+// ListSuspensionsRequestValidationError is the validation error returned by
+// ListSuspensionsRequest.Validate if the designated constraints aren't met.
+type ListSuspensionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSuspensionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSuspensionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSuspensionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSuspensionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSuspensionsRequestValidationError) ErrorName() string {
+	return "ListSuspensionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSuspensionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSuspensionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSuspensionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSuspensionsRequestValidationError{}
+
+// Validate checks the field values on ListSuspensionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSuspensionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSuspensionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSuspensionsResponseMultiError, or nil if none found.
+func (m *ListSuspensionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSuspensionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSuspensions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSuspensionsResponseValidationError{
+						field:  fmt.Sprintf("Suspensions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSuspensionsResponseValidationError{
+						field:  fmt.Sprintf("Suspensions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSuspensionsResponseValidationError{
+					field:  fmt.Sprintf("Suspensions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSuspensionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSuspensionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSuspensionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSuspensionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSuspensionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSuspensionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSuspensionsResponseValidationError is the validation error returned by
+// ListSuspensionsResponse.Validate if the designated constraints aren't met.
+type ListSuspensionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSuspensionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSuspensionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSuspensionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSuspensionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSuspensionsResponseValidationError) ErrorName() string {
+	return "ListSuspensionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSuspensionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSuspensionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSuspensionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSuspensionsResponseValidationError{}
+
+// Validate checks the field values on RequestAccountDeletionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestAccountDeletionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestAccountDeletionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RequestAccountDeletionRequestMultiError, or nil if none found.
+func (m *RequestAccountDeletionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestAccountDeletionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RequestAccountDeletionRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestAccountDeletionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RequestAccountDeletionRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RequestAccountDeletionRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestAccountDeletionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RequestAccountDeletionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestAccountDeletionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestAccountDeletionRequestMultiError) AllErrors() []error { return m }
+
+// RequestAccountDeletionRequestValidationError is the validation error
+// returned by RequestAccountDeletionRequest.Validate if the designated
+// constraints aren't met.
+type RequestAccountDeletionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestAccountDeletionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestAccountDeletionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestAccountDeletionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestAccountDeletionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestAccountDeletionRequestValidationError) ErrorName() string {
+	return "RequestAccountDeletionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestAccountDeletionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestAccountDeletionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestAccountDeletionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestAccountDeletionRequestValidationError{}
+
+// Validate checks the field values on RequestAccountDeletionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestAccountDeletionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestAccountDeletionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RequestAccountDeletionResponseMultiError, or nil if none found.
+func (m *RequestAccountDeletionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestAccountDeletionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScheduledFor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestAccountDeletionResponseValidationError{
+					field:  "ScheduledFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestAccountDeletionResponseValidationError{
+					field:  "ScheduledFor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledFor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestAccountDeletionResponseValidationError{
+				field:  "ScheduledFor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestAccountDeletionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestAccountDeletionResponseMultiError is an error wrapping multiple
+// validation errors returned by RequestAccountDeletionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RequestAccountDeletionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestAccountDeletionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestAccountDeletionResponseMultiError) AllErrors() []error { return m }
+
+// RequestAccountDeletionResponseValidationError is the validation error
+// returned by RequestAccountDeletionResponse.Validate if the designated
+// constraints aren't met.
+type RequestAccountDeletionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestAccountDeletionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestAccountDeletionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestAccountDeletionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestAccountDeletionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestAccountDeletionResponseValidationError) ErrorName() string {
+	return "RequestAccountDeletionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestAccountDeletionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestAccountDeletionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestAccountDeletionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestAccountDeletionResponseValidationError{}
+
+// Validate checks the field values on CancelAccountDeletionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelAccountDeletionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelAccountDeletionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelAccountDeletionRequestMultiError, or nil if none found.
+func (m *CancelAccountDeletionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelAccountDeletionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = CancelAccountDeletionRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelAccountDeletionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CancelAccountDeletionRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CancelAccountDeletionRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelAccountDeletionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CancelAccountDeletionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelAccountDeletionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelAccountDeletionRequestMultiError) AllErrors() []error { return m }
+
+// CancelAccountDeletionRequestValidationError is the validation error returned
+// by CancelAccountDeletionRequest.Validate if the designated constraints
+// aren't met.
+type CancelAccountDeletionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelAccountDeletionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelAccountDeletionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelAccountDeletionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelAccountDeletionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelAccountDeletionRequestValidationError) ErrorName() string {
+	return "CancelAccountDeletionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelAccountDeletionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelAccountDeletionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelAccountDeletionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelAccountDeletionRequestValidationError{}
+
+// Validate checks the field values on CancelAccountDeletionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelAccountDeletionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelAccountDeletionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelAccountDeletionResponseMultiError, or nil if none found.
+func (m *CancelAccountDeletionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelAccountDeletionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CancelAccountDeletionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelAccountDeletionResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelAccountDeletionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CancelAccountDeletionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelAccountDeletionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelAccountDeletionResponseMultiError) AllErrors() []error { return m }
+
+// CancelAccountDeletionResponseValidationError is the validation error
+// returned by CancelAccountDeletionResponse.Validate if the designated
+// constraints aren't met.
+type CancelAccountDeletionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelAccountDeletionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelAccountDeletionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelAccountDeletionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelAccountDeletionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelAccountDeletionResponseValidationError) ErrorName() string {
+	return "CancelAccountDeletionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelAccountDeletionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelAccountDeletionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelAccountDeletionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelAccountDeletionResponseValidationError{}
+
+// Validate checks the field values on NicknameChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NicknameChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NicknameChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NicknameChangeMultiError,
+// or nil if none found.
+func (m *NicknameChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NicknameChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OldNickname
+
+	// no validation rules for NewNickname
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NicknameChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NicknameChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NicknameChangeValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NicknameChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// NicknameChangeMultiError is an error wrapping multiple validation errors
+// returned by NicknameChange.ValidateAll() if the designated constraints
+// aren't met.
+type NicknameChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NicknameChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NicknameChangeMultiError) AllErrors() []error { return m }
+
+// NicknameChangeValidationError is the validation error returned by
+// NicknameChange.Validate if the designated constraints aren't met.
+type NicknameChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NicknameChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NicknameChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NicknameChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NicknameChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NicknameChangeValidationError) ErrorName() string { return "NicknameChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NicknameChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNicknameChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NicknameChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NicknameChangeValidationError{}
+
+// Validate checks the field values on GetNicknameHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNicknameHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNicknameHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNicknameHistoryRequestMultiError, or nil if none found.
+func (m *GetNicknameHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNicknameHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetNicknameHistoryRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return GetNicknameHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetNicknameHistoryRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetNicknameHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetNicknameHistoryRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetNicknameHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNicknameHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNicknameHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetNicknameHistoryRequestValidationError is the validation error returned by
+// GetNicknameHistoryRequest.Validate if the designated constraints aren't met.
+type GetNicknameHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNicknameHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNicknameHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNicknameHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNicknameHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNicknameHistoryRequestValidationError) ErrorName() string {
+	return "GetNicknameHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNicknameHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNicknameHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNicknameHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNicknameHistoryRequestValidationError{}
+
+// Validate checks the field values on GetNicknameHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserResponse) Validate() error {
+func (m *GetNicknameHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetNicknameHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateUserResponseMultiError, or nil if none found.
-func (m *UpdateUserResponse) ValidateAll() error {
+// GetNicknameHistoryResponseMultiError, or nil if none found.
+func (m *GetNicknameHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserResponse) validate(all bool) error {
+func (m *GetNicknameHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetNicknameHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetNicknameHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserResponseValidationError{
-					field:  "User",
+				return GetNicknameHistoryResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateUserResponseMultiError(errors)
+		return GetNicknameHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserResponseMultiError is an error wrapping multiple validation errors
-// returned by UpdateUserResponse.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateUserResponseMultiError []error
+// GetNicknameHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetNicknameHistoryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetNicknameHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserResponseMultiError) Error() string {
+func (m GetNicknameHistoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1912,11 +14211,11 @@ func (m UpdateUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserResponseMultiError) AllErrors() []error { return m }
+func (m GetNicknameHistoryResponseMultiError) AllErrors() []error { return m }
 
-// UpdateUserResponseValidationError is the validation error returned by
-// UpdateUserResponse.Validate if the designated constraints aren't met.
-type UpdateUserResponseValidationError struct {
+// GetNicknameHistoryResponseValidationError is the validation error returned
+// by GetNicknameHistoryResponse.Validate if the designated constraints aren't met.
+type GetNicknameHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1924,24 +14223,24 @@ type UpdateUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserResponseValidationError) Field() string { return e.field }
+func (e GetNicknameHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserResponseValidationError) Reason() string { return e.reason }
+func (e GetNicknameHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserResponseValidationError) Cause() error { return e.cause }
+func (e GetNicknameHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserResponseValidationError) Key() bool { return e.key }
+func (e GetNicknameHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserResponseValidationError) ErrorName() string {
-	return "UpdateUserResponseValidationError"
+func (e GetNicknameHistoryResponseValidationError) ErrorName() string {
+	return "GetNicknameHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserResponseValidationError) Error() string {
+func (e GetNicknameHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1953,14 +14252,14 @@ func (e UpdateUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserResponse.%s: %s%s",
+		"invalid %sGetNicknameHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserResponseValidationError{}
+var _ error = GetNicknameHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1968,66 +14267,212 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserResponseValidationError{}
+} = GetNicknameHistoryResponseValidationError{}
 
-// Validate checks the field values on RemoveUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *RemoveUserRequest) Validate() error {
+// Validate checks the field values on CheckNicknameAvailabilityRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckNicknameAvailabilityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveUserRequestMultiError, or nil if none found.
-func (m *RemoveUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on CheckNicknameAvailabilityRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckNicknameAvailabilityRequestMultiError, or nil if none found.
+func (m *CheckNicknameAvailabilityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveUserRequest) validate(all bool) error {
+func (m *CheckNicknameAvailabilityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetId()); err != nil {
-		err = RemoveUserRequestValidationError{
-			field:  "Id",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetNickname()) < 1 {
+		err := CheckNicknameAvailabilityRequestValidationError{
+			field:  "Nickname",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNickname()) > 256 {
+		err := CheckNicknameAvailabilityRequestValidationError{
+			field:  "Nickname",
+			reason: "value length must be at most 256 bytes",
 		}
 		if !all {
 			return err
 		}
-		errors = append(errors, err)
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reserve
+
+	if len(errors) > 0 {
+		return CheckNicknameAvailabilityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckNicknameAvailabilityRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckNicknameAvailabilityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckNicknameAvailabilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckNicknameAvailabilityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckNicknameAvailabilityRequestMultiError) AllErrors() []error { return m }
+
+// CheckNicknameAvailabilityRequestValidationError is the validation error
+// returned by CheckNicknameAvailabilityRequest.Validate if the designated
+// constraints aren't met.
+type CheckNicknameAvailabilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckNicknameAvailabilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckNicknameAvailabilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckNicknameAvailabilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckNicknameAvailabilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckNicknameAvailabilityRequestValidationError) ErrorName() string {
+	return "CheckNicknameAvailabilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckNicknameAvailabilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckNicknameAvailabilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckNicknameAvailabilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckNicknameAvailabilityRequestValidationError{}
+
+// Validate checks the field values on CheckNicknameAvailabilityResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckNicknameAvailabilityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckNicknameAvailabilityResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckNicknameAvailabilityResponseMultiError, or nil if none found.
+func (m *CheckNicknameAvailabilityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckNicknameAvailabilityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Available
+
+	// no validation rules for Rejection
+
+	// no validation rules for Nickname
+
+	// no validation rules for ReservationToken
+
+	if all {
+		switch v := interface{}(m.GetReservationExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckNicknameAvailabilityResponseValidationError{
+					field:  "ReservationExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckNicknameAvailabilityResponseValidationError{
+					field:  "ReservationExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReservationExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckNicknameAvailabilityResponseValidationError{
+				field:  "ReservationExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	// no validation rules for HardDelete
-
 	if len(errors) > 0 {
-		return RemoveUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *RemoveUserRequest) _validateUuid(uuid string) error {
-	if matched := _user_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		return CheckNicknameAvailabilityResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveUserRequestMultiError is an error wrapping multiple validation errors
-// returned by RemoveUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type RemoveUserRequestMultiError []error
+// CheckNicknameAvailabilityResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckNicknameAvailabilityResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckNicknameAvailabilityResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveUserRequestMultiError) Error() string {
+func (m CheckNicknameAvailabilityResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2036,11 +14481,12 @@ func (m RemoveUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveUserRequestMultiError) AllErrors() []error { return m }
+func (m CheckNicknameAvailabilityResponseMultiError) AllErrors() []error { return m }
 
-// RemoveUserRequestValidationError is the validation error returned by
-// RemoveUserRequest.Validate if the designated constraints aren't met.
-type RemoveUserRequestValidationError struct {
+// CheckNicknameAvailabilityResponseValidationError is the validation error
+// returned by CheckNicknameAvailabilityResponse.Validate if the designated
+// constraints aren't met.
+type CheckNicknameAvailabilityResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2048,24 +14494,24 @@ type RemoveUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveUserRequestValidationError) Field() string { return e.field }
+func (e CheckNicknameAvailabilityResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveUserRequestValidationError) Reason() string { return e.reason }
+func (e CheckNicknameAvailabilityResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveUserRequestValidationError) Cause() error { return e.cause }
+func (e CheckNicknameAvailabilityResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveUserRequestValidationError) Key() bool { return e.key }
+func (e CheckNicknameAvailabilityResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveUserRequestValidationError) ErrorName() string {
-	return "RemoveUserRequestValidationError"
+func (e CheckNicknameAvailabilityResponseValidationError) ErrorName() string {
+	return "CheckNicknameAvailabilityResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveUserRequestValidationError) Error() string {
+func (e CheckNicknameAvailabilityResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2077,14 +14523,14 @@ func (e RemoveUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveUserRequest.%s: %s%s",
+		"invalid %sCheckNicknameAvailabilityResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveUserRequestValidationError{}
+var _ error = CheckNicknameAvailabilityResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2092,44 +14538,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveUserRequestValidationError{}
+} = CheckNicknameAvailabilityResponseValidationError{}
 
-// Validate checks the field values on RemoveUserResponse with the rules
+// Validate checks the field values on GetPublicProfileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveUserResponse) Validate() error {
+func (m *GetPublicProfileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetPublicProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveUserResponseMultiError, or nil if none found.
-func (m *RemoveUserResponse) ValidateAll() error {
+// GetPublicProfileRequestMultiError, or nil if none found.
+func (m *GetPublicProfileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveUserResponse) validate(all bool) error {
+func (m *GetPublicProfileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetUserId() != "" {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = GetPublicProfileRequestValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetNickname()) > 256 {
+		err := GetPublicProfileRequestValidationError{
+			field:  "Nickname",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return RemoveUserResponseMultiError(errors)
+		return GetPublicProfileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveUserResponseMultiError is an error wrapping multiple validation errors
-// returned by RemoveUserResponse.ValidateAll() if the designated constraints
-// aren't met.
-type RemoveUserResponseMultiError []error
+func (m *GetPublicProfileRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPublicProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPublicProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPublicProfileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveUserResponseMultiError) Error() string {
+func (m GetPublicProfileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2138,11 +14619,11 @@ func (m RemoveUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveUserResponseMultiError) AllErrors() []error { return m }
+func (m GetPublicProfileRequestMultiError) AllErrors() []error { return m }
 
-// RemoveUserResponseValidationError is the validation error returned by
-// RemoveUserResponse.Validate if the designated constraints aren't met.
-type RemoveUserResponseValidationError struct {
+// GetPublicProfileRequestValidationError is the validation error returned by
+// GetPublicProfileRequest.Validate if the designated constraints aren't met.
+type GetPublicProfileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2150,24 +14631,24 @@ type RemoveUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveUserResponseValidationError) Field() string { return e.field }
+func (e GetPublicProfileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveUserResponseValidationError) Reason() string { return e.reason }
+func (e GetPublicProfileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveUserResponseValidationError) Cause() error { return e.cause }
+func (e GetPublicProfileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveUserResponseValidationError) Key() bool { return e.key }
+func (e GetPublicProfileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveUserResponseValidationError) ErrorName() string {
-	return "RemoveUserResponseValidationError"
+func (e GetPublicProfileRequestValidationError) ErrorName() string {
+	return "GetPublicProfileRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveUserResponseValidationError) Error() string {
+func (e GetPublicProfileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2179,14 +14660,14 @@ func (e RemoveUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveUserResponse.%s: %s%s",
+		"invalid %sGetPublicProfileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveUserResponseValidationError{}
+var _ error = GetPublicProfileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2194,107 +14675,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveUserResponseValidationError{}
+} = GetPublicProfileRequestValidationError{}
 
-// Validate checks the field values on ListUsersRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListUsersRequest) Validate() error {
+// Validate checks the field values on GetPublicProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPublicProfileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetPublicProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListUsersRequestMultiError, or nil if none found.
-func (m *ListUsersRequest) ValidateAll() error {
+// GetPublicProfileResponseMultiError, or nil if none found.
+func (m *GetPublicProfileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUsersRequest) validate(all bool) error {
+func (m *GetPublicProfileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PageSize
-
-	// no validation rules for Offset
-
-	_ListUsersRequest_Countries_Unique := make(map[string]struct{}, len(m.GetCountries()))
-
-	for idx, item := range m.GetCountries() {
-		_, _ = idx, item
-
-		if _, exists := _ListUsersRequest_Countries_Unique[item]; exists {
-			err := ListUsersRequestValidationError{
-				field:  fmt.Sprintf("Countries[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_ListUsersRequest_Countries_Unique[item] = struct{}{}
-		}
-
-		// no validation rules for Countries[idx]
-	}
-
-	if all {
-		switch v := interface{}(m.GetCreatedAfter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListUsersRequestValidationError{
-					field:  "CreatedAfter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListUsersRequestValidationError{
-					field:  "CreatedAfter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListUsersRequestValidationError{
-				field:  "CreatedAfter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		switch v := interface{}(m.GetProfile()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListUsersRequestValidationError{
-					field:  "CreatedBefore",
+				errors = append(errors, GetPublicProfileResponseValidationError{
+					field:  "Profile",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListUsersRequestValidationError{
-					field:  "CreatedBefore",
+				errors = append(errors, GetPublicProfileResponseValidationError{
+					field:  "Profile",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListUsersRequestValidationError{
-				field:  "CreatedBefore",
+			return GetPublicProfileResponseValidationError{
+				field:  "Profile",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2302,19 +14729,19 @@ func (m *ListUsersRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListUsersRequestMultiError(errors)
+		return GetPublicProfileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUsersRequestMultiError is an error wrapping multiple validation errors
-// returned by ListUsersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListUsersRequestMultiError []error
+// GetPublicProfileResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPublicProfileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPublicProfileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUsersRequestMultiError) Error() string {
+func (m GetPublicProfileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2323,11 +14750,11 @@ func (m ListUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUsersRequestMultiError) AllErrors() []error { return m }
+func (m GetPublicProfileResponseMultiError) AllErrors() []error { return m }
 
-// ListUsersRequestValidationError is the validation error returned by
-// ListUsersRequest.Validate if the designated constraints aren't met.
-type ListUsersRequestValidationError struct {
+// GetPublicProfileResponseValidationError is the validation error returned by
+// GetPublicProfileResponse.Validate if the designated constraints aren't met.
+type GetPublicProfileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2335,22 +14762,24 @@ type ListUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUsersRequestValidationError) Field() string { return e.field }
+func (e GetPublicProfileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUsersRequestValidationError) Reason() string { return e.reason }
+func (e GetPublicProfileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUsersRequestValidationError) Cause() error { return e.cause }
+func (e GetPublicProfileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUsersRequestValidationError) Key() bool { return e.key }
+func (e GetPublicProfileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUsersRequestValidationError) ErrorName() string { return "ListUsersRequestValidationError" }
+func (e GetPublicProfileResponseValidationError) ErrorName() string {
+	return "GetPublicProfileResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListUsersRequestValidationError) Error() string {
+func (e GetPublicProfileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2362,14 +14791,14 @@ func (e ListUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUsersRequest.%s: %s%s",
+		"invalid %sGetPublicProfileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUsersRequestValidationError{}
+var _ error = GetPublicProfileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2377,78 +14806,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUsersRequestValidationError{}
+} = GetPublicProfileResponseValidationError{}
 
-// Validate checks the field values on ListUsersResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListUsersResponse) Validate() error {
+// Validate checks the field values on PublicProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PublicProfile) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListUsersResponseMultiError, or nil if none found.
-func (m *ListUsersResponse) ValidateAll() error {
+// ValidateAll checks the field values on PublicProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PublicProfileMultiError, or
+// nil if none found.
+func (m *PublicProfile) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUsersResponse) validate(all bool) error {
+func (m *PublicProfile) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
+	// no validation rules for UserId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for Nickname
+
+	// no validation rules for Visibility
+
+	// no validation rules for Restricted
+
+	// no validation rules for Country
+
+	if all {
+		switch v := interface{}(m.GetJoinedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublicProfileValidationError{
+					field:  "JoinedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListUsersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				errors = append(errors, PublicProfileValidationError{
+					field:  "JoinedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJoinedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublicProfileValidationError{
+				field:  "JoinedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return ListUsersResponseMultiError(errors)
+		return PublicProfileMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUsersResponseMultiError is an error wrapping multiple validation errors
-// returned by ListUsersResponse.ValidateAll() if the designated constraints
+// PublicProfileMultiError is an error wrapping multiple validation errors
+// returned by PublicProfile.ValidateAll() if the designated constraints
 // aren't met.
-type ListUsersResponseMultiError []error
+type PublicProfileMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUsersResponseMultiError) Error() string {
+func (m PublicProfileMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2457,11 +14893,11 @@ func (m ListUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUsersResponseMultiError) AllErrors() []error { return m }
+func (m PublicProfileMultiError) AllErrors() []error { return m }
 
-// ListUsersResponseValidationError is the validation error returned by
-// ListUsersResponse.Validate if the designated constraints aren't met.
-type ListUsersResponseValidationError struct {
+// PublicProfileValidationError is the validation error returned by
+// PublicProfile.Validate if the designated constraints aren't met.
+type PublicProfileValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2469,24 +14905,22 @@ type ListUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUsersResponseValidationError) Field() string { return e.field }
+func (e PublicProfileValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUsersResponseValidationError) Reason() string { return e.reason }
+func (e PublicProfileValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUsersResponseValidationError) Cause() error { return e.cause }
+func (e PublicProfileValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUsersResponseValidationError) Key() bool { return e.key }
+func (e PublicProfileValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUsersResponseValidationError) ErrorName() string {
-	return "ListUsersResponseValidationError"
-}
+func (e PublicProfileValidationError) ErrorName() string { return "PublicProfileValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListUsersResponseValidationError) Error() string {
+func (e PublicProfileValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2498,14 +14932,14 @@ func (e ListUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUsersResponse.%s: %s%s",
+		"invalid %sPublicProfile.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUsersResponseValidationError{}
+var _ error = PublicProfileValidationError{}
 
 var _ interface {
 	Field() string
@@ -2513,4 +14947,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUsersResponseValidationError{}
+} = PublicProfileValidationError{}
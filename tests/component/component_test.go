@@ -12,7 +12,9 @@ import (
 
 	"cloud.google.com/go/pubsub"
 	"github.com/go-pg/pg/v10"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/golang/protobuf/proto"
+	"github.com/rbroggi/faceittha/internal/actors/signer"
 	v1 "github.com/rbroggi/faceittha/pkg/sdk/v1"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -43,7 +45,8 @@ type ComponentTestSuite struct {
 }
 
 func (s *ComponentTestSuite) SetupTest() {
-	_, err := s.db.Exec("TRUNCATE TABLE faceittha.users")
+	// the tables referencing the users are truncated along with them, postgres rejects the truncation of a referenced table
+	_, err := s.db.Exec("TRUNCATE TABLE faceittha.users, faceittha.users_history, faceittha.user_audit, faceittha.user_data_keys, faceittha.rate_limits, faceittha.credential_failures, faceittha.sessions, faceittha.refresh_tokens, faceittha.totp_credentials, faceittha.recovery_codes, faceittha.login_challenges, faceittha.api_keys, faceittha.user_roles, faceittha.friendships, faceittha.user_blocks, faceittha.user_suspensions, faceittha.nickname_changes, faceittha.nickname_reservations")
 	s.Require().NoError(err)
 }
 
@@ -85,8 +88,14 @@ func TestComponentTestSuite(t *testing.T) {
 	db := pg.Connect(opts)
 	require.NoError(t, db.Ping(context.Background()))
 
+	jwtSigningKeyFile := os.Getenv("JWT_SIGNING_KEY_FILE")
+	if jwtSigningKeyFile == "" {
+		jwtSigningKeyFile = "testdata/jwt.pem"
+	}
+	token := newBearerToken(t, jwtSigningKeyFile)
+
 	// Create a gRPC connection to the server
-	conn, err := grpc.Dial(grpcServerAddress, grpc.WithInsecure())
+	conn, err := grpc.Dial(grpcServerAddress, grpc.WithInsecure(), grpc.WithPerRPCCredentials(token))
 	require.NoError(t, err)
 
 	userSvcClient := v1.NewUserServiceClient(conn)
@@ -123,6 +132,32 @@ func TestComponentTestSuite(t *testing.T) {
 	})
 }
 
+// bearerToken attaches a JWT to every call.
+type bearerToken string
+
+// newBearerToken issues an admin token signed with the key the server trusts in the component tests (testdata/jwks.json).
+func newBearerToken(t *testing.T, keyFile string) bearerToken {
+	key, err := signer.LoadEd25519PrivateKey(keyFile)
+	require.NoError(t, err)
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"sub":   "component-tests",
+		"roles": []string{"admin"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = "component-tests"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return bearerToken(signed)
+}
+
+func (b bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(b)}, nil
+}
+
+func (b bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
 type given = func() *ComponentTestSuite
 type when = func() *ComponentTestSuite
 type then = func() *ComponentTestSuite